@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"reflect"
@@ -336,6 +337,103 @@ resource "validator_decoder_unspecified" "test" {}
 	})
 }
 
+func TestAccPluginSDKAndDecoderCustomizeDiff(t *testing.T) {
+	os.Setenv("TF_ACC", "1")
+
+	type MyType struct {
+		Hello   string `tfschema:"hello"`
+		Number  int    `tfschema:"number"`
+		Enabled bool   `tfschema:"enabled"`
+	}
+
+	expected := MyType{
+		Hello:   "world",
+		Number:  42,
+		Enabled: true,
+	}
+
+	// lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"validator": func() (*schema.Provider, error) { //nolint:unparam
+				return &schema.Provider{
+					DataSourcesMap: map[string]*schema.Resource{},
+					ResourcesMap: map[string]*schema.Resource{
+						"validator_decoder_customize_diff": {
+							Schema: map[string]*schema.Schema{
+								"hello": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"number": {
+									Type:     schema.TypeInt,
+									Required: true,
+								},
+								"enabled": {
+									Type:     schema.TypeBool,
+									Required: true,
+								},
+							},
+							CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+								wrapper := ResourceMetaData{
+									ResourceDiff:             d,
+									Logger:                   ConsoleLogger{},
+									serializationDebugLogger: ConsoleLogger{},
+								}
+
+								var actual MyType
+								if err := wrapper.Decode(&actual); err != nil {
+									return fmt.Errorf("decoding: %+v", err)
+								}
+
+								if !reflect.DeepEqual(actual, expected) {
+									return fmt.Errorf("Values did not match - Expected:\n%+v\n\nActual:\n%+v", expected, actual)
+								}
+
+								if err := wrapper.Encode(&actual); err == nil {
+									return fmt.Errorf("expected an error encoding during CustomizeDiff but didn't get one")
+								}
+
+								return nil
+							},
+							Create: func(d *schema.ResourceData, i interface{}) error { //nolint:SA1019
+								d.SetId("some-id")
+								return nil
+							},
+							Read: func(_ *schema.ResourceData, _ interface{}) error {
+								return nil
+							},
+							Delete: func(_ *schema.ResourceData, _ interface{}) error {
+								return nil
+							},
+						},
+					},
+				}, nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "validator_decoder_customize_diff" "test" {
+  hello   = "world"
+  number  = 42
+  enabled = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceStateMatches("validator_decoder_customize_diff.test", map[string]interface{}{
+						"%":       "4",
+						"id":      "some-id",
+						"enabled": "true",
+						"hello":   "world",
+						"number":  "42",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPluginSDKAndDecoderOptionalComputedOverride(t *testing.T) {
 	os.Setenv("TF_ACC", "1")
 
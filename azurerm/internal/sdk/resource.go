@@ -63,7 +63,6 @@ type Resource interface {
 	IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
 // TODO: ResourceWithStateMigration
 // TODO: a generic state migration for updating ID's
 
@@ -88,6 +87,22 @@ type ResourceWithUpdate interface {
 	Update() ResourceFunc
 }
 
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can inspect and modify the
+// plan (via the ResourceDiff available in the ResourceMetaData)
+// before it's presented to the user.
+//
+// NOTE: ResourceData isn't available within CustomizeDiff, as such the
+// ResourceMetaData can be used to Decode the planned values but not to
+// Encode values, set the ID or mark the resource as gone.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc which runs the Custom Diff logic
+	CustomizeDiff() ResourceFunc
+}
+
 // ResourceWithDeprecation is an optional interface
 //
 // Resources implementing this interface will be marked as Deprecated
@@ -125,8 +140,13 @@ type ResourceMetaData struct {
 	// ResourceData is a reference to the ResourceData object from Terraform's Plugin SDK
 	// This is used to be able to call operations directly should Encode/Decode be insufficient
 	// for example, to determine if a field has changes
+	// This is nil during CustomizeDiff
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is only available during CustomizeDiff
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
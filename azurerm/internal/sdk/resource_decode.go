@@ -18,7 +18,17 @@ import (
 // }
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
+//
+// During CustomizeDiff (where ResourceData isn't available) this decodes the planned values from the ResourceDiff
 func (rmd ResourceMetaData) Decode(input interface{}) error {
+	if rmd.ResourceData == nil {
+		if rmd.ResourceDiff == nil {
+			return fmt.Errorf("internal-error: neither ResourceData or ResourceDiff are available to decode from")
+		}
+
+		return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+	}
+
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
@@ -8,7 +8,12 @@ import (
 // Encode will encode the specified object into the Terraform State
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
+// NOTE: this isn't available during CustomizeDiff, use the ResourceDiff to set planned values instead
 func (rmd ResourceMetaData) Encode(input interface{}) error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("internal-error: ResourceData isn't available to encode into, this can't be used within CustomizeDiff")
+	}
+
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 )
 
@@ -133,7 +134,20 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		resource.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			client := meta.(*clients.Client)
+			metaData := ResourceMetaData{
+				Client:                   client,
+				Logger:                   rw.logger,
+				ResourceDiff:             d,
+				serializationDebugLogger: NullLogger{},
+			}
+
+			return v.CustomizeDiff().Func(ctx, metaData)
+		}
+	}
+
 	// TODO: State Migrations
 
 	return &resource, nil
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
@@ -55,9 +56,16 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"key_vault_key_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: keyVaultValidate.NestedItemId,
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     keyVaultValidate.NestedItemId,
+				DiffSuppressFunc: diskEncryptionSetKeyVaultKeyIdDiffSuppress,
+			},
+
+			"auto_key_rotation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"identity": {
@@ -138,6 +146,7 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 					ID: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
+			RotationToLatestKeyVersionEnabled: utils.Bool(d.Get("auto_key_rotation_enabled").(bool)),
 		},
 		Identity: expandDiskEncryptionSetIdentity(identityRaw),
 		Tags:     tags.Expand(t),
@@ -195,6 +204,12 @@ func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{})
 			keyVaultKeyId = *props.ActiveKey.KeyURL
 		}
 		d.Set("key_vault_key_id", keyVaultKeyId)
+
+		rotationToLatestKeyVersionEnabled := false
+		if props.RotationToLatestKeyVersionEnabled != nil {
+			rotationToLatestKeyVersionEnabled = *props.RotationToLatestKeyVersionEnabled
+		}
+		d.Set("auto_key_rotation_enabled", rotationToLatestKeyVersionEnabled)
 	}
 
 	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
@@ -243,6 +258,13 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("auto_key_rotation_enabled") {
+		if update.DiskEncryptionSetUpdateProperties == nil {
+			update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{}
+		}
+		update.DiskEncryptionSetUpdateProperties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
+	}
+
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
 	if err != nil {
 		return fmt.Errorf("Error updating Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -359,3 +381,28 @@ func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *cli
 		softDeleteEnabled:      softDeleteEnabled,
 	}, nil
 }
+
+// diskEncryptionSetKeyVaultKeyIdDiffSuppress suppresses the diff when the Key has been rotated by Azure, since when
+// `auto_key_rotation_enabled` is set the Disk Encryption Set is updated to the latest version of the Key. This only
+// applies when automatic rotation is both already enabled and remains enabled, otherwise the version is authoritative.
+func diskEncryptionSetKeyVaultKeyIdDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldRotationEnabled, newRotationEnabled := d.GetChange("auto_key_rotation_enabled")
+	if !oldRotationEnabled.(bool) || !newRotationEnabled.(bool) {
+		return false
+	}
+
+	oldId, err := keyVaultParse.ParseNestedItemID(old)
+	if err != nil {
+		return false
+	}
+	newId, err := keyVaultParse.ParseNestedItemID(new)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(oldId.VersionlessID(), newId.VersionlessID())
+}
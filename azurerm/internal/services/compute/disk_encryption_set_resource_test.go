@@ -114,6 +114,38 @@ func TestAccDiskEncryptionSet_keyRotate(t *testing.T) {
 	})
 }
 
+func TestAccDiskEncryptionSet_autoKeyRotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_encryption_set", "test")
+	r := DiskEncryptionSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_key_rotation_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoKeyRotation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_key_rotation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoKeyRotation(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_key_rotation_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (DiskEncryptionSetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DiskEncryptionSetID(state.ID)
 	if err != nil {
@@ -247,6 +279,24 @@ resource "azurerm_disk_encryption_set" "test" {
 `, r.dependencies(data), data.RandomInteger)
 }
 
+func (r DiskEncryptionSetResource) autoKeyRotation(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_disk_encryption_set" "test" {
+  name                      = "acctestDES-%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  key_vault_key_id          = azurerm_key_vault_key.test.id
+  auto_key_rotation_enabled = %t
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.dependencies(data), data.RandomInteger, enabled)
+}
+
 func (r DiskEncryptionSetResource) grantAccessToKeyVault(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -32,3 +32,25 @@ func virtualMachineShouldBeStarted(instanceView compute.VirtualMachineInstanceVi
 
 	return false
 }
+
+// virtualMachinePowerState returns the Power State (e.g. `running` or `deallocated`) from the
+// list of Instance View Statuses, or an empty string if it's not present
+func virtualMachinePowerState(statuses *[]compute.InstanceViewStatus) string {
+	if statuses == nil {
+		return ""
+	}
+
+	for _, status := range *statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		// could also be the provisioning state which we're not bothered with here
+		state := strings.ToLower(*status.Code)
+		if strings.HasPrefix(state, "powerstate/") {
+			return strings.TrimPrefix(state, "powerstate/")
+		}
+	}
+
+	return ""
+}
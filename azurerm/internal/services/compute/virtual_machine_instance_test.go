@@ -79,3 +79,49 @@ func TestVirtualMachineShouldBeStarted(t *testing.T) {
 		}
 	}
 }
+
+func TestVirtualMachinePowerState(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name: "No Power State",
+			Input: &[]compute.InstanceViewStatus{
+				{Code: utils.String("ProvisioningState/succeeded")},
+			},
+			Expected: "",
+		},
+		{
+			Name: "Running",
+			Input: &[]compute.InstanceViewStatus{
+				{Code: utils.String("ProvisioningState/succeeded")},
+				{Code: utils.String("PowerState/running")},
+			},
+			Expected: "running",
+		},
+		{
+			Name: "Deallocated",
+			Input: &[]compute.InstanceViewStatus{
+				{Code: nil},
+				{Code: utils.String("PowerState/Deallocated")},
+			},
+			Expected: "deallocated",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		result := virtualMachinePowerState(testCase.Input)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, result)
+		}
+	}
+}
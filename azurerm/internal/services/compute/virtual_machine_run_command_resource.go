@@ -0,0 +1,497 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceVirtualMachineRunCommand() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineRunCommandCreateUpdate,
+		Read:   resourceVirtualMachineRunCommandRead,
+		Update: resourceVirtualMachineRunCommandCreateUpdate,
+		Delete: resourceVirtualMachineRunCommandDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineRunCommandID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(90 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineID,
+			},
+
+			"source": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"command_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+						},
+
+						"script": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+						},
+
+						"script_uri": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+							ExactlyOneOf: []string{"source.0.command_id", "source.0.script", "source.0.script_uri"},
+						},
+					},
+				},
+			},
+
+			"parameter": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			// the API doesn't return these, so changes can't be detected outside of Terraform
+			"protected_parameter": {
+				Type:      pluginsdk.TypeList,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"value": {
+							Type:      pluginsdk.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"run_as_user": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"run_as_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"run_as_user"},
+			},
+
+			"timeout_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			// these are SAS URIs, which aren't returned by the API
+			"output_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"error_blob_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"instance_view": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"execution_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"execution_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"exit_code": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"output": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"error_message": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"start_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"end_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceVirtualMachineRunCommandCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := parse.VirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_virtual_machine_run_command", id.ID())
+		}
+	}
+
+	runCommand := compute.VirtualMachineRunCommand{
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		VirtualMachineRunCommandProperties: &compute.VirtualMachineRunCommandProperties{
+			Source:              expandVirtualMachineRunCommandSource(d.Get("source").([]interface{})),
+			Parameters:          expandVirtualMachineRunCommandParameters(d.Get("parameter").([]interface{})),
+			ProtectedParameters: expandVirtualMachineRunCommandParameters(d.Get("protected_parameter").([]interface{})),
+			// we need the script to have completed so that the exit code and output are available in the instance view
+			AsyncExecution: utils.Bool(false),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("run_as_user"); ok {
+		runCommand.VirtualMachineRunCommandProperties.RunAsUser = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("run_as_password"); ok {
+		runCommand.VirtualMachineRunCommandProperties.RunAsPassword = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timeout_in_seconds"); ok {
+		runCommand.VirtualMachineRunCommandProperties.TimeoutInSeconds = utils.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("output_blob_uri"); ok {
+		runCommand.VirtualMachineRunCommandProperties.OutputBlobURI = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("error_blob_uri"); ok {
+		runCommand.VirtualMachineRunCommandProperties.ErrorBlobURI = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, runCommand)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceVirtualMachineRunCommandRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RunCommandName)
+	d.Set("virtual_machine_id", parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID())
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualMachineRunCommandProperties; props != nil {
+		if err := d.Set("source", flattenVirtualMachineRunCommandSource(props.Source)); err != nil {
+			return fmt.Errorf("setting `source`: %+v", err)
+		}
+
+		if err := d.Set("parameter", flattenVirtualMachineRunCommandParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("setting `parameter`: %+v", err)
+		}
+
+		d.Set("run_as_user", props.RunAsUser)
+
+		timeoutInSeconds := 0
+		if props.TimeoutInSeconds != nil {
+			timeoutInSeconds = int(*props.TimeoutInSeconds)
+		}
+		d.Set("timeout_in_seconds", timeoutInSeconds)
+
+		if err := d.Set("instance_view", flattenVirtualMachineRunCommandInstanceView(props.InstanceView)); err != nil {
+			return fmt.Errorf("setting `instance_view`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceVirtualMachineRunCommandDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMRunCommandsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineRunCommandSource(input []interface{}) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	source := compute.VirtualMachineRunCommandScriptSource{}
+
+	if v := raw["command_id"].(string); v != "" {
+		source.CommandID = utils.String(v)
+	}
+
+	if v := raw["script"].(string); v != "" {
+		source.Script = utils.String(v)
+	}
+
+	if v := raw["script_uri"].(string); v != "" {
+		source.ScriptURI = utils.String(v)
+	}
+
+	return &source
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	commandId := ""
+	if input.CommandID != nil {
+		commandId = *input.CommandID
+	}
+
+	script := ""
+	if input.Script != nil {
+		script = *input.Script
+	}
+
+	scriptUri := ""
+	if input.ScriptURI != nil {
+		scriptUri = *input.ScriptURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"command_id": commandId,
+			"script":     script,
+			"script_uri": scriptUri,
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []interface{}) *[]compute.RunCommandInputParameter {
+	parameters := make([]compute.RunCommandInputParameter, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		raw := item.(map[string]interface{})
+		parameters = append(parameters, compute.RunCommandInputParameter{
+			Name:  utils.String(raw["name"].(string)),
+			Value: utils.String(raw["value"].(string)),
+		})
+	}
+
+	return &parameters
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		value := ""
+		if item.Value != nil {
+			value = *item.Value
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+
+	return results
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	executionMessage := ""
+	if input.ExecutionMessage != nil {
+		executionMessage = *input.ExecutionMessage
+	}
+
+	exitCode := 0
+	if input.ExitCode != nil {
+		exitCode = int(*input.ExitCode)
+	}
+
+	output := ""
+	if input.Output != nil {
+		output = *input.Output
+	}
+
+	errorMessage := ""
+	if input.Error != nil {
+		errorMessage = *input.Error
+	}
+
+	startTime := ""
+	if input.StartTime != nil {
+		startTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	endTime := ""
+	if input.EndTime != nil {
+		endTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"execution_state":   string(input.ExecutionState),
+			"execution_message": executionMessage,
+			"exit_code":         exitCode,
+			"output":            output,
+			"error_message":     errorMessage,
+			"start_time":        startTime,
+			"end_time":          endTime,
+		},
+	}
+}
@@ -0,0 +1,210 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualMachineRunCommandResource struct {
+}
+
+func TestAccVirtualMachineRunCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.exit_code").HasValue("0"),
+			),
+		},
+		data.ImportStep("protected_parameter", "output_blob_uri", "error_blob_uri"),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_parameter", "output_blob_uri", "error_blob_uri"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineRunCommand_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_run_command", "test")
+	r := VirtualMachineRunCommandResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r VirtualMachineRunCommandResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMRunCommandsClient.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r VirtualMachineRunCommandResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, LinuxVirtualMachineResource{}.authPassword(data), data.RandomInteger)
+}
+
+func (r VirtualMachineRunCommandResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "import" {
+  name               = azurerm_virtual_machine_run_command.test.name
+  location           = azurerm_virtual_machine_run_command.test.location
+  virtual_machine_id = azurerm_virtual_machine_run_command.test.virtual_machine_id
+
+  source {
+    script = "echo 'hello world'"
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineRunCommandResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "output"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2021-08-01"
+  expiry = "2031-08-01"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = false
+  }
+}
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestvmrc-%d"
+  location           = azurerm_resource_group.test.location
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  run_as_user        = "adminuser"
+  timeout_in_seconds = 300
+
+  source {
+    script = "echo $GREETING $SECRET"
+  }
+
+  parameter {
+    name  = "GREETING"
+    value = "hello"
+  }
+
+  protected_parameter {
+    name  = "SECRET"
+    value = "world"
+  }
+
+  output_blob_uri = "${azurerm_storage_container.test.id}/output.txt${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  error_blob_uri  = "${azurerm_storage_container.test.id}/error.txt${data.azurerm_storage_account_blob_container_sas.test.sas}"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, LinuxVirtualMachineResource{}.authPassword(data), data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,387 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// resourceVirtualMachineScaleSetInstance manages the instance-level settings of an existing
+// Virtual Machine Scale Set instance - the instance itself is owned by the Scale Set, so
+// deleting this resource resets these settings rather than deleting the instance.
+func resourceVirtualMachineScaleSetInstance() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVirtualMachineScaleSetInstanceCreateUpdate,
+		Read:   resourceVirtualMachineScaleSetInstanceRead,
+		Update: resourceVirtualMachineScaleSetInstanceCreateUpdate,
+		Delete: resourceVirtualMachineScaleSetInstanceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.VirtualMachineScaleSetInstanceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.VirtualMachineScaleSetID,
+			},
+
+			"instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protect_from_scale_in": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"protect_from_scale_set_actions": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// only the Data Disks defined here are managed by this resource, any others attached
+			// to the instance (e.g. from the Scale Set model) are left as-is
+			"data_disk": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"managed_disk_id": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validate.ManagedDiskID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"lun": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2000),
+						},
+
+						"caching": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(compute.CachingTypesNone),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(compute.CachingTypesNone),
+								string(compute.CachingTypesReadOnly),
+								string(compute.CachingTypesReadWrite),
+							}, false),
+						},
+
+						"write_accelerator_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"latest_model_applied": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualMachineScaleSetInstanceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	scaleSetId, err := parse.VirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewVirtualMachineScaleSetInstanceID(scaleSetId.SubscriptionId, scaleSetId.ResourceGroup, scaleSetId.Name, d.Get("instance_id").(string))
+
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.VirtualMachineScaleSetVMProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	existing.VirtualMachineScaleSetVMProperties.ProtectionPolicy = &compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(d.Get("protect_from_scale_in").(bool)),
+		ProtectFromScaleSetActions: utils.Bool(d.Get("protect_from_scale_set_actions").(bool)),
+	}
+
+	if d.IsNewResource() || d.HasChange("data_disk") {
+		oldRaw, newRaw := d.GetChange("data_disk")
+		if d.IsNewResource() {
+			oldRaw = []interface{}{}
+		}
+		if err := updateVirtualMachineScaleSetInstanceDataDisks(&existing, oldRaw.([]interface{}), newRaw.([]interface{})); err != nil {
+			return fmt.Errorf("updating Data Disks for %s: %+v", id, err)
+		}
+	}
+
+	// extensions are managed through the Scale Set and can't be sent back to this API
+	existing.Resources = nil
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, existing)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceVirtualMachineScaleSetInstanceRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// when importing nothing is known about which Data Disks are managed by this resource
+	importing := d.Get("virtual_machine_scale_set_id").(string) == ""
+
+	d.Set("virtual_machine_scale_set_id", parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName).ID())
+	d.Set("instance_id", id.VirtualMachineName)
+	d.Set("name", resp.Name)
+
+	if props := resp.VirtualMachineScaleSetVMProperties; props != nil {
+		protectFromScaleIn := false
+		protectFromScaleSetActions := false
+		if policy := props.ProtectionPolicy; policy != nil {
+			if policy.ProtectFromScaleIn != nil {
+				protectFromScaleIn = *policy.ProtectFromScaleIn
+			}
+			if policy.ProtectFromScaleSetActions != nil {
+				protectFromScaleSetActions = *policy.ProtectFromScaleSetActions
+			}
+		}
+		d.Set("protect_from_scale_in", protectFromScaleIn)
+		d.Set("protect_from_scale_set_actions", protectFromScaleSetActions)
+		d.Set("latest_model_applied", props.LatestModelApplied)
+
+		var dataDisks *[]compute.DataDisk
+		if profile := props.StorageProfile; profile != nil {
+			dataDisks = profile.DataDisks
+		}
+		if err := d.Set("data_disk", flattenVirtualMachineScaleSetInstanceDataDisks(dataDisks, d.Get("data_disk").([]interface{}), importing)); err != nil {
+			return fmt.Errorf("setting `data_disk`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualMachineScaleSetInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.VirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	scaleSetId := parse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+	locks.ByID(scaleSetId.ID())
+	defer locks.UnlockByID(scaleSetId.ID())
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			// the instance has already gone, so there's nothing to reset
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if existing.VirtualMachineScaleSetVMProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	existing.VirtualMachineScaleSetVMProperties.ProtectionPolicy = &compute.VirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         utils.Bool(false),
+		ProtectFromScaleSetActions: utils.Bool(false),
+	}
+	if err := updateVirtualMachineScaleSetInstanceDataDisks(&existing, d.Get("data_disk").([]interface{}), []interface{}{}); err != nil {
+		return fmt.Errorf("detaching Data Disks from %s: %+v", *id, err)
+	}
+	existing.Resources = nil
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, existing)
+	if err != nil {
+		return fmt.Errorf("resetting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for reset of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// updateVirtualMachineScaleSetInstanceDataDisks detaches the Data Disks previously managed by this resource
+// and attaches the ones now defined, leaving any other Data Disks on the instance untouched
+func updateVirtualMachineScaleSetInstanceDataDisks(vm *compute.VirtualMachineScaleSetVM, oldDisks []interface{}, newDisks []interface{}) error {
+	props := vm.VirtualMachineScaleSetVMProperties
+	if props.StorageProfile == nil {
+		props.StorageProfile = &compute.StorageProfile{}
+	}
+
+	existing := make([]compute.DataDisk, 0)
+	if props.StorageProfile.DataDisks != nil {
+		existing = *props.StorageProfile.DataDisks
+	}
+
+	managedByUs := make(map[string]struct{})
+	for _, raw := range oldDisks {
+		v := raw.(map[string]interface{})
+		managedByUs[strings.ToLower(v["managed_disk_id"].(string))] = struct{}{}
+	}
+
+	disks := make([]compute.DataDisk, 0)
+	usedLuns := make(map[int32]struct{})
+	for _, disk := range existing {
+		if disk.ManagedDisk != nil && disk.ManagedDisk.ID != nil {
+			if _, ok := managedByUs[strings.ToLower(*disk.ManagedDisk.ID)]; ok {
+				continue
+			}
+		}
+
+		disks = append(disks, disk)
+		if disk.Lun != nil {
+			usedLuns[*disk.Lun] = struct{}{}
+		}
+	}
+
+	for _, raw := range newDisks {
+		v := raw.(map[string]interface{})
+		lun := int32(v["lun"].(int))
+		if _, ok := usedLuns[lun]; ok {
+			return fmt.Errorf("LUN %d is already in use by a Data Disk which isn't managed by this resource", lun)
+		}
+		usedLuns[lun] = struct{}{}
+
+		disks = append(disks, compute.DataDisk{
+			Lun:          utils.Int32(lun),
+			Caching:      compute.CachingTypes(v["caching"].(string)),
+			CreateOption: compute.DiskCreateOptionTypesAttach,
+			ManagedDisk: &compute.ManagedDiskParameters{
+				ID: utils.String(v["managed_disk_id"].(string)),
+			},
+			WriteAcceleratorEnabled: utils.Bool(v["write_accelerator_enabled"].(bool)),
+		})
+	}
+
+	props.StorageProfile.DataDisks = &disks
+	return nil
+}
+
+// flattenVirtualMachineScaleSetInstanceDataDisks returns the Data Disks managed by this resource - when
+// importing this is any Data Disk which was attached rather than created from the Scale Set model
+func flattenVirtualMachineScaleSetInstanceDataDisks(input *[]compute.DataDisk, current []interface{}, importing bool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	managedByUs := make(map[string]struct{})
+	for _, raw := range current {
+		v := raw.(map[string]interface{})
+		managedByUs[strings.ToLower(v["managed_disk_id"].(string))] = struct{}{}
+	}
+
+	for _, disk := range *input {
+		if disk.ManagedDisk == nil || disk.ManagedDisk.ID == nil {
+			continue
+		}
+
+		if importing {
+			if disk.CreateOption != compute.DiskCreateOptionTypesAttach {
+				continue
+			}
+		} else if _, ok := managedByUs[strings.ToLower(*disk.ManagedDisk.ID)]; !ok {
+			continue
+		}
+
+		lun := 0
+		if disk.Lun != nil {
+			lun = int(*disk.Lun)
+		}
+
+		writeAcceleratorEnabled := false
+		if disk.WriteAcceleratorEnabled != nil {
+			writeAcceleratorEnabled = *disk.WriteAcceleratorEnabled
+		}
+
+		results = append(results, map[string]interface{}{
+			"managed_disk_id":           *disk.ManagedDisk.ID,
+			"lun":                       lun,
+			"caching":                   string(disk.Caching),
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,133 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type VirtualMachineScaleSetInstanceResource struct {
+}
+
+func TestAccVirtualMachineScaleSetInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance", "test")
+	r := VirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protect_from_scale_in").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetInstance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_instance", "test")
+	r := VirtualMachineScaleSetInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protect_from_scale_set_actions").HasValue("true"),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protect_from_scale_set_actions").HasValue("false"),
+				check.That(data.ResourceName).Key("data_disk.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineScaleSetInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMScaleSetVMsClient.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSetName, id.VirtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r VirtualMachineScaleSetInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set_instances" "test" {
+  name                = azurerm_linux_virtual_machine_scale_set.test.name
+  resource_group_name = azurerm_linux_virtual_machine_scale_set.test.resource_group_name
+}
+
+resource "azurerm_virtual_machine_scale_set_instance" "test" {
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_id                  = data.azurerm_virtual_machine_scale_set_instances.test.instances.0.instance_id
+  protect_from_scale_in        = true
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data))
+}
+
+func (r VirtualMachineScaleSetInstanceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set_instances" "test" {
+  name                = azurerm_linux_virtual_machine_scale_set.test.name
+  resource_group_name = azurerm_linux_virtual_machine_scale_set.test.resource_group_name
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_scale_set_instance" "test" {
+  virtual_machine_scale_set_id   = azurerm_linux_virtual_machine_scale_set.test.id
+  instance_id                    = data.azurerm_virtual_machine_scale_set_instances.test.instances.0.instance_id
+  protect_from_scale_in          = true
+  protect_from_scale_set_actions = true
+
+  data_disk {
+    managed_disk_id = azurerm_managed_disk.test.id
+    lun             = 10
+    caching         = "ReadOnly"
+  }
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data), data.RandomInteger)
+}
@@ -0,0 +1,266 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVirtualMachineScaleSetInstances() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualMachineScaleSetInstancesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"instances": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"instance_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"computer_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"virtual_machine_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"zone": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"power_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"latest_model_applied": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"protect_from_scale_in": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"protect_from_scale_set_actions": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualMachineScaleSetInstancesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	scaleSetsClient := meta.(*clients.Client).Compute.VMScaleSetClient
+	instancesClient := meta.(*clients.Client).Compute.VMScaleSetVMsClient
+	nicsClient := meta.(*clients.Client).Network.InterfacesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualMachineScaleSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	scaleSet, err := scaleSetsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(scaleSet.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	instances := make([]compute.VirtualMachineScaleSetVM, 0)
+	iterator, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", string(compute.InstanceView))
+	if err != nil {
+		return fmt.Errorf("listing instances for %s: %+v", id, err)
+	}
+	for iterator.NotDone() {
+		instances = append(instances, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing instances for %s: %+v", id, err)
+		}
+	}
+
+	flattened, err := flattenVirtualMachineScaleSetInstances(ctx, nicsClient, id, instances)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if err := d.Set("instances", flattened); err != nil {
+		return fmt.Errorf("setting `instances`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenVirtualMachineScaleSetInstances(ctx context.Context, nicsClient *network.InterfacesClient, id parse.VirtualMachineScaleSetId, input []compute.VirtualMachineScaleSetVM) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		if item.InstanceID == nil {
+			continue
+		}
+		instanceId := *item.InstanceID
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		virtualMachineId := ""
+		if item.ID != nil {
+			virtualMachineId = *item.ID
+		}
+
+		zone := ""
+		if item.Zones != nil && len(*item.Zones) > 0 {
+			zone = (*item.Zones)[0]
+		}
+
+		computerName := ""
+		powerState := ""
+		latestModelApplied := false
+		protectFromScaleIn := false
+		protectFromScaleSetActions := false
+		if props := item.VirtualMachineScaleSetVMProperties; props != nil {
+			if props.OsProfile != nil && props.OsProfile.ComputerName != nil {
+				computerName = *props.OsProfile.ComputerName
+			}
+
+			if props.InstanceView != nil {
+				powerState = virtualMachinePowerState(props.InstanceView.Statuses)
+			}
+
+			if props.LatestModelApplied != nil {
+				latestModelApplied = *props.LatestModelApplied
+			}
+
+			if policy := props.ProtectionPolicy; policy != nil {
+				if policy.ProtectFromScaleIn != nil {
+					protectFromScaleIn = *policy.ProtectFromScaleIn
+				}
+				if policy.ProtectFromScaleSetActions != nil {
+					protectFromScaleSetActions = *policy.ProtectFromScaleSetActions
+				}
+			}
+		}
+
+		privateIPAddresses, err := retrieveVirtualMachineScaleSetInstancePrivateIPAddresses(ctx, nicsClient, id, instanceId)
+		if err != nil {
+			return nil, err
+		}
+
+		privateIPAddress := ""
+		if len(privateIPAddresses) > 0 {
+			privateIPAddress = privateIPAddresses[0]
+		}
+
+		results = append(results, map[string]interface{}{
+			"instance_id":                    instanceId,
+			"name":                           name,
+			"computer_name":                  computerName,
+			"virtual_machine_id":             virtualMachineId,
+			"zone":                           zone,
+			"power_state":                    powerState,
+			"latest_model_applied":           latestModelApplied,
+			"protect_from_scale_in":          protectFromScaleIn,
+			"protect_from_scale_set_actions": protectFromScaleSetActions,
+			"private_ip_address":             privateIPAddress,
+			"private_ip_addresses":           privateIPAddresses,
+		})
+	}
+
+	return results, nil
+}
+
+// retrieveVirtualMachineScaleSetInstancePrivateIPAddresses returns the Private IP Addresses of the Network Interfaces
+// attached to the specified Scale Set instance, with the address of the Primary IP Configuration first
+func retrieveVirtualMachineScaleSetInstancePrivateIPAddresses(ctx context.Context, client *network.InterfacesClient, id parse.VirtualMachineScaleSetId, instanceId string) ([]string, error) {
+	primary := make([]string, 0)
+	secondary := make([]string, 0)
+
+	iterator, err := client.ListVirtualMachineScaleSetVMNetworkInterfacesComplete(ctx, id.ResourceGroup, id.Name, instanceId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Network Interfaces for instance %q of %s: %+v", instanceId, id, err)
+	}
+
+	for iterator.NotDone() {
+		nic := iterator.Value()
+		if props := nic.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+			isPrimaryNic := props.Primary != nil && *props.Primary
+			for _, config := range *props.IPConfigurations {
+				ipProps := config.InterfaceIPConfigurationPropertiesFormat
+				if ipProps == nil || ipProps.PrivateIPAddress == nil {
+					continue
+				}
+
+				if isPrimaryNic && ipProps.Primary != nil && *ipProps.Primary {
+					primary = append(primary, *ipProps.PrivateIPAddress)
+				} else {
+					secondary = append(secondary, *ipProps.PrivateIPAddress)
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Network Interfaces for instance %q of %s: %+v", instanceId, id, err)
+		}
+	}
+
+	return append(primary, secondary...), nil
+}
@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineScaleSetInstancesDataSource struct {
+}
+
+func TestAccDataSourceVirtualMachineScaleSetInstances_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_scale_set_instances", "test")
+	r := VirtualMachineScaleSetInstancesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("instances.#").HasValue("1"),
+				check.That(data.ResourceName).Key("instances.0.instance_id").Exists(),
+				check.That(data.ResourceName).Key("instances.0.power_state").HasValue("running"),
+				check.That(data.ResourceName).Key("instances.0.latest_model_applied").HasValue("true"),
+				check.That(data.ResourceName).Key("instances.0.private_ip_address").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineScaleSetInstancesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_machine_scale_set_instances" "test" {
+  name                = azurerm_linux_virtual_machine_scale_set.test.name
+  resource_group_name = azurerm_linux_virtual_machine_scale_set.test.resource_group_name
+}
+`, LinuxVirtualMachineScaleSetResource{}.authPassword(data))
+}
@@ -1,7 +1,7 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-01-15/documentdb"
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-06-15/documentdb"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
@@ -11,6 +11,7 @@ type Client struct {
 	GremlinClient           *documentdb.GremlinResourcesClient
 	MongoDbClient           *documentdb.MongoDBResourcesClient
 	NotebookWorkspaceClient *documentdb.NotebookWorkspacesClient
+	RestorableClient        *documentdb.RestorableDatabaseAccountsClient
 	SqlClient               *documentdb.SQLResourcesClient
 	SqlResourceClient       *documentdb.SQLResourcesClient
 	TableClient             *documentdb.TableResourcesClient
@@ -32,6 +33,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	restorableClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&restorableClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlClient.Client, o.ResourceManagerAuthorizer)
 
@@ -47,6 +51,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		GremlinClient:           &gremlinClient,
 		MongoDbClient:           &mongoDbClient,
 		NotebookWorkspaceClient: &notebookWorkspaceClient,
+		RestorableClient:        &restorableClient,
 		SqlClient:               &sqlClient,
 		SqlResourceClient:       &sqlResourceClient,
 		TableClient:             &tableClient,
@@ -0,0 +1,192 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-06-15/documentdb"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceCosmosDbRestorableDatabaseAccounts() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbRestorableDatabaseAccountsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"accounts": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"api_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"deletion_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"restorable_locations": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"location": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"regional_database_account_instance_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"creation_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+
+									"deletion_time": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCosmosDbRestorableDatabaseAccountsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Cosmos.RestorableClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	loc := location.Normalize(d.Get("location").(string))
+
+	resp, err := client.ListByLocation(ctx, loc)
+	if err != nil {
+		return fmt.Errorf("retrieving Cosmos DB Restorable Database Accounts (Location %q): %+v", loc, err)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.DocumentDB/locations/%s/restorableDatabaseAccountNames/%s", subscriptionId, loc, name))
+	d.Set("name", name)
+	d.Set("location", loc)
+
+	if err := d.Set("accounts", flattenCosmosDbRestorableDatabaseAccounts(resp.Value, name)); err != nil {
+		return fmt.Errorf("setting `accounts`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenCosmosDbRestorableDatabaseAccounts(input *[]documentdb.RestorableDatabaseAccountGetResult, accountName string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		props := item.RestorableDatabaseAccountProperties
+		if props == nil || props.AccountName == nil || *props.AccountName != accountName {
+			continue
+		}
+
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		creationTime := ""
+		if props.CreationTime != nil {
+			creationTime = props.CreationTime.Format(time.RFC3339)
+		}
+
+		deletionTime := ""
+		if props.DeletionTime != nil {
+			deletionTime = props.DeletionTime.Format(time.RFC3339)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                   id,
+			"api_type":             string(props.APIType),
+			"creation_time":        creationTime,
+			"deletion_time":        deletionTime,
+			"restorable_locations": flattenCosmosDbRestorableDatabaseAccountLocations(props.RestorableLocations),
+		})
+	}
+
+	return results
+}
+
+func flattenCosmosDbRestorableDatabaseAccountLocations(input *[]documentdb.RestorableLocationResource) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		locationName := ""
+		if item.LocationName != nil {
+			locationName = location.Normalize(*item.LocationName)
+		}
+
+		instanceId := ""
+		if item.RegionalDatabaseAccountInstanceID != nil {
+			instanceId = *item.RegionalDatabaseAccountInstanceID
+		}
+
+		creationTime := ""
+		if item.CreationTime != nil {
+			creationTime = item.CreationTime.Format(time.RFC3339)
+		}
+
+		deletionTime := ""
+		if item.DeletionTime != nil {
+			deletionTime = item.DeletionTime.Format(time.RFC3339)
+		}
+
+		results = append(results, map[string]interface{}{
+			"location":                              locationName,
+			"regional_database_account_instance_id": instanceId,
+			"creation_time":                         creationTime,
+			"deletion_time":                         deletionTime,
+		})
+	}
+
+	return results
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-01-15/documentdb"
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-06-15/documentdb"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
@@ -130,7 +130,7 @@ resource "azurerm_cosmosdb_sql_database" "test" {
   resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
   account_name        = azurerm_cosmosdb_account.test.name
 }
-`, CosmosDBAccountResource{}.basic(data, documentdb.GlobalDocumentDB, documentdb.Strong), data.RandomInteger)
+`, CosmosDBAccountResource{}.basic(data, documentdb.DatabaseAccountKindGlobalDocumentDB, documentdb.DefaultConsistencyLevelStrong), data.RandomInteger)
 }
 
 func (CosmosSqlDatabaseResource) throughput(data acceptance.TestData, throughput int) string {
@@ -143,7 +143,7 @@ resource "azurerm_cosmosdb_sql_database" "test" {
   account_name        = azurerm_cosmosdb_account.test.name
   throughput          = %[3]d
 }
-`, CosmosDBAccountResource{}.basic(data, documentdb.GlobalDocumentDB, documentdb.Strong), data.RandomInteger, throughput)
+`, CosmosDBAccountResource{}.basic(data, documentdb.DatabaseAccountKindGlobalDocumentDB, documentdb.DefaultConsistencyLevelStrong), data.RandomInteger, throughput)
 }
 
 func (CosmosSqlDatabaseResource) autoscale(data acceptance.TestData, maxThroughput int) string {
@@ -158,7 +158,7 @@ resource "azurerm_cosmosdb_sql_database" "test" {
     max_throughput = %[3]d
   }
 }
-`, CosmosDBAccountResource{}.basic(data, documentdb.GlobalDocumentDB, documentdb.Strong), data.RandomInteger, maxThroughput)
+`, CosmosDBAccountResource{}.basic(data, documentdb.DatabaseAccountKindGlobalDocumentDB, documentdb.DefaultConsistencyLevelStrong), data.RandomInteger, maxThroughput)
 }
 
 func (CosmosSqlDatabaseResource) serverless(data acceptance.TestData) string {
@@ -169,5 +169,5 @@ resource "azurerm_cosmosdb_sql_database" "test" {
   resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
   account_name        = azurerm_cosmosdb_account.test.name
 }
-`, CosmosDBAccountResource{}.capabilities(data, documentdb.GlobalDocumentDB, []string{"EnableServerless"}), data.RandomInteger)
+`, CosmosDBAccountResource{}.capabilities(data, documentdb.DatabaseAccountKindGlobalDocumentDB, []string{"EnableServerless"}), data.RandomInteger)
 }
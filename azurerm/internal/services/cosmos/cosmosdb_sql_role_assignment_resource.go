@@ -0,0 +1,199 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-06-15/documentdb"
+	"github.com/hashicorp/go-uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceCosmosDbSQLRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCosmosDbSQLRoleAssignmentCreate,
+		Read:   resourceCosmosDbSQLRoleAssignmentRead,
+		Delete: resourceCosmosDbSQLRoleAssignmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.SqlRoleAssignmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SqlRoleDefinitionID,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SqlRoleScope,
+			},
+		},
+	}
+}
+
+func resourceCosmosDbSQLRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	if name == "" {
+		uuid, err := uuid.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("generating UUID for Cosmos DB SQL Role Assignment: %+v", err)
+		}
+
+		name = uuid
+	}
+
+	id := parse.NewSqlRoleAssignmentID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), name)
+
+	existing, err := client.GetSQLRoleAssignment(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_role_assignment", id.ID())
+	}
+
+	accountId := parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName)
+
+	roleDefinitionId, err := parse.SqlRoleDefinitionID(d.Get("role_definition_id").(string))
+	if err != nil {
+		return err
+	}
+	roleDefinitionAccountId := parse.NewDatabaseAccountID(roleDefinitionId.SubscriptionId, roleDefinitionId.ResourceGroup, roleDefinitionId.DatabaseAccountName)
+	if !strings.EqualFold(roleDefinitionAccountId.ID(), accountId.ID()) {
+		return fmt.Errorf("the `role_definition_id` %q must be within the Database Account %q", roleDefinitionId.ID(), accountId.ID())
+	}
+
+	scope := d.Get("scope").(string)
+	if err := validateCosmosDbSQLRoleScopes(accountId, []string{scope}); err != nil {
+		return fmt.Errorf("validating `scope`: %+v", err)
+	}
+
+	parameters := documentdb.SQLRoleAssignmentCreateUpdateParameters{
+		SQLRoleAssignmentResource: &documentdb.SQLRoleAssignmentResource{
+			PrincipalID:      utils.String(d.Get("principal_id").(string)),
+			RoleDefinitionID: utils.String(roleDefinitionId.ID()),
+			Scope:            utils.String(scope),
+		},
+	}
+
+	future, err := client.CreateUpdateSQLRoleAssignment(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCosmosDbSQLRoleAssignmentRead(d, meta)
+}
+
+func resourceCosmosDbSQLRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSQLRoleAssignment(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.DatabaseAccountName)
+
+	if props := resp.SQLRoleAssignmentResource; props != nil {
+		d.Set("principal_id", props.PrincipalID)
+		d.Set("role_definition_id", props.RoleDefinitionID)
+		d.Set("scope", props.Scope)
+	}
+
+	return nil
+}
+
+func resourceCosmosDbSQLRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteSQLRoleAssignment(ctx, id.Name, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SqlRoleScopeId is the scope of a Cosmos DB SQL Role Definition or Role Assignment - which is either the Database
+// Account, a Database (`{accountId}/dbs/{databaseName}`) or a Container (`{accountId}/dbs/{databaseName}/colls/{containerName}`)
+type SqlRoleScopeId struct {
+	DatabaseAccountId DatabaseAccountId
+	DatabaseName      string
+	ContainerName     string
+}
+
+func (id SqlRoleScopeId) ID() string {
+	scope := id.DatabaseAccountId.ID()
+	if id.DatabaseName != "" {
+		scope = fmt.Sprintf("%s/dbs/%s", scope, id.DatabaseName)
+	}
+	if id.ContainerName != "" {
+		scope = fmt.Sprintf("%s/colls/%s", scope, id.ContainerName)
+	}
+	return scope
+}
+
+// SqlRoleScopeID parses a SqlRoleScope ID into an SqlRoleScopeId struct
+func SqlRoleScopeID(input string) (*SqlRoleScopeId, error) {
+	accountId := input
+	path := ""
+	hasPath := false
+	if i := strings.Index(input, "/dbs/"); i != -1 {
+		accountId = input[:i]
+		path = input[i+len("/dbs/"):]
+		hasPath = true
+	}
+
+	databaseAccountId, err := DatabaseAccountID(accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	scopeId := SqlRoleScopeId{
+		DatabaseAccountId: *databaseAccountId,
+	}
+
+	if hasPath {
+		segments := strings.Split(path, "/")
+		switch {
+		case len(segments) == 1 && segments[0] != "":
+			scopeId.DatabaseName = segments[0]
+		case len(segments) == 3 && segments[0] != "" && segments[1] == "colls" && segments[2] != "":
+			scopeId.DatabaseName = segments[0]
+			scopeId.ContainerName = segments[2]
+		default:
+			return nil, fmt.Errorf("expected the scope to be either a Database Account, `{accountId}/dbs/{databaseName}` or `{accountId}/dbs/{databaseName}/colls/{containerName}` but got %q", input)
+		}
+	}
+
+	return &scopeId, nil
+}
@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestSqlRoleScopeID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *SqlRoleScopeId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing DatabaseAccountName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/",
+			Error: true,
+		},
+
+		{
+			// database account
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+			},
+		},
+
+		{
+			// database
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+				DatabaseName:      "db1",
+			},
+		},
+
+		{
+			// container
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/colls/container1",
+			Expected: &SqlRoleScopeId{
+				DatabaseAccountId: NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1"),
+				DatabaseName:      "db1",
+				ContainerName:     "container1",
+			},
+		},
+
+		{
+			// missing database name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/",
+			Error: true,
+		},
+
+		{
+			// missing container name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/colls/",
+			Error: true,
+		},
+
+		{
+			// unexpected segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/dbs/db1/containers/container1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := SqlRoleScopeID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.DatabaseAccountId != v.Expected.DatabaseAccountId {
+			t.Fatalf("Expected %+v for DatabaseAccountId but got %+v", v.Expected.DatabaseAccountId, actual.DatabaseAccountId)
+		}
+		if actual.DatabaseName != v.Expected.DatabaseName {
+			t.Fatalf("Expected %q for DatabaseName but got %q", v.Expected.DatabaseName, actual.DatabaseName)
+		}
+		if actual.ContainerName != v.Expected.ContainerName {
+			t.Fatalf("Expected %q for ContainerName but got %q", v.Expected.ContainerName, actual.ContainerName)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q for ID but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_cosmosdb_account": dataSourceCosmosDbAccount(),
+		"azurerm_cosmosdb_account":                      dataSourceCosmosDbAccount(),
+		"azurerm_cosmosdb_restorable_database_accounts": dataSourceCosmosDbRestorableDatabaseAccounts(),
 	}
 }
 
@@ -39,6 +40,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_cosmosdb_sql_container":        resourceCosmosDbSQLContainer(),
 		"azurerm_cosmosdb_sql_database":         resourceCosmosDbSQLDatabase(),
 		"azurerm_cosmosdb_sql_function":         resourceCosmosDbSQLFunction(),
+		"azurerm_cosmosdb_sql_role_assignment":  resourceCosmosDbSQLRoleAssignment(),
+		"azurerm_cosmosdb_sql_role_definition":  resourceCosmosDbSQLRoleDefinition(),
 		"azurerm_cosmosdb_sql_stored_procedure": resourceCosmosDbSQLStoredProcedure(),
 		"azurerm_cosmosdb_sql_trigger":          resourceCosmosDbSQLTrigger(),
 		"azurerm_cosmosdb_table":                resourceCosmosDbTable(),
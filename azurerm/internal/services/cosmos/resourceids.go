@@ -14,3 +14,6 @@ package cosmos
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SqlStoredProcedure -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlDatabases/db1/containers/container1/storedProcedures/sproc1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SqlTrigger -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.DocumentDB/databaseAccounts/account1/sqlDatabases/database1/containers/container1/triggers/trigger1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Table -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/tables/table1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SqlRoleAssignment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlRoleAssignments/9e007587-dbcd-4190-84cb-fcab5a09ebd7
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SqlRoleDefinition -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/sqlRoleDefinitions/9e007587-dbcd-4190-84cb-fcab5a09ebd7
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=RestorableDatabaseAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.DocumentDB/locations/westus/restorableDatabaseAccounts/4a8ccad8-4f69-4b5c-88ea-f38cd8a1c5e3
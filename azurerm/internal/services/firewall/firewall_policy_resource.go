@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,6 +15,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/validate"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
@@ -35,6 +39,8 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -166,6 +172,168 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 				},
 			},
 
+			"intrusion_detection": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
+								string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
+								string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+							}, false),
+						},
+						"signature_overrides": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"state": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.FirewallPolicyIntrusionDetectionStateTypeOff),
+											string(network.FirewallPolicyIntrusionDetectionStateTypeAlert),
+											string(network.FirewallPolicyIntrusionDetectionStateTypeDeny),
+										}, false),
+									},
+								},
+							},
+						},
+						"traffic_bypass": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"protocol": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.FirewallPolicyIntrusionDetectionProtocolICMP),
+											string(network.FirewallPolicyIntrusionDetectionProtocolANY),
+											string(network.FirewallPolicyIntrusionDetectionProtocolTCP),
+											string(network.FirewallPolicyIntrusionDetectionProtocolUDP),
+										}, false),
+									},
+									"description": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"destination_addresses": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"destination_ip_groups": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"destination_ports": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"source_addresses": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"source_ip_groups": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ResourceIdentityTypeUserAssigned),
+							}, false),
+						},
+						"user_assigned_identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: msiValidate.UserAssignedIdentityID,
+							},
+						},
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tls_certificate": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+						},
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"private_ip_ranges": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -210,7 +378,10 @@ func resourceFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
 			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
 			DNSSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			IntrusionDetection:   expandFirewallPolicyIntrusionDetection(d.Get("intrusion_detection").([]interface{})),
+			TransportSecurity:    expandFirewallPolicyTransportSecurity(d.Get("tls_certificate").([]interface{})),
 		},
+		Identity: expandFirewallPolicyIdentity(d.Get("identity").([]interface{})),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -308,6 +479,14 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf(`setting "rule_collection_groups": %+v`, err)
 		}
 
+		if err := d.Set("intrusion_detection", flattenFirewallPolicyIntrusionDetection(prop.IntrusionDetection)); err != nil {
+			return fmt.Errorf(`setting "intrusion_detection": %+v`, err)
+		}
+
+		if err := d.Set("tls_certificate", flattenFirewallPolicyTransportSecurity(prop.TransportSecurity)); err != nil {
+			return fmt.Errorf(`setting "tls_certificate": %+v`, err)
+		}
+
 		var privateIpRanges []interface{}
 		if prop.Snat != nil {
 			privateIpRanges = utils.FlattenStringSlice(prop.Snat.PrivateRanges)
@@ -317,9 +496,36 @@ func resourceFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	identity, err := flattenFirewallPolicyIdentity(resp.Identity)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("identity", identity); err != nil {
+		return fmt.Errorf(`setting "identity": %+v`, err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+func resourceFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the `sku` defaults to `Standard` when it's not specified
+	if d.Get("sku").(string) != string(network.FirewallPolicySkuTierPremium) {
+		for _, key := range []string{"intrusion_detection", "tls_certificate"} {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+				return fmt.Errorf("`%s` can only be specified when `sku` is set to `%s`", key, string(network.FirewallPolicySkuTierPremium))
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("tls_certificate"); ok && len(v.([]interface{})) > 0 {
+		if identity, ok := d.GetOk("identity"); !ok || len(identity.([]interface{})) == 0 {
+			return fmt.Errorf("an `identity` block must be specified when `tls_certificate` is set")
+		}
+	}
+
+	return nil
+}
+
 func resourceFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -406,3 +612,188 @@ func flattenFirewallPolicyDNSSetting(input *network.DNSSettings) []interface{} {
 		},
 	}
 }
+
+func expandFirewallPolicyIntrusionDetection(input []interface{}) *network.FirewallPolicyIntrusionDetection {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	signatureOverrides := make([]network.FirewallPolicyIntrusionDetectionSignatureSpecification, 0)
+	for _, v := range raw["signature_overrides"].([]interface{}) {
+		overrides := v.(map[string]interface{})
+		signatureOverrides = append(signatureOverrides, network.FirewallPolicyIntrusionDetectionSignatureSpecification{
+			ID:   utils.String(overrides["id"].(string)),
+			Mode: network.FirewallPolicyIntrusionDetectionStateType(overrides["state"].(string)),
+		})
+	}
+
+	trafficBypass := make([]network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications, 0)
+	for _, v := range raw["traffic_bypass"].([]interface{}) {
+		bypass := v.(map[string]interface{})
+		trafficBypass = append(trafficBypass, network.FirewallPolicyIntrusionDetectionBypassTrafficSpecifications{
+			Name:                 utils.String(bypass["name"].(string)),
+			Description:          utils.String(bypass["description"].(string)),
+			Protocol:             network.FirewallPolicyIntrusionDetectionProtocol(bypass["protocol"].(string)),
+			SourceAddresses:      utils.ExpandStringSlice(bypass["source_addresses"].(*pluginsdk.Set).List()),
+			DestinationAddresses: utils.ExpandStringSlice(bypass["destination_addresses"].(*pluginsdk.Set).List()),
+			DestinationPorts:     utils.ExpandStringSlice(bypass["destination_ports"].(*pluginsdk.Set).List()),
+			SourceIPGroups:       utils.ExpandStringSlice(bypass["source_ip_groups"].(*pluginsdk.Set).List()),
+			DestinationIPGroups:  utils.ExpandStringSlice(bypass["destination_ip_groups"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &network.FirewallPolicyIntrusionDetection{
+		Mode: network.FirewallPolicyIntrusionDetectionStateType(raw["mode"].(string)),
+		Configuration: &network.FirewallPolicyIntrusionDetectionConfiguration{
+			SignatureOverrides:    &signatureOverrides,
+			BypassTrafficSettings: &trafficBypass,
+		},
+	}
+}
+
+func expandFirewallPolicyTransportSecurity(input []interface{}) *network.FirewallPolicyTransportSecurity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &network.FirewallPolicyTransportSecurity{
+		CertificateAuthority: &network.FirewallPolicyCertificateAuthority{
+			KeyVaultSecretID: utils.String(raw["key_vault_secret_id"].(string)),
+			Name:             utils.String(raw["name"].(string)),
+		},
+	}
+}
+
+func expandFirewallPolicyIdentity(input []interface{}) *network.ManagedServiceIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return &network.ManagedServiceIdentity{
+			Type: network.ResourceIdentityTypeNone,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	identityIds := make(map[string]*network.ManagedServiceIdentityUserAssignedIdentitiesValue)
+	for _, id := range raw["user_assigned_identity_ids"].(*pluginsdk.Set).List() {
+		identityIds[id.(string)] = &network.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	}
+
+	return &network.ManagedServiceIdentity{
+		Type:                   network.ResourceIdentityType(raw["type"].(string)),
+		UserAssignedIdentities: identityIds,
+	}
+}
+
+func flattenFirewallPolicyIntrusionDetection(input *network.FirewallPolicyIntrusionDetection) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	signatureOverrides := make([]interface{}, 0)
+	trafficBypass := make([]interface{}, 0)
+	if config := input.Configuration; config != nil {
+		if config.SignatureOverrides != nil {
+			for _, v := range *config.SignatureOverrides {
+				id := ""
+				if v.ID != nil {
+					id = *v.ID
+				}
+				signatureOverrides = append(signatureOverrides, map[string]interface{}{
+					"id":    id,
+					"state": string(v.Mode),
+				})
+			}
+		}
+
+		if config.BypassTrafficSettings != nil {
+			for _, v := range *config.BypassTrafficSettings {
+				name := ""
+				if v.Name != nil {
+					name = *v.Name
+				}
+				description := ""
+				if v.Description != nil {
+					description = *v.Description
+				}
+				trafficBypass = append(trafficBypass, map[string]interface{}{
+					"name":                  name,
+					"description":           description,
+					"protocol":              string(v.Protocol),
+					"source_addresses":      utils.FlattenStringSlice(v.SourceAddresses),
+					"destination_addresses": utils.FlattenStringSlice(v.DestinationAddresses),
+					"destination_ports":     utils.FlattenStringSlice(v.DestinationPorts),
+					"source_ip_groups":      utils.FlattenStringSlice(v.SourceIPGroups),
+					"destination_ip_groups": utils.FlattenStringSlice(v.DestinationIPGroups),
+				})
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                string(input.Mode),
+			"signature_overrides": signatureOverrides,
+			"traffic_bypass":      trafficBypass,
+		},
+	}
+}
+
+func flattenFirewallPolicyTransportSecurity(input *network.FirewallPolicyTransportSecurity) []interface{} {
+	if input == nil || input.CertificateAuthority == nil {
+		return []interface{}{}
+	}
+
+	keyVaultSecretId := ""
+	if input.CertificateAuthority.KeyVaultSecretID != nil {
+		keyVaultSecretId = *input.CertificateAuthority.KeyVaultSecretID
+	}
+
+	name := ""
+	if input.CertificateAuthority.Name != nil {
+		name = *input.CertificateAuthority.Name
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_secret_id": keyVaultSecretId,
+			"name":                name,
+		},
+	}
+}
+
+func flattenFirewallPolicyIdentity(input *network.ManagedServiceIdentity) ([]interface{}, error) {
+	if input == nil || input.Type == network.ResourceIdentityTypeNone {
+		return []interface{}{}, nil
+	}
+
+	identityIds := make([]interface{}, 0)
+	for key := range input.UserAssignedIdentities {
+		parsedId, err := msiParse.UserAssignedIdentityID(key)
+		if err != nil {
+			return nil, err
+		}
+		identityIds = append(identityIds, parsedId.ID())
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                       string(input.Type),
+			"user_assigned_identity_ids": identityIds,
+			"principal_id":               principalId,
+			"tenant_id":                  tenantId,
+		},
+	}, nil
+}
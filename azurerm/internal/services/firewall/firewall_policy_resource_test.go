@@ -3,6 +3,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
@@ -61,6 +62,33 @@ func TestAccFirewallPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_completePremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.completePremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicy_premiumFeaturesRequirePremiumSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.intrusionDetectionStandard(data),
+			ExpectError: regexp.MustCompile("`intrusion_detection` can only be specified when `sku` is set to `Premium`"),
+		},
+	})
+}
+
 func TestAccFirewallPolicy_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
 	r := FirewallPolicyResource{}
@@ -187,6 +215,75 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) completePremium(data acceptance.TestData) string {
+	template := FirewallPolicyResource{}.templatePremium(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy" "test" {
+  name                     = "acctest-networkfw-Policy-%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku                      = "Premium"
+  threat_intelligence_mode = "Off"
+  threat_intelligence_allowlist {
+    ip_addresses = ["1.1.1.1", "2.2.2.2"]
+    fqdns        = ["foo.com", "bar.com"]
+  }
+  dns {
+    servers       = ["1.1.1.1", "2.2.2.2"]
+    proxy_enabled = true
+  }
+  intrusion_detection {
+    mode = "Alert"
+    signature_overrides {
+      state = "Alert"
+      id    = "1"
+    }
+    traffic_bypass {
+      name                  = "Name bypass traffic settings"
+      description           = "Description bypass traffic settings"
+      protocol              = "ANY"
+      destination_addresses = ["1.1.1.1"]
+      source_addresses      = ["1.1.1.1"]
+      destination_ports     = ["*"]
+    }
+  }
+  identity {
+    type                       = "UserAssigned"
+    user_assigned_identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+  tls_certificate {
+    key_vault_secret_id = azurerm_key_vault_certificate.test.secret_id
+    name                = azurerm_key_vault_certificate.test.name
+  }
+  private_ip_ranges = ["172.16.0.0/12", "192.168.0.0/16"]
+  tags = {
+    env = "Test"
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.identity]
+}
+`, template, data.RandomInteger)
+}
+
+func (FirewallPolicyResource) intrusionDetectionStandard(data acceptance.TestData) string {
+	template := FirewallPolicyResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard"
+  intrusion_detection {
+    mode = "Alert"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -243,3 +340,88 @@ resource "azurerm_resource_group" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (FirewallPolicyResource) templatePremium(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-networkfw-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_key_vault_access_policy" "current" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  certificate_permissions = ["Create", "Delete", "Get", "Purge", "Update"]
+  secret_permissions      = ["Get", "Delete", "Purge", "Set"]
+}
+
+resource "azurerm_key_vault_access_policy" "identity" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = azurerm_user_assigned_identity.test.principal_id
+
+  certificate_permissions = ["Get"]
+  secret_permissions      = ["Get"]
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      extended_key_usage = ["1.3.6.1.5.5.7.3.1"]
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+      subject            = "CN=acctest-firewall-ca"
+      validity_in_months = 12
+    }
+  }
+
+  depends_on = [azurerm_key_vault_access_policy.current]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -1,6 +1,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -152,6 +153,26 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 											ValidateFunc: validation.StringIsNotEmpty,
 										},
 									},
+									"destination_urls": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+									"terminate_tls": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+									},
+									"web_categories": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
 								},
 							},
 						},
@@ -372,6 +393,8 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(firewallPolicyRuleCollectionGroupCustomizeDiff),
 	}
 }
 
@@ -551,6 +574,54 @@ func expandFirewallPolicyFilterRuleCollection(input []interface{}, f func(input
 	return result
 }
 
+// firewallPolicyRuleCollectionGroupCustomizeDiff ensures the Firewall Policy has a Premium SKU when any of the
+// application rules use Premium features. When the Firewall Policy isn't known at plan time (e.g. it's being created
+// in the same apply) this can't be checked until the API rejects the Rule Collection Group during the apply.
+func firewallPolicyRuleCollectionGroupCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !firewallPolicyApplicationRulesRequirePremium(diff.Get("application_rule_collection").(*pluginsdk.Set).List()) {
+		return nil
+	}
+
+	if !diff.NewValueKnown("firewall_policy_id") {
+		return nil
+	}
+
+	policyId, err := parse.FirewallPolicyID(diff.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := v.(*clients.Client).Firewall.FirewallPolicyClient
+	policy, err := client.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(policy.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *policyId, err)
+	}
+
+	if props := policy.FirewallPolicyPropertiesFormat; props == nil || props.Sku == nil || props.Sku.Tier != network.FirewallPolicySkuTierPremium {
+		return fmt.Errorf("`terminate_tls` and `destination_urls` within an `application_rule_collection` can only be used when the Firewall Policy has a `Premium` SKU")
+	}
+
+	return nil
+}
+
+// firewallPolicyApplicationRulesRequirePremium returns whether any of the application rules use TLS inspection
+// or URL filtering, both of which are only available for Premium Firewall Policies
+func firewallPolicyApplicationRulesRequirePremium(input []interface{}) bool {
+	for _, e := range input {
+		collection := e.(map[string]interface{})
+		for _, r := range collection["rule"].(*pluginsdk.Set).List() {
+			rule := r.(map[string]interface{})
+			if rule["terminate_tls"].(bool) || rule["destination_urls"].(*pluginsdk.Set).Len() > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func expandFirewallPolicyRuleApplication(input []interface{}) *[]network.BasicFirewallPolicyRule {
 	result := make([]network.BasicFirewallPolicyRule, 0)
 	for _, e := range input {
@@ -571,6 +642,9 @@ func expandFirewallPolicyRuleApplication(input []interface{}) *[]network.BasicFi
 			SourceIPGroups:  utils.ExpandStringSlice(condition["source_ip_groups"].(*pluginsdk.Set).List()),
 			TargetFqdns:     utils.ExpandStringSlice(condition["destination_fqdns"].(*pluginsdk.Set).List()),
 			FqdnTags:        utils.ExpandStringSlice(condition["destination_fqdn_tags"].(*pluginsdk.Set).List()),
+			TargetUrls:      utils.ExpandStringSlice(condition["destination_urls"].(*pluginsdk.Set).List()),
+			TerminateTLS:    utils.Bool(condition["terminate_tls"].(bool)),
+			WebCategories:   utils.ExpandStringSlice(condition["web_categories"].(*pluginsdk.Set).List()),
 		}
 		result = append(result, output)
 	}
@@ -753,6 +827,11 @@ func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRu
 			}
 		}
 
+		var terminateTLS bool
+		if rule.TerminateTLS != nil {
+			terminateTLS = *rule.TerminateTLS
+		}
+
 		output = append(output, map[string]interface{}{
 			"name":                  name,
 			"protocols":             protocols,
@@ -760,6 +839,9 @@ func flattenFirewallPolicyRuleApplication(input *[]network.BasicFirewallPolicyRu
 			"source_ip_groups":      utils.FlattenStringSlice(rule.SourceIPGroups),
 			"destination_fqdns":     utils.FlattenStringSlice(rule.TargetFqdns),
 			"destination_fqdn_tags": utils.FlattenStringSlice(rule.FqdnTags),
+			"destination_urls":      utils.FlattenStringSlice(rule.TargetUrls),
+			"terminate_tls":         terminateTLS,
+			"web_categories":        utils.FlattenStringSlice(rule.WebCategories),
 		})
 	}
 
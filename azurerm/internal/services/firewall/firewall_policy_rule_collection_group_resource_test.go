@@ -46,6 +46,21 @@ func TestAccFirewallPolicyRuleCollectionGroup_complete(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_applicationRulePremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.applicationRulePremium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccFirewallPolicyRuleCollectionGroup_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
 	r := FirewallPolicyRuleCollectionGroupResource{}
@@ -274,6 +289,43 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) applicationRulePremium(data acceptance.TestData) string {
+	template := FirewallPolicyResource{}.completePremium(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      destination_urls = ["www.example.com/path"]
+      terminate_tls    = true
+    }
+    rule {
+      name = "app_rule_collection1_rule2"
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses = ["10.0.0.1"]
+      web_categories   = ["News"]
+    }
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
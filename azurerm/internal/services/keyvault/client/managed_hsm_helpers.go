@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	resourcesClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// BaseUriForManagedHSM returns the Data Plane URI for the specified Managed HSM
+func (c *Client) BaseUriForManagedHSM(ctx context.Context, managedHsmId parse.ManagedHSMId) (*string, error) {
+	resp, err := c.ManagedHsmClient.Get(ctx, managedHsmId.ResourceGroup, managedHsmId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("%s was not found", managedHsmId)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", managedHsmId, err)
+	}
+
+	if resp.Properties == nil || resp.Properties.HsmURI == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.HsmUri` was nil", managedHsmId)
+	}
+
+	return resp.Properties.HsmURI, nil
+}
+
+// ManagedHSMIDFromBaseUrl returns the Resource ID of the Managed HSM with the specified Data Plane URI - or nil
+// if it wasn't found
+func (c *Client) ManagedHSMIDFromBaseUrl(ctx context.Context, resourcesClient *resourcesClient.Client, managedHsmBaseUrl string) (*string, error) {
+	managedHsmName, err := c.parseManagedHSMNameFromBaseUrl(managedHsmBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := fmt.Sprintf("resourceType eq 'Microsoft.KeyVault/managedHSMs' and name eq '%s'", *managedHsmName)
+	result, err := resourcesClient.ResourcesClient.List(ctx, filter, "", utils.Int32(5))
+	if err != nil {
+		return nil, fmt.Errorf("listing resources matching %q: %+v", filter, err)
+	}
+
+	for result.NotDone() {
+		for _, v := range result.Values() {
+			if v.ID == nil {
+				continue
+			}
+
+			id, err := parse.ManagedHSMID(*v.ID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %+v", *v.ID, err)
+			}
+			if !strings.EqualFold(id.Name, *managedHsmName) {
+				continue
+			}
+
+			return utils.String(id.ID()), nil
+		}
+
+		if err := result.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("iterating over results: %+v", err)
+		}
+	}
+
+	// we haven't found it, but Data Sources and Resources need to handle this error separately
+	return nil, nil
+}
+
+func (c *Client) parseManagedHSMNameFromBaseUrl(input string) (*string, error) {
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// https://the-hsm.managedhsm.azure.net
+	// https://the-hsm.managedhsm.usgovcloudapi.net
+	// https://the-hsm.managedhsm.azure.cn
+
+	segments := strings.Split(uri.Host, ".")
+	if len(segments) < 3 || segments[1] != "managedhsm" {
+		return nil, fmt.Errorf("expected a URI in the format `the-hsm-name.managedhsm.**` but got %q", uri.Host)
+	}
+	return &segments[0], nil
+}
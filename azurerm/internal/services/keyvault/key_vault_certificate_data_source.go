@@ -46,6 +46,26 @@ func dataSourceKeyVaultCertificate() *pluginsdk.Resource {
 			},
 
 			// Computed
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versionless_secret_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"resource_manager_versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"certificate_policy": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -278,8 +298,22 @@ func dataSourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.Set("version", id.Version)
+	d.Set("versionless_id", id.VersionlessID())
 	d.Set("secret_id", cert.Sid)
 
+	versionlessSecretId := ""
+	if cert.Sid != nil {
+		secretId, err := parse.ParseNestedItemID(*cert.Sid)
+		if err != nil {
+			return err
+		}
+		versionlessSecretId = secretId.VersionlessID()
+	}
+	d.Set("versionless_secret_id", versionlessSecretId)
+
+	d.Set("resource_manager_id", parse.NewCertificateVersionID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, id.Name, id.Version).ID())
+	d.Set("resource_manager_versionless_id", parse.NewCertificateID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, id.Name).ID())
+
 	certificateData := ""
 	if contents := cert.Cer; contents != nil {
 		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
@@ -1,10 +1,12 @@
 package keyvault
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"math"
@@ -27,9 +29,9 @@ import (
 
 func resourceKeyVaultCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		// TODO: support Updating once we have more information about what can be updated
 		Create: resourceKeyVaultCertificateCreate,
 		Read:   resourceKeyVaultCertificateRead,
+		Update: resourceKeyVaultCertificateUpdate,
 		Delete: resourceKeyVaultCertificateDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
@@ -40,6 +42,7 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -65,11 +68,13 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						// either a base64 encoded PKCS#12 (PFX) bundle, or a PEM bundle (optionally base64 encoded)
 						"contents": {
-							Type:      pluginsdk.TypeString,
-							Required:  true,
-							ForceNew:  true,
-							Sensitive: true,
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 						"password": {
 							Type:      pluginsdk.TypeString,
@@ -83,8 +88,8 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 
 			"certificate_policy": {
 				Type:     pluginsdk.TypeList,
-				Required: true,
-				ForceNew: true,
+				Optional: true,
+				Computed: true,
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -95,9 +100,9 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"name": {
-										Type:     pluginsdk.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
 									},
 								},
 							},
@@ -173,7 +178,6 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 												"action_type": {
 													Type:     pluginsdk.TypeString,
 													Required: true,
-													ForceNew: true,
 													ValidateFunc: validation.StringInSlice([]string{
 														string(keyvault.AutoRenew),
 														string(keyvault.EmailContacts),
@@ -190,14 +194,14 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 										Elem: &pluginsdk.Resource{
 											Schema: map[string]*pluginsdk.Schema{
 												"days_before_expiry": {
-													Type:     pluginsdk.TypeInt,
-													Optional: true,
-													ForceNew: true,
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
 												},
 												"lifetime_percentage": {
-													Type:     pluginsdk.TypeInt,
-													Optional: true,
-													ForceNew: true,
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntBetween(1, 99),
 												},
 											},
 										},
@@ -207,7 +211,8 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 						},
 						"secret_properties": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
+							Computed: true,
 							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
@@ -215,6 +220,10 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											keyVaultCertificateContentTypePKCS12,
+											keyVaultCertificateContentTypePEM,
+										}, false),
 									},
 								},
 							},
@@ -367,11 +376,41 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"secret_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"versionless_secret_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"resource_manager_versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"next_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"certificate_data": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -428,17 +467,39 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 
 	if v, ok := d.GetOk("certificate"); ok {
 		// Import
-		certificate := expandKeyVaultCertificate(v)
+		certificate, err := expandKeyVaultCertificate(v)
+		if err != nil {
+			return fmt.Errorf("expanding `certificate`: %+v", err)
+		}
+
+		if policy == nil {
+			policy = &keyvault.CertificatePolicy{}
+		}
+		if policy.SecretProperties == nil || policy.SecretProperties.ContentType == nil {
+			policy.SecretProperties = &keyvault.SecretProperties{
+				ContentType: utils.String(certificate.ContentType),
+			}
+		}
+		if *policy.SecretProperties.ContentType != certificate.ContentType {
+			return fmt.Errorf("`certificate_policy.0.secret_properties.0.content_type` must be %q since the `certificate` is in that format, but got %q", certificate.ContentType, *policy.SecretProperties.ContentType)
+		}
+
 		importParameters := keyvault.CertificateImportParameters{
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
-			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        policy,
 			Tags:                     tags.Expand(t),
 		}
+		if certificate.CertificatePassword != "" {
+			importParameters.Password = utils.String(certificate.CertificatePassword)
+		}
 		if _, err := client.ImportCertificate(ctx, *keyVaultBaseUrl, name, importParameters); err != nil {
 			return err
 		}
 	} else {
+		if policy == nil {
+			return fmt.Errorf("`certificate_policy` is required when generating a new Certificate")
+		}
+
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: policy,
@@ -503,6 +564,32 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 	return resourceKeyVaultCertificateRead(d, meta)
 }
 
+func resourceKeyVaultCertificateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("certificate_policy") {
+		policy, err := expandKeyVaultCertificatePolicy(d)
+		if err != nil {
+			return fmt.Errorf("expanding certificate policy: %s", err)
+		}
+
+		if policy != nil {
+			if _, err := client.UpdateCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name, *policy); err != nil {
+				return fmt.Errorf("updating the Policy for Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+			}
+		}
+	}
+
+	return resourceKeyVaultCertificateRead(d, meta)
+}
+
 func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
@@ -573,6 +660,18 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("Error reading Key Vault Certificate: %+v", err)
 	}
 
+	// the version changes when the Certificate is renewed, so parse the updated id
+	respID, err := parse.ParseNestedItemID(*cert.ID)
+	if err != nil {
+		return err
+	}
+
+	// the ID tracks the current version of the Certificate, so that it's consistent with `version`
+	if respID.Version != id.Version {
+		log.Printf("[DEBUG] Certificate %q (Key Vault %q) has been renewed from version %q to %q - updating the ID", id.Name, id.KeyVaultBaseUrl, id.Version, respID.Version)
+		d.SetId(respID.ID())
+	}
+
 	d.Set("name", id.Name)
 
 	certificatePolicy := flattenKeyVaultCertificatePolicy(cert.Policy, cert.Cer)
@@ -585,9 +684,27 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	// Computed
-	d.Set("version", id.Version)
+	d.Set("version", respID.Version)
+	d.Set("versionless_id", respID.VersionlessID())
 	d.Set("secret_id", cert.Sid)
 
+	versionlessSecretId := ""
+	if cert.Sid != nil {
+		secretId, err := parse.ParseNestedItemID(*cert.Sid)
+		if err != nil {
+			return err
+		}
+		versionlessSecretId = secretId.VersionlessID()
+	}
+	d.Set("versionless_secret_id", versionlessSecretId)
+
+	d.Set("resource_manager_id", parse.NewCertificateVersionID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, respID.Name, respID.Version).ID())
+	d.Set("resource_manager_versionless_id", parse.NewCertificateID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, respID.Name).ID())
+
+	lastRenewalTime, nextRenewalTime := flattenKeyVaultCertificateRenewalTimes(cert.Attributes, cert.Policy)
+	d.Set("last_renewal_time", lastRenewalTime)
+	d.Set("next_renewal_time", nextRenewalTime)
+
 	certificateData := ""
 	if contents := cert.Cer; contents != nil {
 		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
@@ -692,6 +809,9 @@ func (d deleteAndPurgeCertificate) NestedItemHasBeenPurged(ctx context.Context)
 
 func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.CertificatePolicy, error) {
 	policies := d.Get("certificate_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil, nil
+	}
 	policyRaw := policies[0].(map[string]interface{})
 	policy := keyvault.CertificatePolicy{}
 
@@ -773,10 +893,11 @@ func expandKeyVaultCertificatePolicy(d *pluginsdk.ResourceData) (*keyvault.Certi
 	}
 	policy.LifetimeActions = &lifetimeActions
 
-	secrets := policyRaw["secret_properties"].([]interface{})
-	secret := secrets[0].(map[string]interface{})
-	policy.SecretProperties = &keyvault.SecretProperties{
-		ContentType: utils.String(secret["content_type"].(string)),
+	if secrets := policyRaw["secret_properties"].([]interface{}); len(secrets) > 0 && secrets[0] != nil {
+		secret := secrets[0].(map[string]interface{})
+		policy.SecretProperties = &keyvault.SecretProperties{
+			ContentType: utils.String(secret["content_type"].(string)),
+		}
 	}
 
 	certificateProperties := policyRaw["x509_certificate_properties"].([]interface{})
@@ -978,17 +1099,113 @@ func flattenKeyVaultCertificateAttribute(input *keyvault.CertificateAttributes)
 	}
 }
 
+// flattenKeyVaultCertificateRenewalTimes returns the time at which the current version of the Certificate was issued
+// and - when the Certificate is automatically renewed - the time at which the next version will be issued
+func flattenKeyVaultCertificateRenewalTimes(attributes *keyvault.CertificateAttributes, policy *keyvault.CertificatePolicy) (string, string) {
+	if attributes == nil {
+		return "", ""
+	}
+
+	lastRenewalTime := ""
+	if attributes.Created != nil {
+		lastRenewalTime = time.Time(*attributes.Created).Format(time.RFC3339)
+	}
+
+	if attributes.Expires == nil || policy == nil || policy.LifetimeActions == nil {
+		return lastRenewalTime, ""
+	}
+	expires := time.Time(*attributes.Expires)
+
+	nextRenewalTime := ""
+	for _, action := range *policy.LifetimeActions {
+		if action.Action == nil || action.Action.ActionType != keyvault.AutoRenew || action.Trigger == nil {
+			continue
+		}
+
+		if v := action.Trigger.DaysBeforeExpiry; v != nil {
+			nextRenewalTime = expires.AddDate(0, 0, -int(*v)).Format(time.RFC3339)
+		}
+
+		if v := action.Trigger.LifetimePercentage; v != nil && attributes.NotBefore != nil {
+			notBefore := time.Time(*attributes.NotBefore)
+			lifetime := expires.Sub(notBefore)
+			nextRenewalTime = notBefore.Add(lifetime / 100 * time.Duration(*v)).Format(time.RFC3339)
+		}
+	}
+
+	return lastRenewalTime, nextRenewalTime
+}
+
+const (
+	keyVaultCertificateContentTypePKCS12 = "application/x-pkcs12"
+	keyVaultCertificateContentTypePEM    = "application/x-pem-file"
+)
+
 type KeyVaultCertificateImportParameters struct {
 	CertificateData     string
 	CertificatePassword string
+	ContentType         string
 }
 
-func expandKeyVaultCertificate(v interface{}) KeyVaultCertificateImportParameters {
+func expandKeyVaultCertificate(v interface{}) (*KeyVaultCertificateImportParameters, error) {
 	certs := v.([]interface{})
 	cert := certs[0].(map[string]interface{})
 
-	return KeyVaultCertificateImportParameters{
-		CertificateData:     cert["contents"].(string),
+	data, contentType, err := keyVaultCertificateImportContents(cert["contents"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultCertificateImportParameters{
+		CertificateData:     *data,
 		CertificatePassword: cert["password"].(string),
+		ContentType:         contentType,
+	}, nil
+}
+
+// keyVaultCertificateImportContents determines whether the specified contents are a PKCS#12 (PFX) bundle or a PEM bundle
+// (which can be base64 encoded, and include the certificate chain) - returning the value to be sent to Key Vault and
+// the Content Type matching this format
+func keyVaultCertificateImportContents(input string) (*string, string, error) {
+	contents := strings.TrimSpace(input)
+	if !strings.Contains(contents, "-----BEGIN") {
+		// strip any line breaks, since base64 encoded files are commonly wrapped
+		contents = strings.Join(strings.Fields(contents), "")
+		decoded, err := base64.StdEncoding.DecodeString(contents)
+		if err != nil {
+			return nil, "", fmt.Errorf("`contents` must be either a PEM bundle or a base64 encoded PKCS#12 or PEM bundle: %+v", err)
+		}
+
+		if !bytes.Contains(decoded, []byte("-----BEGIN")) {
+			return &contents, keyVaultCertificateContentTypePKCS12, nil
+		}
+		contents = strings.TrimSpace(string(decoded))
 	}
+
+	certificates := 0
+	privateKeys := 0
+	rest := []byte(contents)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		switch {
+		case block.Type == "CERTIFICATE":
+			certificates++
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			privateKeys++
+		}
+	}
+
+	if certificates == 0 {
+		return nil, "", fmt.Errorf("the PEM bundle must contain at least one certificate")
+	}
+	if privateKeys != 1 {
+		return nil, "", fmt.Errorf("the PEM bundle must contain exactly one private key but got %d", privateKeys)
+	}
+
+	return &contents, keyVaultCertificateContentTypePEM, nil
 }
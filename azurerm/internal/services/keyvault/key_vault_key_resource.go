@@ -5,14 +5,17 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/keyrotationpolicies"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -21,6 +24,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// keyVaultKeyTypeOctHSM is a Symmetric Key, which is only supported within a Managed HSM
+const keyVaultKeyTypeOctHSM = keyvault.JSONWebKeyType("oct-HSM")
+
 func resourceKeyVaultKey() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create:   resourceKeyVaultKeyCreate,
@@ -74,23 +80,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ConflictsWith: []string{"curve"},
 			},
 
-			"key_opts": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				Elem: &pluginsdk.Schema{
-					Type: pluginsdk.TypeString,
-					// turns out Azure's *really* sensitive about the casing of these
-					// issue: https://github.com/Azure/azure-rest-api-specs/issues/1739
-					ValidateFunc: validation.StringInSlice([]string{
-						string(keyvault.Decrypt),
-						string(keyvault.Encrypt),
-						string(keyvault.Sign),
-						string(keyvault.UnwrapKey),
-						string(keyvault.Verify),
-						string(keyvault.WrapKey),
-					}, false),
-				},
-			},
+			"key_opts": schemaKeyVaultKeyOptions(),
 
 			"curve": {
 				Type:     pluginsdk.TypeString,
@@ -125,6 +115,61 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							AtLeastOneOf: []string{
+								"rotation_policy.0.expire_after",
+								"rotation_policy.0.automatic",
+							},
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							RequiredWith: []string{"rotation_policy.0.expire_after"},
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -164,6 +209,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -206,15 +252,8 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Tags: tags.Expand(t),
 	}
 
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
-		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
-		keySize, ok := d.GetOk("key_size")
-		if !ok {
-			return fmt.Errorf("Key size is required when creating an RSA key")
-		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	if err := expandKeyVaultKeySizeAndCurve(d, &parameters); err != nil {
+		return err
 	}
 	// TODO: support `oct` once this is fixed
 	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
@@ -259,6 +298,17 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v := d.Get("rotation_policy").([]interface{}); len(v) > 0 {
+		policy, err := expandKeyVaultKeyRotationPolicy(v)
+		if err != nil {
+			return err
+		}
+
+		if _, err := rotationPoliciesClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, *policy); err != nil {
+			return fmt.Errorf("setting Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -273,6 +323,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -332,12 +383,25 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		// removing the `rotation_policy` block resets the policy to one without any actions
+		policy, err := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		if _, err := rotationPoliciesClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, *policy); err != nil {
+			return fmt.Errorf("updating Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
 func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	rotationPoliciesClient := meta.(*clients.Client).KeyVault.KeyRotationPoliciesClient
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -384,27 +448,8 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("name", id.Name)
 
-	if key := resp.Key; key != nil {
-		d.Set("key_type", string(key.Kty))
-
-		options := flattenKeyVaultKeyOptions(key.KeyOps)
-		if err := d.Set("key_opts", options); err != nil {
-			return err
-		}
-
-		d.Set("n", key.N)
-		d.Set("e", key.E)
-		d.Set("x", key.X)
-		d.Set("y", key.Y)
-		if key.N != nil {
-			nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
-			if err != nil {
-				return fmt.Errorf("Could not decode N: %+v", err)
-			}
-			d.Set("key_size", len(nBytes)*8)
-		}
-
-		d.Set("curve", key.Crv)
+	if err := flattenKeyVaultKeyMaterial(d, resp.Key); err != nil {
+		return err
 	}
 
 	if attributes := resp.Attributes; attributes != nil {
@@ -417,6 +462,21 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	policy, err := rotationPoliciesClient.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// the `GetRotationPolicy` permission isn't granted by existing Access Policies (and Rotation Policies aren't
+		// available in every cloud) - so we don't require it unless the Rotation Policy is managed by Terraform
+		if len(d.Get("rotation_policy").([]interface{})) > 0 {
+			return fmt.Errorf("retrieving Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+		log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for Key %q (Key Vault %q) - skipping since `rotation_policy` isn't specified: %+v", id.Name, id.KeyVaultBaseUrl, err)
+	} else {
+		if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(policy.Model)); err != nil {
+			return fmt.Errorf("setting `rotation_policy`: %+v", err)
+		}
+	}
+
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
@@ -499,6 +559,81 @@ func (d deleteAndPurgeKey) NestedItemHasBeenPurged(ctx context.Context) (autores
 	return resp.Response, err
 }
 
+func schemaKeyVaultKeyOptions() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+			// turns out Azure's *really* sensitive about the casing of these
+			// issue: https://github.com/Azure/azure-rest-api-specs/issues/1739
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.Decrypt),
+				string(keyvault.Encrypt),
+				string(keyvault.Sign),
+				string(keyvault.UnwrapKey),
+				string(keyvault.Verify),
+				string(keyvault.WrapKey),
+			}, false),
+		},
+	}
+}
+
+// expandKeyVaultKeySizeAndCurve sets the `curve` for EC Keys and the `key_size` for RSA and Symmetric Keys
+func expandKeyVaultKeySizeAndCurve(d *pluginsdk.ResourceData, parameters *keyvault.KeyCreateParameters) error {
+	switch parameters.Kty {
+	case keyvault.EC, keyvault.ECHSM:
+		curveName := d.Get("curve").(string)
+		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
+
+	case keyvault.RSA, keyvault.RSAHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return fmt.Errorf("Key size is required when creating an RSA key")
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+
+	case keyVaultKeyTypeOctHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return fmt.Errorf("Key size is required when creating an oct-HSM key")
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	}
+
+	return nil
+}
+
+// flattenKeyVaultKeyMaterial sets the type, operations and public key material of a Key into the state
+func flattenKeyVaultKeyMaterial(d *pluginsdk.ResourceData, key *keyvault.JSONWebKey) error {
+	if key == nil {
+		return nil
+	}
+
+	d.Set("key_type", string(key.Kty))
+
+	options := flattenKeyVaultKeyOptions(key.KeyOps)
+	if err := d.Set("key_opts", options); err != nil {
+		return err
+	}
+
+	d.Set("n", key.N)
+	d.Set("e", key.E)
+	d.Set("x", key.X)
+	d.Set("y", key.Y)
+	if key.N != nil {
+		nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
+		if err != nil {
+			return fmt.Errorf("Could not decode N: %+v", err)
+		}
+		d.Set("key_size", len(nBytes)*8)
+	}
+
+	d.Set("curve", key.Crv)
+
+	return nil
+}
+
 func expandKeyVaultKeyOptions(d *pluginsdk.ResourceData) *[]keyvault.JSONWebKeyOperation {
 	options := d.Get("key_opts").([]interface{})
 	results := make([]keyvault.JSONWebKeyOperation, 0, len(options))
@@ -519,3 +654,112 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 
 	return results
 }
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) (*keyrotationpolicies.KeyRotationPolicy, error) {
+	lifetimeActions := make([]keyrotationpolicies.LifetimeAction, 0)
+	policy := keyrotationpolicies.KeyRotationPolicy{
+		Attributes:      &keyrotationpolicies.KeyRotationPolicyAttributes{},
+		LifetimeActions: &lifetimeActions,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &policy, nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	expireAfter := raw["expire_after"].(string)
+	if expireAfter != "" {
+		policy.Attributes.ExpiryTime = utils.String(expireAfter)
+	}
+
+	if v := raw["notify_before_expiry"].(string); v != "" {
+		notify := keyrotationpolicies.ActionTypeNotify
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeAction{
+			Action: &keyrotationpolicies.LifetimeActionType{
+				Type: &notify,
+			},
+			Trigger: &keyrotationpolicies.LifetimeActionTrigger{
+				TimeBeforeExpiry: utils.String(v),
+			},
+		})
+	}
+
+	if v := raw["automatic"].([]interface{}); len(v) > 0 && v[0] != nil {
+		automatic := v[0].(map[string]interface{})
+		trigger := keyrotationpolicies.LifetimeActionTrigger{}
+		if timeAfterCreation := automatic["time_after_creation"].(string); timeAfterCreation != "" {
+			trigger.TimeAfterCreate = utils.String(timeAfterCreation)
+		}
+		if timeBeforeExpiry := automatic["time_before_expiry"].(string); timeBeforeExpiry != "" {
+			if expireAfter == "" {
+				return nil, fmt.Errorf("`expire_after` must be specified when `time_before_expiry` is set within the `automatic` block")
+			}
+			trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+		}
+
+		rotate := keyrotationpolicies.ActionTypeRotate
+		lifetimeActions = append(lifetimeActions, keyrotationpolicies.LifetimeAction{
+			Action: &keyrotationpolicies.LifetimeActionType{
+				Type: &rotate,
+			},
+			Trigger: &trigger,
+		})
+	}
+
+	return &policy, nil
+}
+
+func flattenKeyVaultKeyRotationPolicy(input *keyrotationpolicies.KeyRotationPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Action.Type == nil || action.Trigger == nil {
+				continue
+			}
+
+			timeAfterCreation := ""
+			if action.Trigger.TimeAfterCreate != nil {
+				timeAfterCreation = *action.Trigger.TimeAfterCreate
+			}
+			timeBeforeExpiry := ""
+			if action.Trigger.TimeBeforeExpiry != nil {
+				timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+			}
+
+			switch {
+			case strings.EqualFold(string(*action.Action.Type), string(keyrotationpolicies.ActionTypeNotify)):
+				notifyBeforeExpiry = timeBeforeExpiry
+
+			case strings.EqualFold(string(*action.Action.Type), string(keyrotationpolicies.ActionTypeRotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreation,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// Key Vault returns a default policy (which notifies 30 days before expiry) when one hasn't been configured,
+	// which isn't applicable without an expiry
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+			"automatic":            automatic,
+		},
+	}
+}
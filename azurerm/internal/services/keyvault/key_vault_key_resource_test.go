@@ -203,6 +203,62 @@ func TestAccKeyVaultKey_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_before_expiry").HasValue("P30D"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultKey_rotationPolicyUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicyUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").IsEmpty(),
+				check.That(data.ResourceName).Key("rotation_policy.0.automatic.0.time_after_creation").HasValue("P60D"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKeyVaultKey_updatedExternally(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -484,6 +540,73 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) rotationPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "P90D"
+    notify_before_expiry = "P29D"
+
+    automatic {
+      time_before_expiry = "P30D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
+func (r KeyVaultKeyResource) rotationPolicyUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    automatic {
+      time_after_creation = "P60D"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) basicRSAHSM(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -832,8 +955,10 @@ resource "azurerm_key_vault" "test" {
       "Create",
       "Delete",
       "Get",
+      "GetRotationPolicy",
       "Purge",
       "Recover",
+      "SetRotationPolicy",
       "Update",
     ]
 
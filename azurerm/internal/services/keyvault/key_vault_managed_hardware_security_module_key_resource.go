@@ -0,0 +1,316 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleKey() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleKeyCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleKeyRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleKeyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseNestedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemName,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"key_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.ECHSM),
+					string(keyvault.RSAHSM),
+					string(keyVaultKeyTypeOctHSM),
+				}, false),
+			},
+
+			"key_size": {
+				Type:          pluginsdk.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.IntInSlice([]int{128, 192, 256, 2048, 3072, 4096}),
+				ConflictsWith: []string{"curve"},
+			},
+
+			"key_opts": schemaKeyVaultKeyOptions(),
+
+			"curve": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.P256),
+					string(keyvault.P256K),
+					string(keyvault.P384),
+					string(keyvault.P521),
+				}, false),
+				ConflictsWith: []string{"key_size"},
+			},
+
+			"not_before_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"expiration_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Computed
+			"version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"versionless_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"n": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"x": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"y": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	managedHsmBaseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Base URI for Key %q within %s: %+v", name, *managedHsmId, err)
+	}
+
+	existing, err := client.GetKey(ctx, *managedHsmBaseUri, name, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing Key %q (Managed HSM %q): %+v", name, *managedHsmBaseUri, err)
+		}
+	}
+	if existing.Key != nil && existing.Key.Kid != nil && *existing.Key.Kid != "" {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_key", *existing.Key.Kid)
+	}
+
+	parameters := keyvault.KeyCreateParameters{
+		Kty:    keyvault.JSONWebKeyType(d.Get("key_type").(string)),
+		KeyOps: expandKeyVaultKeyOptions(d),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := expandKeyVaultKeySizeAndCurve(d, &parameters); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		parameters.KeyAttributes.Expires = &expirationUnixTime
+	}
+
+	resp, err := client.CreateKey(ctx, *managedHsmBaseUri, name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating Key %q (Managed HSM %q): %+v", name, *managedHsmBaseUri, err)
+	}
+	if resp.Key == nil || resp.Key.Kid == nil {
+		return fmt.Errorf("creating Key %q (Managed HSM %q): `key.kid` was nil", name, *managedHsmBaseUri)
+	}
+
+	d.SetId(*resp.Key.Kid)
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := keyvault.KeyUpdateParameters{
+		KeyOps: expandKeyVaultKeyOptions(d),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		parameters.KeyAttributes.Expires = &expirationUnixTime
+	}
+
+	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+		return fmt.Errorf("updating Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	return resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID of the Managed HSM at URL %q: %+v", id.KeyVaultBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.KeyVaultBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Key %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Key %q (Managed HSM %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+
+	if err := flattenKeyVaultKeyMaterial(d, resp.Key); err != nil {
+		return err
+	}
+
+	if attributes := resp.Attributes; attributes != nil {
+		if v := attributes.NotBefore; v != nil {
+			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
+		}
+
+		if v := attributes.Expires; v != nil {
+			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
+		}
+	}
+
+	// Computed
+	d.Set("version", id.Version)
+	d.Set("versionless_id", id.VersionlessID())
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	shouldPurge := meta.(*clients.Client).Features.KeyVault.PurgeSoftDeleteOnDestroy
+	description := fmt.Sprintf("Key %q (Managed HSM %q)", id.Name, id.KeyVaultBaseUrl)
+	deleter := deleteAndPurgeKey{
+		client:      client,
+		keyVaultUri: id.KeyVaultBaseUrl,
+		name:        id.Name,
+	}
+	return deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter)
+}
@@ -0,0 +1,171 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleKeyResource struct {
+}
+
+// NOTE: these tests are run in sequence from TestAccKeyVaultManagedHardwareSecurityModule, since only
+// one Managed HSM can be provisioned at a time
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("versionless_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("n").Exists(),
+				check.That(data.ResourceName).Key("e").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagementClient.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Managed HSM Key %q: %+v", state.ID, err)
+	}
+
+	return utils.Bool(resp.Key != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctestkey-%s"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type       = "EC-HSM"
+  curve          = "P-256"
+  key_opts       = ["sign", "verify"]
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name            = "acctestkey-%s"
+  managed_hsm_id  = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type        = "RSA-HSM"
+  key_size        = 2048
+  key_opts        = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+  not_before_date = "2021-01-01T01:02:03Z"
+  expiration_date = "2031-01-01T01:02:03Z"
+
+  tags = {
+    "hello" = "world"
+  }
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name            = "acctestkey-%s"
+  managed_hsm_id  = azurerm_key_vault_managed_hardware_security_module.test.id
+  key_type        = "EC-HSM"
+  curve           = "P-256"
+  key_opts        = ["sign", "verify"]
+  expiration_date = "2031-01-01T01:02:03Z"
+
+  tags = {
+    "hello" = "world"
+  }
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data), data.RandomString)
+}
+
+func (KeyVaultManagedHardwareSecurityModuleKeyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_managed_hardware_security_module_role_definition" "crypto_user" {
+  name           = "21dbd100-6940-42c2-9190-5d6cb909625b"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "crypto_user" {
+  name               = "1e243909-064c-6ac3-84e9-1c8bf8d6ad22"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/keys"
+  role_definition_id = data.azurerm_key_vault_managed_hardware_security_module_role_definition.crypto_user.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data))
+}
@@ -1,6 +1,7 @@
 package keyvault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -25,6 +26,7 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmKeyVaultManagedHardwareSecurityModuleCreate,
 		Read:   resourceArmKeyVaultManagedHardwareSecurityModuleRead,
+		Update: resourceArmKeyVaultManagedHardwareSecurityModuleUpdate,
 		Delete: resourceArmKeyVaultManagedHardwareSecurityModuleDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -35,9 +37,27 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// once the Security Domain has been downloaded the Managed HSM is activated, at which point it can't be changed
+			if d.Get("security_domain_encrypted_data").(string) != "" && (d.HasChange("security_domain_key_vault_certificate_ids") || d.HasChange("security_domain_quorum")) {
+				return fmt.Errorf("`security_domain_key_vault_certificate_ids` and `security_domain_quorum` cannot be changed once the Managed HSM has been activated")
+			}
+
+			// the Security Domain can only be recovered when the quorum can be met by the certificates it's encrypted with
+			if d.NewValueKnown("security_domain_key_vault_certificate_ids") && d.NewValueKnown("security_domain_quorum") {
+				certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{})
+				if quorum := d.Get("security_domain_quorum").(int); len(certificateIds) > 0 && quorum > len(certificateIds) {
+					return fmt.Errorf("`security_domain_quorum` (%d) cannot be greater than the number of `security_domain_key_vault_certificate_ids` (%d)", quorum, len(certificateIds))
+				}
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -90,11 +110,36 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(7, 90),
 			},
 
+			"security_domain_key_vault_certificate_ids": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     3,
+				MaxItems:     10,
+				RequiredWith: []string{"security_domain_quorum"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NestedItemIdWithOptionalVersion,
+				},
+			},
+
+			"security_domain_quorum": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(2, 10),
+				RequiredWith: []string{"security_domain_key_vault_certificate_ids"},
+			},
+
 			"hsm_uri": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 
+			"security_domain_encrypted_data": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			// https://github.com/Azure/azure-rest-api-specs/issues/13365
 			"tags": tags.ForceNewSchema(),
 		},
@@ -149,6 +194,36 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+
+	if v := d.Get("security_domain_key_vault_certificate_ids").([]interface{}); len(v) > 0 {
+		encryptedData, err := activateKeyVaultManagedHardwareSecurityModule(ctx, d, meta, id)
+		if err != nil {
+			return err
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
+	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedHardwareSecurityModuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the only updatable fields are used to activate the Managed HSM, which the CustomizeDiff ensures happens once
+	if d.HasChanges("security_domain_key_vault_certificate_ids", "security_domain_quorum") && d.Get("security_domain_encrypted_data").(string) == "" {
+		encryptedData, err := activateKeyVaultManagedHardwareSecurityModule(ctx, d, meta, *id)
+		if err != nil {
+			return err
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
 	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
 }
 
@@ -0,0 +1,194 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roleassignments"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMDataPlaneRoleAssignmentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"scope": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMRoleScope,
+			},
+
+			"role_definition_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMRoleDefinitionID,
+			},
+
+			"principal_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	managedHsmBaseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Base URI for %s: %+v", *managedHsmId, err)
+	}
+
+	id, err := parse.NewManagedHSMDataPlaneRoleAssignmentID(*managedHsmBaseUri, d.Get("scope").(string), d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: existing.HttpResponse}) {
+			return fmt.Errorf("checking for presence of existing Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(autorest.Response{Response: existing.HttpResponse}) {
+		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_assignment", id.ID())
+	}
+
+	parameters := roleassignments.RoleAssignmentCreateParameters{
+		Properties: roleassignments.RoleAssignmentProperties{
+			PrincipalId:      d.Get("principal_id").(string),
+			RoleDefinitionId: d.Get("role_definition_id").(string),
+		},
+	}
+
+	if _, err := client.Create(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMDataPlaneRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.ManagedHSMBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID of the Managed HSM at URL %q: %+v", id.ManagedHSMBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.ManagedHSMBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			log.Printf("[DEBUG] Role Assignment %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.ManagedHSMBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+	d.Set("scope", id.Scope)
+
+	if model := resp.Model; model != nil {
+		d.Set("resource_manager_id", model.Id)
+
+		if props := model.Properties; props != nil {
+			d.Set("principal_id", props.PrincipalId)
+			d.Set("role_definition_id", props.RoleDefinitionId)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMDataPlaneRoleAssignmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			return fmt.Errorf("deleting Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct {
+}
+
+// NOTE: these tests are run in sequence from TestAccKeyVaultManagedHardwareSecurityModule, since only
+// one Managed HSM can be provisioned at a time
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_customRole(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customRole(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMDataPlaneRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmRoleAssignmentsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Role Assignment %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_managed_hardware_security_module_role_definition" "crypto_officer" {
+  name           = "515eb02d-2335-4d2d-92f2-b1cbdf9c3778"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+}
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "a9dbe818-56e7-5878-c0ce-a1477692c1d6"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/"
+  role_definition_id = data.azurerm_key_vault_managed_hardware_security_module_role_definition.crypto_officer.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data))
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) customRole(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "b2a1c7f3-5d1e-4e0a-9b6f-0c8d7e6f5a41"
+  managed_hsm_id     = azurerm_key_vault_managed_hardware_security_module.test.id
+  scope              = "/keys"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}.basic(data))
+}
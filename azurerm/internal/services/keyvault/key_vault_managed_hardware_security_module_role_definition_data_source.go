@@ -0,0 +1,172 @@
+package keyvault
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roledefinitions"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"role_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"role_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"permission": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"not_data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleDefinitionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	managedHsmBaseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Base URI for %s: %+v", *managedHsmId, err)
+	}
+
+	id, err := parse.NewManagedHSMDataPlaneRoleDefinitionID(*managedHsmBaseUri, string(roledefinitions.RoleScopeGlobal), d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			return fmt.Errorf("Role Definition %q was not found in Managed HSM %q", id.Name, id.ManagedHSMBaseUrl)
+		}
+
+		return fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("resource_manager_id", model.Id)
+
+		if props := model.Properties; props != nil {
+			d.Set("role_name", props.RoleName)
+			d.Set("description", props.Description)
+
+			roleType := ""
+			if props.Type != nil {
+				roleType = string(*props.Type)
+			}
+			d.Set("role_type", roleType)
+
+			if err := d.Set("permission", flattenKeyVaultManagedHardwareSecurityModuleRolePermissions(props.Permissions)); err != nil {
+				return fmt.Errorf("setting `permission`: %+v", err)
+			}
+
+			assignableScopes := make([]interface{}, 0)
+			if props.AssignableScopes != nil {
+				for _, scope := range *props.AssignableScopes {
+					assignableScopes = append(assignableScopes, string(scope))
+				}
+			}
+			if err := d.Set("assignable_scopes", assignableScopes); err != nil {
+				return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource struct {
+}
+
+func testAccDataSourceKeyVaultManagedHardwareSecurityModuleRoleDefinition_builtIn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.builtIn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_name").HasValue("Managed HSM Crypto User"),
+				check.That(data.ResourceName).Key("role_type").HasValue("AKVBuiltInRole"),
+				check.That(data.ResourceName).Key("permission.#").HasValue("1"),
+				check.That(data.ResourceName).Key("resource_manager_id").Exists(),
+			),
+		},
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionDataSource) builtIn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "21dbd100-6940-42c2-9190-5d6cb909625b"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data))
+}
@@ -0,0 +1,299 @@
+package keyvault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/roledefinitions"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinition() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Read:   resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead,
+		Update: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate,
+		Delete: resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagedHSMDataPlaneRoleDefinitionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"managed_hsm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.ManagedHSMID,
+			},
+
+			"role_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"permission": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"not_data_actions": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"assignable_scopes": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"resource_manager_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleDefinitionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedHsmId, err := parse.ManagedHSMID(d.Get("managed_hsm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	managedHsmBaseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, *managedHsmId)
+	if err != nil {
+		return fmt.Errorf("looking up the Base URI for %s: %+v", *managedHsmId, err)
+	}
+
+	id, err := parse.NewManagedHSMDataPlaneRoleDefinitionID(*managedHsmBaseUri, string(roledefinitions.RoleScopeGlobal), d.Get("name").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(autorest.Response{Response: existing.HttpResponse}) {
+				return fmt.Errorf("checking for presence of existing Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(autorest.Response{Response: existing.HttpResponse}) {
+			return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module_role_definition", id.ID())
+		}
+	}
+
+	roleType := roledefinitions.RoleTypeCustomRole
+	parameters := roledefinitions.RoleDefinitionCreateParameters{
+		Properties: roledefinitions.RoleDefinitionProperties{
+			AssignableScopes: &[]roledefinitions.RoleScope{
+				roledefinitions.RoleScopeGlobal,
+			},
+			Permissions: expandKeyVaultManagedHardwareSecurityModuleRolePermissions(d.Get("permission").([]interface{})),
+			RoleName:    utils.String(d.Get("role_name").(string)),
+			Type:        &roleType,
+		},
+	}
+	if v := d.Get("description").(string); v != "" {
+		parameters.Properties.Description = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name, parameters); err != nil {
+		return fmt.Errorf("creating/updating Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d, meta)
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleDefinitionsClient
+	resourcesClient := meta.(*clients.Client).Resource
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMDataPlaneRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managedHsmId, err := keyVaultsClient.ManagedHSMIDFromBaseUrl(ctx, resourcesClient, id.ManagedHSMBaseUrl)
+	if err != nil {
+		return fmt.Errorf("retrieving the Resource ID of the Managed HSM at URL %q: %+v", id.ManagedHSMBaseUrl, err)
+	}
+	if managedHsmId == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Managed HSM at URL %q - removing from state!", id.ManagedHSMBaseUrl)
+		d.SetId("")
+		return nil
+	}
+
+	resp, err := client.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			log.Printf("[DEBUG] Role Definition %q was not found in Managed HSM at URI %q - removing from state", id.Name, id.ManagedHSMBaseUrl)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("managed_hsm_id", managedHsmId)
+
+	if model := resp.Model; model != nil {
+		d.Set("resource_manager_id", model.Id)
+
+		if props := model.Properties; props != nil {
+			d.Set("role_name", props.RoleName)
+			d.Set("description", props.Description)
+
+			if err := d.Set("permission", flattenKeyVaultManagedHardwareSecurityModuleRolePermissions(props.Permissions)); err != nil {
+				return fmt.Errorf("setting `permission`: %+v", err)
+			}
+
+			assignableScopes := make([]interface{}, 0)
+			if props.AssignableScopes != nil {
+				for _, scope := range *props.AssignableScopes {
+					assignableScopes = append(assignableScopes, string(scope))
+				}
+			}
+			if err := d.Set("assignable_scopes", assignableScopes); err != nil {
+				return fmt.Errorf("setting `assignable_scopes`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyVaultManagedHardwareSecurityModuleRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmRoleDefinitionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMDataPlaneRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name); err != nil {
+		if !utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			return fmt.Errorf("deleting Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func expandKeyVaultManagedHardwareSecurityModuleRolePermissions(input []interface{}) *[]roledefinitions.Permission {
+	permissions := make([]roledefinitions.Permission, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		raw := item.(map[string]interface{})
+
+		permissions = append(permissions, roledefinitions.Permission{
+			Actions:        utils.ExpandStringSlice(raw["actions"].(*pluginsdk.Set).List()),
+			NotActions:     utils.ExpandStringSlice(raw["not_actions"].(*pluginsdk.Set).List()),
+			DataActions:    utils.ExpandStringSlice(raw["data_actions"].(*pluginsdk.Set).List()),
+			NotDataActions: utils.ExpandStringSlice(raw["not_data_actions"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &permissions
+}
+
+func flattenKeyVaultManagedHardwareSecurityModuleRolePermissions(input *[]roledefinitions.Permission) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		output = append(output, map[string]interface{}{
+			"actions":          utils.FlattenStringSlice(item.Actions),
+			"not_actions":      utils.FlattenStringSlice(item.NotActions),
+			"data_actions":     utils.FlattenStringSlice(item.DataActions),
+			"not_data_actions": utils.FlattenStringSlice(item.NotDataActions),
+		})
+	}
+
+	return output
+}
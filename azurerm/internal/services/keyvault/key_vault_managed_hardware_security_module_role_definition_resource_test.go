@@ -0,0 +1,119 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource struct {
+}
+
+// NOTE: these tests are run in sequence from TestAccKeyVaultManagedHardwareSecurityModule, since only
+// one Managed HSM can be provisioned at a time
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Updated Role Definition"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMDataPlaneRoleDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.KeyVault.ManagedHsmRoleDefinitionsClient.Get(ctx, id.ManagedHSMBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp.HttpResponse}) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Role Definition %q (Managed HSM %q): %+v", id.Name, id.ManagedHSMBaseUrl, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "7e0b4c36-9e5f-4f4a-8e6b-2b6f0d0b1a53"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  role_name      = "acctestRD-%s"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data), data.RandomString)
+}
+
+func (KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_definition" "test" {
+  name           = "7e0b4c36-9e5f-4f4a-8e6b-2b6f0d0b1a53"
+  managed_hsm_id = azurerm_key_vault_managed_hardware_security_module.test.id
+  role_name      = "acctestRD-%s"
+  description    = "Updated Role Definition"
+
+  permission {
+    data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/read/action",
+      "Microsoft.KeyVault/managedHsm/keys/encrypt/action",
+      "Microsoft.KeyVault/managedHsm/keys/decrypt/action",
+    ]
+    not_data_actions = [
+      "Microsoft.KeyVault/managedHsm/keys/delete",
+    ]
+  }
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data), data.RandomString)
+}
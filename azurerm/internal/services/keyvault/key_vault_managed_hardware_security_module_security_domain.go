@@ -0,0 +1,144 @@
+package keyvault
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math/big"
+	"time"
+
+	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/sdk/securitydomain"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// activateKeyVaultManagedHardwareSecurityModule downloads the Security Domain of the Managed HSM, encrypted using the
+// public keys of the specified Key Vault Certificates - which activates the Managed HSM. The encrypted Security Domain
+// is returned, since it (and a quorum of the private keys) is required to restore the Managed HSM.
+func activateKeyVaultManagedHardwareSecurityModule(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.ManagedHSMId) (string, error) {
+	keyVaultsClient := meta.(*clients.Client).KeyVault
+	client := meta.(*clients.Client).KeyVault.ManagedHsmSecurityDomainClient
+
+	certificates := make([]securitydomain.SecurityDomainJsonWebKey, 0)
+	for _, raw := range d.Get("security_domain_key_vault_certificate_ids").([]interface{}) {
+		certificate, err := keyVaultManagedHardwareSecurityModuleSecurityDomainCertificate(ctx, keyVaultsClient.ManagementClient, raw.(string))
+		if err != nil {
+			return "", err
+		}
+		certificates = append(certificates, *certificate)
+	}
+
+	managedHsmBaseUri, err := keyVaultsClient.BaseUriForManagedHSM(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("looking up the Base URI for %s: %+v", id, err)
+	}
+
+	input := securitydomain.CertificateInfoObject{
+		Certificates: certificates,
+		Required:     utils.Int64(int64(d.Get("security_domain_quorum").(int))),
+	}
+	resp, err := client.Download(ctx, *managedHsmBaseUri, input)
+	if err != nil {
+		return "", fmt.Errorf("downloading the Security Domain for %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Value == "" {
+		return "", fmt.Errorf("downloading the Security Domain for %s: `value` was nil", id)
+	}
+
+	timeout, ok := ctx.Deadline()
+	if !ok {
+		return "", fmt.Errorf("context is missing a timeout")
+	}
+
+	log.Printf("[DEBUG] Waiting for the Security Domain for %s to finish downloading..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(securitydomain.OperationStatusInProgress)},
+		Target:  []string{string(securitydomain.OperationStatusSuccess)},
+		Refresh: func() (interface{}, string, error) {
+			status, err := client.DownloadPending(ctx, *managedHsmBaseUri)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving the status of the Security Domain download: %+v", err)
+			}
+			if status.Model == nil || status.Model.Status == nil {
+				return nil, "", fmt.Errorf("retrieving the status of the Security Domain download: `status` was nil")
+			}
+
+			if *status.Model.Status == securitydomain.OperationStatusFailed {
+				details := ""
+				if status.Model.StatusDetails != nil {
+					details = *status.Model.StatusDetails
+				}
+				return status, string(*status.Model.Status), fmt.Errorf("downloading the Security Domain failed: %s", details)
+			}
+
+			return status, string(*status.Model.Status), nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("waiting for the Security Domain for %s to be downloaded: %+v", id, err)
+	}
+
+	return resp.Model.Value, nil
+}
+
+// keyVaultManagedHardwareSecurityModuleSecurityDomainCertificate retrieves the public part of the specified Key Vault
+// Certificate as a JSON Web Key, which is used to encrypt the Security Domain
+func keyVaultManagedHardwareSecurityModuleSecurityDomainCertificate(ctx context.Context, client *keyvaultmgmt.BaseClient, certificateId string) (*securitydomain.SecurityDomainJsonWebKey, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(certificateId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Certificate %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if resp.Cer == nil {
+		return nil, fmt.Errorf("retrieving Certificate %q (Key Vault %q): `cer` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+
+	kid := certificateId
+	if resp.ID != nil {
+		kid = *resp.ID
+	}
+
+	return expandKeyVaultManagedHardwareSecurityModuleSecurityDomainCertificate(kid, *resp.Cer)
+}
+
+func expandKeyVaultManagedHardwareSecurityModuleSecurityDomainCertificate(kid string, der []byte) (*securitydomain.SecurityDomainJsonWebKey, error) {
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Certificate %q: %+v", kid, err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("Certificate %q must use an RSA key to be used for the Security Domain", kid)
+	}
+
+	sha1Thumbprint := sha1.Sum(der)
+	sha256Thumbprint := sha256.Sum256(der)
+
+	return &securitydomain.SecurityDomainJsonWebKey{
+		Alg:     "RSA-OAEP-256",
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		KeyOps:  []string{"verify", "encrypt", "wrapKey"},
+		Kid:     kid,
+		Kty:     "RSA",
+		N:       base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		Use:     utils.String("enc"),
+		X5c:     []string{base64.StdEncoding.EncodeToString(der)},
+		X5t:     utils.String(base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:])),
+		X5tS256: base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:]),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type CertificateVersionId struct {
+	SubscriptionId  string
+	ResourceGroup   string
+	VaultName       string
+	CertificateName string
+	VersionName     string
+}
+
+func NewCertificateVersionID(subscriptionId, resourceGroup, vaultName, certificateName, versionName string) CertificateVersionId {
+	return CertificateVersionId{
+		SubscriptionId:  subscriptionId,
+		ResourceGroup:   resourceGroup,
+		VaultName:       vaultName,
+		CertificateName: certificateName,
+		VersionName:     versionName,
+	}
+}
+
+func (id CertificateVersionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Version Name %q", id.VersionName),
+		fmt.Sprintf("Certificate Name %q", id.CertificateName),
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Certificate Version", segmentsStr)
+}
+
+func (id CertificateVersionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s/certificates/%s/versions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VaultName, id.CertificateName, id.VersionName)
+}
+
+// CertificateVersionID parses a CertificateVersion ID into an CertificateVersionId struct
+func CertificateVersionID(input string) (*CertificateVersionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CertificateVersionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+	if resourceId.CertificateName, err = id.PopSegment("certificates"); err != nil {
+		return nil, err
+	}
+	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
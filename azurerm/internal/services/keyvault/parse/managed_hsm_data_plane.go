@@ -0,0 +1,136 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagedHSMDataPlaneRoleAssignmentId{}
+var _ resourceid.Formatter = ManagedHSMDataPlaneRoleDefinitionId{}
+
+type ManagedHSMDataPlaneRoleAssignmentId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Name              string
+}
+
+func NewManagedHSMDataPlaneRoleAssignmentID(managedHsmBaseUrl, scope, name string) (*ManagedHSMDataPlaneRoleAssignmentId, error) {
+	baseUrl, err := parseManagedHSMBaseUrl(managedHsmBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMDataPlaneRoleAssignmentId{
+		ManagedHSMBaseUrl: *baseUrl,
+		Scope:             scope,
+		Name:              name,
+	}, nil
+}
+
+func (id ManagedHSMDataPlaneRoleAssignmentId) ID() string {
+	// example: https://the-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/a3d8a4b6-0e0a-4f5c-8b6a-fb1c2d4c2e10
+	return managedHSMDataPlaneScopedId(id.ManagedHSMBaseUrl, id.Scope, "roleAssignments", id.Name)
+}
+
+// ManagedHSMDataPlaneRoleAssignmentID parses the ID of a Role Assignment within a Managed HSM
+func ManagedHSMDataPlaneRoleAssignmentID(input string) (*ManagedHSMDataPlaneRoleAssignmentId, error) {
+	baseUrl, scope, name, err := parseManagedHSMDataPlaneScopedId(input, "roleAssignments")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMDataPlaneRoleAssignmentId{
+		ManagedHSMBaseUrl: *baseUrl,
+		Scope:             *scope,
+		Name:              *name,
+	}, nil
+}
+
+type ManagedHSMDataPlaneRoleDefinitionId struct {
+	ManagedHSMBaseUrl string
+	Scope             string
+	Name              string
+}
+
+func NewManagedHSMDataPlaneRoleDefinitionID(managedHsmBaseUrl, scope, name string) (*ManagedHSMDataPlaneRoleDefinitionId, error) {
+	baseUrl, err := parseManagedHSMBaseUrl(managedHsmBaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMDataPlaneRoleDefinitionId{
+		ManagedHSMBaseUrl: *baseUrl,
+		Scope:             scope,
+		Name:              name,
+	}, nil
+}
+
+func (id ManagedHSMDataPlaneRoleDefinitionId) ID() string {
+	// example: https://the-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b
+	return managedHSMDataPlaneScopedId(id.ManagedHSMBaseUrl, id.Scope, "roleDefinitions", id.Name)
+}
+
+// ManagedHSMDataPlaneRoleDefinitionID parses the ID of a Role Definition within a Managed HSM
+func ManagedHSMDataPlaneRoleDefinitionID(input string) (*ManagedHSMDataPlaneRoleDefinitionId, error) {
+	baseUrl, scope, name, err := parseManagedHSMDataPlaneScopedId(input, "roleDefinitions")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMDataPlaneRoleDefinitionId{
+		ManagedHSMBaseUrl: *baseUrl,
+		Scope:             *scope,
+		Name:              *name,
+	}, nil
+}
+
+func managedHSMDataPlaneScopedId(baseUrl, scope, resourceType, name string) string {
+	segments := []string{
+		strings.TrimSuffix(baseUrl, "/"),
+	}
+	if scope := strings.Trim(scope, "/"); scope != "" {
+		segments = append(segments, scope)
+	}
+	segments = append(segments, "providers", "Microsoft.Authorization", resourceType, name)
+	return strings.Join(segments, "/")
+}
+
+func parseManagedHSMBaseUrl(input string) (*string, error) {
+	baseUrl, err := url.Parse(input)
+	if err != nil || input == "" || baseUrl.Host == "" {
+		return nil, fmt.Errorf("parsing Managed HSM Base URL %q: %+v", input, err)
+	}
+
+	result := fmt.Sprintf("%s://%s/", baseUrl.Scheme, baseUrl.Host)
+	return &result, nil
+}
+
+func parseManagedHSMDataPlaneScopedId(input, resourceType string) (baseUrl, scope, name *string, err error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("parsing Managed HSM Data Plane ID %q: %+v", input, err)
+	}
+
+	separator := fmt.Sprintf("/providers/Microsoft.Authorization/%s/", resourceType)
+	path := strings.TrimSuffix(idURL.Path, "/")
+	index := strings.Index(path, separator)
+	if index == -1 {
+		return nil, nil, nil, fmt.Errorf("expected the path of %q to contain %q", input, separator)
+	}
+
+	parsedName := path[index+len(separator):]
+	if parsedName == "" || strings.Contains(parsedName, "/") {
+		return nil, nil, nil, fmt.Errorf("expected the path of %q to end with a name after %q", input, separator)
+	}
+
+	parsedScope := path[:index]
+	if parsedScope == "" {
+		parsedScope = "/"
+	}
+
+	parsedBaseUrl := fmt.Sprintf("%s://%s/", idURL.Scheme, idURL.Host)
+	return &parsedBaseUrl, &parsedScope, &parsedName, nil
+}
@@ -0,0 +1,141 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestManagedHSMDataPlaneRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ManagedHSMDataPlaneRoleAssignmentId
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/abc123",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/abc123",
+			Expected: &ManagedHSMDataPlaneRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				Name:              "abc123",
+			},
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/abc123",
+			Expected: &ManagedHSMDataPlaneRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys",
+				Name:              "abc123",
+			},
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/keys/key1/providers/Microsoft.Authorization/roleAssignments/abc123",
+			Expected: &ManagedHSMDataPlaneRoleAssignmentId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/keys/key1",
+				Name:              "abc123",
+			},
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/abc123/extra",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMDataPlaneRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.ManagedHSMBaseUrl != v.Expected.ManagedHSMBaseUrl {
+			t.Fatalf("Expected %q but got %q for ManagedHSMBaseUrl", v.Expected.ManagedHSMBaseUrl, actual.ManagedHSMBaseUrl)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
+
+func TestManagedHSMDataPlaneRoleDefinitionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *ManagedHSMDataPlaneRoleDefinitionId
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/abc123",
+			Error: true,
+		},
+		{
+			Input: "https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/abc123",
+			Expected: &ManagedHSMDataPlaneRoleDefinitionId{
+				ManagedHSMBaseUrl: "https://my-hsm.managedhsm.azure.net/",
+				Scope:             "/",
+				Name:              "abc123",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagedHSMDataPlaneRoleDefinitionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.ManagedHSMBaseUrl != v.Expected.ManagedHSMBaseUrl {
+			t.Fatalf("Expected %q but got %q for ManagedHSMBaseUrl", v.Expected.ManagedHSMBaseUrl, actual.ManagedHSMBaseUrl)
+		}
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
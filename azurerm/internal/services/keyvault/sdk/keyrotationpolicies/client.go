@@ -0,0 +1,15 @@
+package keyrotationpolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KeyRotationPoliciesClient struct {
+	Client autorest.Client
+}
+
+// NewKeyRotationPoliciesClient returns a client for the Key Rotation Policies within a Key Vault, where
+// the Key Vault is specified by it's Base URL (e.g. `https://vault1.vault.azure.net/`) on each request
+func NewKeyRotationPoliciesClient() KeyRotationPoliciesClient {
+	return KeyRotationPoliciesClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
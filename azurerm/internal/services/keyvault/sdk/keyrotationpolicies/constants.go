@@ -0,0 +1,15 @@
+package keyrotationpolicies
+
+type ActionType string
+
+const (
+	ActionTypeNotify ActionType = "Notify"
+	ActionTypeRotate ActionType = "Rotate"
+)
+
+func PossibleValuesForActionType() []string {
+	return []string{
+		string(ActionTypeNotify),
+		string(ActionTypeRotate),
+	}
+}
@@ -0,0 +1,9 @@
+package keyrotationpolicies
+
+type KeyRotationPolicyAttributes struct {
+	Created *int64 `json:"created,omitempty"`
+
+	// ExpiryTime is an ISO 8601 duration (e.g. `P90D`) after which newly created versions of the Key expire
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	Updated    *int64  `json:"updated,omitempty"`
+}
@@ -0,0 +1,9 @@
+package keyrotationpolicies
+
+type LifetimeActionTrigger struct {
+	// TimeAfterCreate is an ISO 8601 duration after the creation of the Key Version at which the Action is triggered
+	TimeAfterCreate *string `json:"timeAfterCreate,omitempty"`
+
+	// TimeBeforeExpiry is an ISO 8601 duration before the expiry of the Key Version at which the Action is triggered
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
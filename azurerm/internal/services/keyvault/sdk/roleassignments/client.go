@@ -0,0 +1,15 @@
+package roleassignments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleAssignmentsClient struct {
+	Client autorest.Client
+}
+
+// NewRoleAssignmentsClient returns a client for the local RBAC Role Assignments within a Managed HSM, where
+// the Managed HSM is specified by it's Base URL (e.g. `https://hsm1.managedhsm.azure.net/`) on each request
+func NewRoleAssignmentsClient() RoleAssignmentsClient {
+	return RoleAssignmentsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
@@ -0,0 +1,73 @@
+package roleassignments
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleAssignment
+}
+
+// Create ...
+func (c RoleAssignmentsClient) Create(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string, input RoleAssignmentCreateParameters) (result CreateResponse, err error) {
+	req, err := c.preparerForCreate(ctx, vaultBaseUrl, scope, roleAssignmentName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Create", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Create", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Create", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreate prepares the Create request.
+func (c RoleAssignmentsClient) preparerForCreate(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string, input RoleAssignmentCreateParameters) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"scope":                strings.TrimSuffix(scope, "/"), // the root scope (`/`) is omitted from the path
+		"role-assignment-name": autorest.Encode("path", roleAssignmentName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPathParameters("{scope}/providers/Microsoft.Authorization/roleAssignments/{role-assignment-name}", pathParameters),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreate handles the response to the Create request. The method always
+// closes the http.Response Body.
+func (c RoleAssignmentsClient) responderForCreate(resp *http.Response) (result CreateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
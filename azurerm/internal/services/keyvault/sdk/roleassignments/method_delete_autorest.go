@@ -0,0 +1,71 @@
+package roleassignments
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleAssignment
+}
+
+// Delete ...
+func (c RoleAssignmentsClient) Delete(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string) (result DeleteResponse, err error) {
+	req, err := c.preparerForDelete(ctx, vaultBaseUrl, scope, roleAssignmentName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDelete prepares the Delete request.
+func (c RoleAssignmentsClient) preparerForDelete(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"scope":                strings.TrimSuffix(scope, "/"), // the root scope (`/`) is omitted from the path
+		"role-assignment-name": autorest.Encode("path", roleAssignmentName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPathParameters("{scope}/providers/Microsoft.Authorization/roleAssignments/{role-assignment-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDelete handles the response to the Delete request. The method always
+// closes the http.Response Body.
+func (c RoleAssignmentsClient) responderForDelete(resp *http.Response) (result DeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
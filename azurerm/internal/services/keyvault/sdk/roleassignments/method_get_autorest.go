@@ -0,0 +1,71 @@
+package roleassignments
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleAssignment
+}
+
+// Get ...
+func (c RoleAssignmentsClient) Get(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string) (result GetResponse, err error) {
+	req, err := c.preparerForGet(ctx, vaultBaseUrl, scope, roleAssignmentName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roleassignments.RoleAssignmentsClient", "Get", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGet prepares the Get request.
+func (c RoleAssignmentsClient) preparerForGet(ctx context.Context, vaultBaseUrl string, scope string, roleAssignmentName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"scope":                strings.TrimSuffix(scope, "/"), // the root scope (`/`) is omitted from the path
+		"role-assignment-name": autorest.Encode("path", roleAssignmentName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPathParameters("{scope}/providers/Microsoft.Authorization/roleAssignments/{role-assignment-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGet handles the response to the Get request. The method always
+// closes the http.Response Body.
+func (c RoleAssignmentsClient) responderForGet(resp *http.Response) (result GetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,40 @@
+package roleassignments
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPreparerForGetScope(t *testing.T) {
+	testData := []struct {
+		scope    string
+		expected string
+	}{
+		{
+			scope:    "/",
+			expected: "https://hsm1.managedhsm.azure.net/providers/Microsoft.Authorization/roleAssignments/assignment1?api-version=7.3",
+		},
+		{
+			scope:    "/keys",
+			expected: "https://hsm1.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/assignment1?api-version=7.3",
+		},
+		{
+			scope:    "/keys/key1",
+			expected: "https://hsm1.managedhsm.azure.net/keys/key1/providers/Microsoft.Authorization/roleAssignments/assignment1?api-version=7.3",
+		},
+	}
+
+	client := NewRoleAssignmentsClient()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.scope)
+
+		req, err := client.preparerForGet(context.TODO(), "https://hsm1.managedhsm.azure.net/", v.scope, "assignment1")
+		if err != nil {
+			t.Fatalf("preparing request: %+v", err)
+		}
+
+		if actual := req.URL.String(); actual != v.expected {
+			t.Fatalf("Expected %q but got %q", v.expected, actual)
+		}
+	}
+}
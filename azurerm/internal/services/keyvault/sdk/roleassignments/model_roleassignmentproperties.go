@@ -0,0 +1,6 @@
+package roleassignments
+
+type RoleAssignmentProperties struct {
+	PrincipalId      string `json:"principalId"`
+	RoleDefinitionId string `json:"roleDefinitionId"`
+}
@@ -0,0 +1,15 @@
+package roledefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RoleDefinitionsClient struct {
+	Client autorest.Client
+}
+
+// NewRoleDefinitionsClient returns a client for the local RBAC Role Definitions within a Managed HSM, where
+// the Managed HSM is specified by it's Base URL (e.g. `https://hsm1.managedhsm.azure.net/`) on each request
+func NewRoleDefinitionsClient() RoleDefinitionsClient {
+	return RoleDefinitionsClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
@@ -0,0 +1,29 @@
+package roledefinitions
+
+type RoleScope string
+
+const (
+	RoleScopeGlobal RoleScope = "/"
+	RoleScopeKeys   RoleScope = "/keys"
+)
+
+func PossibleValuesForRoleScope() []string {
+	return []string{
+		string(RoleScopeGlobal),
+		string(RoleScopeKeys),
+	}
+}
+
+type RoleType string
+
+const (
+	RoleTypeAKVBuiltInRole RoleType = "AKVBuiltInRole"
+	RoleTypeCustomRole     RoleType = "CustomRole"
+)
+
+func PossibleValuesForRoleType() []string {
+	return []string{
+		string(RoleTypeAKVBuiltInRole),
+		string(RoleTypeCustomRole),
+	}
+}
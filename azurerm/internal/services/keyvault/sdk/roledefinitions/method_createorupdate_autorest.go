@@ -0,0 +1,73 @@
+package roledefinitions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleDefinition
+}
+
+// CreateOrUpdate ...
+func (c RoleDefinitionsClient) CreateOrUpdate(ctx context.Context, vaultBaseUrl string, scope string, roleDefinitionName string, input RoleDefinitionCreateParameters) (result CreateOrUpdateResponse, err error) {
+	req, err := c.preparerForCreateOrUpdate(ctx, vaultBaseUrl, scope, roleDefinitionName, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "CreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "CreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdate prepares the CreateOrUpdate request.
+func (c RoleDefinitionsClient) preparerForCreateOrUpdate(ctx context.Context, vaultBaseUrl string, scope string, roleDefinitionName string, input RoleDefinitionCreateParameters) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"scope":                strings.TrimSuffix(scope, "/"), // the root scope (`/`) is omitted from the path
+		"role-definition-name": autorest.Encode("path", roleDefinitionName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPathParameters("{scope}/providers/Microsoft.Authorization/roleDefinitions/{role-definition-name}", pathParameters),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdate handles the response to the CreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c RoleDefinitionsClient) responderForCreateOrUpdate(resp *http.Response) (result CreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
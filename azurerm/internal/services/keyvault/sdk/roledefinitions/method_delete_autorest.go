@@ -0,0 +1,71 @@
+package roledefinitions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleDefinition
+}
+
+// Delete ...
+func (c RoleDefinitionsClient) Delete(ctx context.Context, vaultBaseUrl string, scope string, roleDefinitionName string) (result DeleteResponse, err error) {
+	req, err := c.preparerForDelete(ctx, vaultBaseUrl, scope, roleDefinitionName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "Delete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "roledefinitions.RoleDefinitionsClient", "Delete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDelete prepares the Delete request.
+func (c RoleDefinitionsClient) preparerForDelete(ctx context.Context, vaultBaseUrl string, scope string, roleDefinitionName string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"scope":                strings.TrimSuffix(scope, "/"), // the root scope (`/`) is omitted from the path
+		"role-definition-name": autorest.Encode("path", roleDefinitionName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPathParameters("{scope}/providers/Microsoft.Authorization/roleDefinitions/{role-definition-name}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDelete handles the response to the Delete request. The method always
+// closes the http.Response Body.
+func (c RoleDefinitionsClient) responderForDelete(resp *http.Response) (result DeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package roledefinitions
+
+type RoleDefinitionCreateParameters struct {
+	Properties RoleDefinitionProperties `json:"properties"`
+}
@@ -0,0 +1,9 @@
+package roledefinitions
+
+type RoleDefinitionProperties struct {
+	AssignableScopes *[]RoleScope  `json:"assignableScopes,omitempty"`
+	Description      *string       `json:"description,omitempty"`
+	Permissions      *[]Permission `json:"permissions,omitempty"`
+	RoleName         *string       `json:"roleName,omitempty"`
+	Type             *RoleType     `json:"type,omitempty"`
+}
@@ -0,0 +1,15 @@
+package securitydomain
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SecurityDomainClient struct {
+	Client autorest.Client
+}
+
+// NewSecurityDomainClient returns a client for the Security Domain of a Managed HSM, where the Managed HSM
+// is specified by it's Base URL (e.g. `https://hsm1.managedhsm.azure.net/`) on each request
+func NewSecurityDomainClient() SecurityDomainClient {
+	return SecurityDomainClient{
+		Client: autorest.NewClientWithUserAgent(userAgent()),
+	}
+}
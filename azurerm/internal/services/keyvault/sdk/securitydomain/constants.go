@@ -0,0 +1,17 @@
+package securitydomain
+
+type OperationStatus string
+
+const (
+	OperationStatusFailed     OperationStatus = "Failed"
+	OperationStatusInProgress OperationStatus = "InProgress"
+	OperationStatusSuccess    OperationStatus = "Success"
+)
+
+func PossibleValuesForOperationStatus() []string {
+	return []string{
+		string(OperationStatusFailed),
+		string(OperationStatusInProgress),
+		string(OperationStatusSuccess),
+	}
+}
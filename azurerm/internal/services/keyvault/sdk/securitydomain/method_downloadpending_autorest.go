@@ -0,0 +1,65 @@
+package securitydomain
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DownloadPendingResponse struct {
+	HttpResponse *http.Response
+	Model        *SecurityDomainOperationStatus
+}
+
+// DownloadPending retrieves the status of the Security Domain download operation
+func (c SecurityDomainClient) DownloadPending(ctx context.Context, vaultBaseUrl string) (result DownloadPendingResponse, err error) {
+	req, err := c.preparerForDownloadPending(ctx, vaultBaseUrl)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "DownloadPending", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "DownloadPending", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDownloadPending(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "securitydomain.SecurityDomainClient", "DownloadPending", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDownloadPending prepares the DownloadPending request.
+func (c SecurityDomainClient) preparerForDownloadPending(ctx context.Context, vaultBaseUrl string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", map[string]interface{}{
+			"vaultBaseUrl": vaultBaseUrl,
+		}),
+		autorest.WithPath("/securitydomain/download/pending"),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDownloadPending handles the response to the DownloadPending request. The method always
+// closes the http.Response Body.
+func (c SecurityDomainClient) responderForDownloadPending(resp *http.Response) (result DownloadPendingResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package securitydomain
+
+type CertificateInfoObject struct {
+	Certificates []SecurityDomainJsonWebKey `json:"certificates"`
+
+	// Required is the number of Certificates (between 2 and 10) which are required to restore the Security Domain
+	Required *int64 `json:"required,omitempty"`
+}
@@ -0,0 +1,6 @@
+package securitydomain
+
+type SecurityDomainObject struct {
+	// Value is the encrypted Security Domain, as a JSON document
+	Value string `json:"value"`
+}
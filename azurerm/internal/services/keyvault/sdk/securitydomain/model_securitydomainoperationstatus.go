@@ -0,0 +1,6 @@
+package securitydomain
+
+type SecurityDomainOperationStatus struct {
+	Status        *OperationStatus `json:"status,omitempty"`
+	StatusDetails *string          `json:"status_details,omitempty"`
+}
@@ -0,0 +1,38 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ManagedHSMRoleDefinitionID validates the ID of a Role Definition within a Managed HSM, as used by Role Assignments
+// (e.g. `Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b`)
+func ManagedHSMRoleDefinitionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`(?i)^[^/]*/providers/Microsoft\.Authorization/roleDefinitions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Managed HSM Role Definition in the format `{prefix}/providers/Microsoft.Authorization/roleDefinitions/{name}` but got %q", key, v))
+	}
+
+	return
+}
+
+// ManagedHSMRoleScope validates the scope of a Role Assignment within a Managed HSM, which is either
+// the Managed HSM (`/`), all Keys (`/keys`) or a single Key (`/keys/{name}`)
+func ManagedHSMRoleScope(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^/(keys(/[a-zA-Z0-9-]{1,127})?)?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be `/`, `/keys` or `/keys/{name}` but got %q", key, v))
+	}
+
+	return
+}
@@ -0,0 +1,91 @@
+package validate
+
+import "testing"
+
+func TestManagedHSMRoleDefinitionID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.KeyVault/providers/Microsoft.Authorization/roleAssignments/21dbd100-6940-42c2-9190-5d6cb909625b",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/abc123",
+			Valid: false,
+		},
+		{
+			Input: "Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b",
+			Valid: true,
+		},
+		{
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b",
+			Valid: true,
+		},
+		{
+			Input: "Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b/extra",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ManagedHSMRoleDefinitionID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestManagedHSMRoleScope(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "/",
+			Valid: true,
+		},
+		{
+			Input: "/keys",
+			Valid: true,
+		},
+		{
+			Input: "/keys/",
+			Valid: false,
+		},
+		{
+			Input: "/keys/my-key",
+			Valid: true,
+		},
+		{
+			Input: "/keys/my-key/versions",
+			Valid: false,
+		},
+		{
+			Input: "/secrets",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ManagedHSMRoleScope(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -5,7 +5,11 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/monitor/mgmt/2020-10-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/preview/alertsmanagement/mgmt/2019-06-01-preview/alertsmanagement"
 	classic "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	previewInsights "github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-04-01-preview/insights"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/diagnosticsettings"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/diagnosticsettingscategories"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/scheduledqueryrules"
 )
 
 type Client struct {
@@ -20,14 +24,18 @@ type Client struct {
 	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
-	ActionGroupsClient               *classic.ActionGroupsClient
-	ActivityLogAlertsClient          *insights.ActivityLogAlertsClient
-	AlertRulesClient                 *classic.AlertRulesClient
-	DiagnosticSettingsClient         *classic.DiagnosticSettingsClient
-	DiagnosticSettingsCategoryClient *classic.DiagnosticSettingsCategoryClient
-	LogProfilesClient                *classic.LogProfilesClient
-	MetricAlertsClient               *classic.MetricAlertsClient
-	ScheduledQueryRulesClient        *classic.ScheduledQueryRulesClient
+	ActionGroupsClient                   *classic.ActionGroupsClient
+	ActivityLogAlertsClient              *insights.ActivityLogAlertsClient
+	AlertRulesClient                     *classic.AlertRulesClient
+	DataCollectionEndpointsClient        *previewInsights.DataCollectionEndpointsClient
+	DataCollectionRulesClient            *previewInsights.DataCollectionRulesClient
+	DataCollectionRuleAssociationsClient *previewInsights.DataCollectionRuleAssociationsClient
+	DiagnosticSettingsClient             *diagnosticsettings.DiagnosticSettingsClient
+	DiagnosticSettingsCategoryClient     *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient
+	LogProfilesClient                    *classic.LogProfilesClient
+	MetricAlertsClient                   *classic.MetricAlertsClient
+	ScheduledQueryRulesClient            *classic.ScheduledQueryRulesClient
+	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -52,10 +60,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertRulesClient := classic.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
-	DiagnosticSettingsClient := classic.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	DataCollectionEndpointsClient := previewInsights.NewDataCollectionEndpointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionEndpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	DataCollectionRulesClient := previewInsights.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	DataCollectionRuleAssociationsClient := previewInsights.NewDataCollectionRuleAssociationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DataCollectionRuleAssociationsClient.Client, o.ResourceManagerAuthorizer)
+
+	DiagnosticSettingsClient := diagnosticsettings.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
 
-	DiagnosticSettingsCategoryClient := classic.NewDiagnosticSettingsCategoryClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	DiagnosticSettingsCategoryClient := diagnosticsettingscategories.NewDiagnosticSettingsCategoriesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DiagnosticSettingsCategoryClient.Client, o.ResourceManagerAuthorizer)
 
 	LogProfilesClient := classic.NewLogProfilesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
@@ -67,18 +84,25 @@ func NewClient(o *common.ClientOptions) *Client {
 	ScheduledQueryRulesClient := classic.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ScheduledQueryRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	ScheduledQueryRulesV2Client := scheduledqueryrules.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ScheduledQueryRulesV2Client.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AADDiagnosticSettingsClient:      &AADDiagnosticSettingsClient,
-		AutoscaleSettingsClient:          &AutoscaleSettingsClient,
-		ActionRulesClient:                &ActionRulesClient,
-		SmartDetectorAlertRulesClient:    &SmartDetectorAlertRulesClient,
-		ActionGroupsClient:               &ActionGroupsClient,
-		ActivityLogAlertsClient:          &ActivityLogAlertsClient,
-		AlertRulesClient:                 &AlertRulesClient,
-		DiagnosticSettingsClient:         &DiagnosticSettingsClient,
-		DiagnosticSettingsCategoryClient: &DiagnosticSettingsCategoryClient,
-		LogProfilesClient:                &LogProfilesClient,
-		MetricAlertsClient:               &MetricAlertsClient,
-		ScheduledQueryRulesClient:        &ScheduledQueryRulesClient,
+		AADDiagnosticSettingsClient:          &AADDiagnosticSettingsClient,
+		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
+		ActionRulesClient:                    &ActionRulesClient,
+		SmartDetectorAlertRulesClient:        &SmartDetectorAlertRulesClient,
+		ActionGroupsClient:                   &ActionGroupsClient,
+		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
+		AlertRulesClient:                     &AlertRulesClient,
+		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
+		DataCollectionRulesClient:            &DataCollectionRulesClient,
+		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
+		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
+		LogProfilesClient:                    &LogProfilesClient,
+		MetricAlertsClient:                   &MetricAlertsClient,
+		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
+		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
 	}
 }
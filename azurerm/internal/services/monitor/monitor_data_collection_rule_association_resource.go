@@ -0,0 +1,185 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-04-01-preview/insights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMonitorDataCollectionRuleAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleAssociationRead,
+		Update: resourceMonitorDataCollectionRuleAssociationCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionRuleAssociationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			// associations with a Data Collection Endpoint must be named `configurationAccessEndpoint`
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "configurationAccessEndpoint",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"data_collection_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DataCollectionRuleID,
+				ExactlyOneOf: []string{"data_collection_rule_id", "data_collection_endpoint_id"},
+			},
+
+			"data_collection_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DataCollectionEndpointID,
+				ExactlyOneOf: []string{"data_collection_rule_id", "data_collection_endpoint_id"},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionRuleAssociationID(d.Get("target_resource_id").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.TargetResourceId, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule_association", id.ID())
+		}
+	}
+
+	props := insights.DataCollectionRuleAssociationProxyOnlyResourceProperties{
+		Description: utils.String(d.Get("description").(string)),
+	}
+	if v := d.Get("data_collection_rule_id").(string); v != "" {
+		props.DataCollectionRuleID = utils.String(v)
+	}
+	if v := d.Get("data_collection_endpoint_id").(string); v != "" {
+		props.DataCollectionEndpointID = utils.String(v)
+	}
+
+	body := insights.DataCollectionRuleAssociationProxyOnlyResource{
+		DataCollectionRuleAssociationProxyOnlyResourceProperties: &props,
+	}
+
+	if _, err := client.Create(ctx, id.TargetResourceId, id.Name, &body); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMonitorDataCollectionRuleAssociationRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.TargetResourceId, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("target_resource_id", id.TargetResourceId)
+
+	if props := resp.DataCollectionRuleAssociationProxyOnlyResourceProperties; props != nil {
+		d.Set("description", props.Description)
+
+		dataCollectionRuleId := ""
+		if props.DataCollectionRuleID != nil {
+			ruleId, err := parse.DataCollectionRuleID(*props.DataCollectionRuleID)
+			if err != nil {
+				return err
+			}
+			dataCollectionRuleId = ruleId.ID()
+		}
+		d.Set("data_collection_rule_id", dataCollectionRuleId)
+
+		dataCollectionEndpointId := ""
+		if props.DataCollectionEndpointID != nil {
+			endpointId, err := parse.DataCollectionEndpointID(*props.DataCollectionEndpointID)
+			if err != nil {
+				return err
+			}
+			dataCollectionEndpointId = endpointId.ID()
+		}
+		d.Set("data_collection_endpoint_id", dataCollectionEndpointId)
+	}
+
+	return nil
+}
+
+func resourceMonitorDataCollectionRuleAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.TargetResourceId, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
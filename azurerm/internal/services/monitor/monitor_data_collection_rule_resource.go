@@ -0,0 +1,868 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-04-01-preview/insights"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMonitorDataCollectionRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDataCollectionRuleCreateUpdate,
+		Read:   resourceMonitorDataCollectionRuleRead,
+		Update: resourceMonitorDataCollectionRuleCreateUpdate,
+		Delete: resourceMonitorDataCollectionRuleDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataCollectionRuleID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"data_flow": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"streams": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(insights.KnownDataFlowStreamsMicrosoftEvent),
+									string(insights.KnownDataFlowStreamsMicrosoftInsightsMetrics),
+									string(insights.KnownDataFlowStreamsMicrosoftPerf),
+									string(insights.KnownDataFlowStreamsMicrosoftSyslog),
+									string(insights.KnownDataFlowStreamsMicrosoftWindowsEvent),
+								}, false),
+							},
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"destinations": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"azure_monitor_metrics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+
+						"log_analytics": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"workspace_resource_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+									},
+								},
+							},
+							AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						},
+					},
+				},
+			},
+
+			"data_sources": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"extension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"extension_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftEvent),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftInsightsMetrics),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftPerf),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftSyslog),
+												string(insights.KnownExtensionDataSourceStreamsMicrosoftWindowsEvent),
+											}, false),
+										},
+									},
+
+									"extension_json": {
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+									},
+
+									"input_data_sources": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"performance_counter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"counter_specifiers": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+
+									"sampling_frequency_in_seconds": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1800),
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownPerfCounterDataSourceStreamsMicrosoftInsightsMetrics),
+												string(insights.KnownPerfCounterDataSourceStreamsMicrosoftPerf),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"syslog": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"facility_names": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownSyslogDataSourceFacilityNamesAsterisk),
+												string(insights.KnownSyslogDataSourceFacilityNamesAuth),
+												string(insights.KnownSyslogDataSourceFacilityNamesAuthpriv),
+												string(insights.KnownSyslogDataSourceFacilityNamesCron),
+												string(insights.KnownSyslogDataSourceFacilityNamesDaemon),
+												string(insights.KnownSyslogDataSourceFacilityNamesKern),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal0),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal1),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal2),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal3),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal4),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal5),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal6),
+												string(insights.KnownSyslogDataSourceFacilityNamesLocal7),
+												string(insights.KnownSyslogDataSourceFacilityNamesLpr),
+												string(insights.KnownSyslogDataSourceFacilityNamesMail),
+												string(insights.KnownSyslogDataSourceFacilityNamesMark),
+												string(insights.KnownSyslogDataSourceFacilityNamesNews),
+												string(insights.KnownSyslogDataSourceFacilityNamesSyslog),
+												string(insights.KnownSyslogDataSourceFacilityNamesUser),
+												string(insights.KnownSyslogDataSourceFacilityNamesUucp),
+											}, false),
+										},
+									},
+
+									"log_levels": {
+										Type:     pluginsdk.TypeSet,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownSyslogDataSourceLogLevelsAsterisk),
+												string(insights.KnownSyslogDataSourceLogLevelsAlert),
+												string(insights.KnownSyslogDataSourceLogLevelsCritical),
+												string(insights.KnownSyslogDataSourceLogLevelsDebug),
+												string(insights.KnownSyslogDataSourceLogLevelsEmergency),
+												string(insights.KnownSyslogDataSourceLogLevelsError),
+												string(insights.KnownSyslogDataSourceLogLevelsInfo),
+												string(insights.KnownSyslogDataSourceLogLevelsNotice),
+												string(insights.KnownSyslogDataSourceLogLevelsWarning),
+											}, false),
+										},
+									},
+								},
+							},
+						},
+
+						"windows_event_log": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"streams": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(insights.KnownWindowsEventLogDataSourceStreamsMicrosoftEvent),
+												string(insights.KnownWindowsEventLogDataSourceStreamsMicrosoftWindowsEvent),
+											}, false),
+										},
+									},
+
+									"x_path_queries": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"kind": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(insights.KnownDataCollectionRuleResourceKindLinux),
+					string(insights.KnownDataCollectionRuleResourceKindWindows),
+				}, false),
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorDataCollectionRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDataCollectionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_monitor_data_collection_rule", id.ID())
+		}
+	}
+
+	dataSources, err := expandMonitorDataCollectionRuleDataSources(d.Get("data_sources").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `data_sources`: %+v", err)
+	}
+
+	body := insights.DataCollectionRuleResource{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Kind:     insights.KnownDataCollectionRuleResourceKind(d.Get("kind").(string)),
+		DataCollectionRuleResourceProperties: &insights.DataCollectionRuleResourceProperties{
+			Description:  utils.String(d.Get("description").(string)),
+			DataSources:  dataSources,
+			Destinations: expandMonitorDataCollectionRuleDestinations(d.Get("destinations").([]interface{})),
+			DataFlows:    expandMonitorDataCollectionRuleDataFlows(d.Get("data_flow").([]interface{})),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.Name, &body); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMonitorDataCollectionRuleRead(d, meta)
+}
+
+func resourceMonitorDataCollectionRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("kind", string(resp.Kind))
+
+	if props := resp.DataCollectionRuleResourceProperties; props != nil {
+		d.Set("description", props.Description)
+
+		if err := d.Set("data_flow", flattenMonitorDataCollectionRuleDataFlows(props.DataFlows)); err != nil {
+			return fmt.Errorf("setting `data_flow`: %+v", err)
+		}
+
+		dataSources, err := flattenMonitorDataCollectionRuleDataSources(props.DataSources)
+		if err != nil {
+			return fmt.Errorf("flattening `data_sources`: %+v", err)
+		}
+		if err := d.Set("data_sources", dataSources); err != nil {
+			return fmt.Errorf("setting `data_sources`: %+v", err)
+		}
+
+		if err := d.Set("destinations", flattenMonitorDataCollectionRuleDestinations(props.Destinations)); err != nil {
+			return fmt.Errorf("setting `destinations`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMonitorDataCollectionRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataCollectionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMonitorDataCollectionRuleDataFlows(input []interface{}) *[]insights.DataFlow {
+	results := make([]insights.DataFlow, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownDataFlowStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownDataFlowStreams(stream.(string)))
+		}
+
+		results = append(results, insights.DataFlow{
+			Streams:      &streams,
+			Destinations: utils.ExpandStringSlice(v["destinations"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandMonitorDataCollectionRuleDestinations(input []interface{}) *insights.DataCollectionRuleDestinations {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	result := insights.DataCollectionRuleDestinations{}
+
+	if metrics := v["azure_monitor_metrics"].([]interface{}); len(metrics) > 0 && metrics[0] != nil {
+		metric := metrics[0].(map[string]interface{})
+		result.AzureMonitorMetrics = &insights.DestinationsSpecAzureMonitorMetrics{
+			Name: utils.String(metric["name"].(string)),
+		}
+	}
+
+	logAnalytics := make([]insights.LogAnalyticsDestination, 0)
+	for _, item := range v["log_analytics"].([]interface{}) {
+		destination := item.(map[string]interface{})
+		logAnalytics = append(logAnalytics, insights.LogAnalyticsDestination{
+			Name:                utils.String(destination["name"].(string)),
+			WorkspaceResourceID: utils.String(destination["workspace_resource_id"].(string)),
+		})
+	}
+	result.LogAnalytics = &logAnalytics
+
+	return &result
+}
+
+func expandMonitorDataCollectionRuleDataSources(input []interface{}) (*insights.DataCollectionRuleDataSources, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	v := input[0].(map[string]interface{})
+
+	extensions, err := expandMonitorDataCollectionRuleExtensions(v["extension"].([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &insights.DataCollectionRuleDataSources{
+		Extensions:          extensions,
+		PerformanceCounters: expandMonitorDataCollectionRulePerformanceCounters(v["performance_counter"].([]interface{})),
+		Syslog:              expandMonitorDataCollectionRuleSyslog(v["syslog"].([]interface{})),
+		WindowsEventLogs:    expandMonitorDataCollectionRuleWindowsEventLogs(v["windows_event_log"].([]interface{})),
+	}, nil
+}
+
+func expandMonitorDataCollectionRuleExtensions(input []interface{}) (*[]insights.ExtensionDataSource, error) {
+	results := make([]insights.ExtensionDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownExtensionDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownExtensionDataSourceStreams(stream.(string)))
+		}
+
+		result := insights.ExtensionDataSource{
+			Name:          utils.String(v["name"].(string)),
+			ExtensionName: utils.String(v["extension_name"].(string)),
+			Streams:       &streams,
+		}
+
+		if inputDataSources := v["input_data_sources"].([]interface{}); len(inputDataSources) > 0 {
+			result.InputDataSources = utils.ExpandStringSlice(inputDataSources)
+		}
+
+		if extensionJson := v["extension_json"].(string); extensionJson != "" {
+			var settings interface{}
+			if err := json.Unmarshal([]byte(extensionJson), &settings); err != nil {
+				return nil, fmt.Errorf("unmarshaling `extension_json` for extension %q: %+v", v["name"].(string), err)
+			}
+			result.ExtensionSettings = settings
+		}
+
+		results = append(results, result)
+	}
+
+	return &results, nil
+}
+
+func expandMonitorDataCollectionRulePerformanceCounters(input []interface{}) *[]insights.PerfCounterDataSource {
+	results := make([]insights.PerfCounterDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownPerfCounterDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownPerfCounterDataSourceStreams(stream.(string)))
+		}
+
+		results = append(results, insights.PerfCounterDataSource{
+			Name:                       utils.String(v["name"].(string)),
+			CounterSpecifiers:          utils.ExpandStringSlice(v["counter_specifiers"].([]interface{})),
+			SamplingFrequencyInSeconds: utils.Int32(int32(v["sampling_frequency_in_seconds"].(int))),
+			Streams:                    &streams,
+		})
+	}
+
+	return &results
+}
+
+func expandMonitorDataCollectionRuleSyslog(input []interface{}) *[]insights.SyslogDataSource {
+	results := make([]insights.SyslogDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		facilityNames := make([]insights.KnownSyslogDataSourceFacilityNames, 0)
+		for _, facilityName := range v["facility_names"].(*pluginsdk.Set).List() {
+			facilityNames = append(facilityNames, insights.KnownSyslogDataSourceFacilityNames(facilityName.(string)))
+		}
+
+		logLevels := make([]insights.KnownSyslogDataSourceLogLevels, 0)
+		for _, logLevel := range v["log_levels"].(*pluginsdk.Set).List() {
+			logLevels = append(logLevels, insights.KnownSyslogDataSourceLogLevels(logLevel.(string)))
+		}
+
+		results = append(results, insights.SyslogDataSource{
+			Name:          utils.String(v["name"].(string)),
+			FacilityNames: &facilityNames,
+			LogLevels:     &logLevels,
+			// Syslog data sources can only be sent to the `Microsoft-Syslog` stream
+			Streams: &[]insights.KnownSyslogDataSourceStreams{
+				insights.KnownSyslogDataSourceStreamsMicrosoftSyslog,
+			},
+		})
+	}
+
+	return &results
+}
+
+func expandMonitorDataCollectionRuleWindowsEventLogs(input []interface{}) *[]insights.WindowsEventLogDataSource {
+	results := make([]insights.WindowsEventLogDataSource, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		streams := make([]insights.KnownWindowsEventLogDataSourceStreams, 0)
+		for _, stream := range v["streams"].([]interface{}) {
+			streams = append(streams, insights.KnownWindowsEventLogDataSourceStreams(stream.(string)))
+		}
+
+		results = append(results, insights.WindowsEventLogDataSource{
+			Name:         utils.String(v["name"].(string)),
+			XPathQueries: utils.ExpandStringSlice(v["x_path_queries"].([]interface{})),
+			Streams:      &streams,
+		})
+	}
+
+	return &results
+}
+
+func flattenMonitorDataCollectionRuleDataFlows(input *[]insights.DataFlow) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"streams":      streams,
+			"destinations": utils.FlattenStringSlice(item.Destinations),
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDataCollectionRuleDestinations(input *insights.DataCollectionRuleDestinations) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	azureMonitorMetrics := make([]interface{}, 0)
+	if input.AzureMonitorMetrics != nil {
+		name := ""
+		if input.AzureMonitorMetrics.Name != nil {
+			name = *input.AzureMonitorMetrics.Name
+		}
+		azureMonitorMetrics = append(azureMonitorMetrics, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	logAnalytics := make([]interface{}, 0)
+	if input.LogAnalytics != nil {
+		for _, item := range *input.LogAnalytics {
+			name := ""
+			if item.Name != nil {
+				name = *item.Name
+			}
+
+			workspaceResourceId := ""
+			if item.WorkspaceResourceID != nil {
+				workspaceResourceId = *item.WorkspaceResourceID
+			}
+
+			logAnalytics = append(logAnalytics, map[string]interface{}{
+				"name":                  name,
+				"workspace_resource_id": workspaceResourceId,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"azure_monitor_metrics": azureMonitorMetrics,
+			"log_analytics":         logAnalytics,
+		},
+	}
+}
+
+func flattenMonitorDataCollectionRuleDataSources(input *insights.DataCollectionRuleDataSources) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	extensions, err := flattenMonitorDataCollectionRuleExtensions(input.Extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"extension":           extensions,
+			"performance_counter": flattenMonitorDataCollectionRulePerformanceCounters(input.PerformanceCounters),
+			"syslog":              flattenMonitorDataCollectionRuleSyslog(input.Syslog),
+			"windows_event_log":   flattenMonitorDataCollectionRuleWindowsEventLogs(input.WindowsEventLogs),
+		},
+	}, nil
+}
+
+func flattenMonitorDataCollectionRuleExtensions(input *[]insights.ExtensionDataSource) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		extensionName := ""
+		if item.ExtensionName != nil {
+			extensionName = *item.ExtensionName
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		extensionJson := ""
+		if item.ExtensionSettings != nil {
+			settings, err := json.Marshal(item.ExtensionSettings)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling the settings for extension %q: %+v", name, err)
+			}
+			extensionJson = string(settings)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"extension_name":     extensionName,
+			"streams":            streams,
+			"extension_json":     extensionJson,
+			"input_data_sources": utils.FlattenStringSlice(item.InputDataSources),
+		})
+	}
+
+	return results, nil
+}
+
+func flattenMonitorDataCollectionRulePerformanceCounters(input *[]insights.PerfCounterDataSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		samplingFrequency := 0
+		if item.SamplingFrequencyInSeconds != nil {
+			samplingFrequency = int(*item.SamplingFrequencyInSeconds)
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                          name,
+			"counter_specifiers":            utils.FlattenStringSlice(item.CounterSpecifiers),
+			"sampling_frequency_in_seconds": samplingFrequency,
+			"streams":                       streams,
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDataCollectionRuleSyslog(input *[]insights.SyslogDataSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		facilityNames := make([]interface{}, 0)
+		if item.FacilityNames != nil {
+			for _, facilityName := range *item.FacilityNames {
+				facilityNames = append(facilityNames, string(facilityName))
+			}
+		}
+
+		logLevels := make([]interface{}, 0)
+		if item.LogLevels != nil {
+			for _, logLevel := range *item.LogLevels {
+				logLevels = append(logLevels, string(logLevel))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"facility_names": facilityNames,
+			"log_levels":     logLevels,
+		})
+	}
+
+	return results
+}
+
+func flattenMonitorDataCollectionRuleWindowsEventLogs(input *[]insights.WindowsEventLogDataSource) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		streams := make([]interface{}, 0)
+		if item.Streams != nil {
+			for _, stream := range *item.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"streams":        streams,
+			"x_path_queries": utils.FlattenStringSlice(item.XPathQueries),
+		})
+	}
+
+	return results
+}
@@ -1,13 +1,13 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
-	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/diagnosticsettingscategories"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
@@ -34,6 +34,13 @@ func dataSourceMonitorDiagnosticCategories() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"log_category_groups": {
+				Type:     pluginsdk.TypeSet,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+				Computed: true,
+			},
+
 			"metrics": {
 				Type:     pluginsdk.TypeSet,
 				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
@@ -50,49 +57,80 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 	defer cancel()
 
 	actualResourceId := d.Get("resource_id").(string)
-	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
-	resourceId := strings.TrimPrefix(actualResourceId, "/")
 
-	// then retrieve the possible Diagnostics Categories for this Resource
-	categories, err := categoriesClient.List(ctx, resourceId)
+	categories, err := monitorDiagnosticCategoriesForResource(ctx, categoriesClient, actualResourceId)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, err)
+		return err
+	}
+
+	d.SetId(actualResourceId)
+
+	if err := d.Set("logs", categories.logs); err != nil {
+		return fmt.Errorf("Error setting `logs`: %+v", err)
 	}
 
-	if categories.Value == nil {
-		return fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", actualResourceId)
+	if err := d.Set("log_category_groups", categories.logCategoryGroups); err != nil {
+		return fmt.Errorf("Error setting `log_category_groups`: %+v", err)
 	}
 
-	d.SetId(actualResourceId)
-	val := *categories.Value
+	if err := d.Set("metrics", categories.metrics); err != nil {
+		return fmt.Errorf("Error setting `metrics`: %+v", err)
+	}
+
+	return nil
+}
+
+type monitorDiagnosticCategories struct {
+	logs              []string
+	logCategoryGroups []string
+	metrics           []string
+}
+
+// monitorDiagnosticCategoriesForResource retrieves the Diagnostics Categories (and Log Category Groups) which are
+// available for the specified Resource - this is shared with `azurerm_monitor_diagnostic_setting` which uses it to
+// resolve the categories when `enable_all` is set
+func monitorDiagnosticCategoriesForResource(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, resourceId string) (*monitorDiagnosticCategories, error) {
+	resp, err := client.List(ctx, resourceId)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: %+v", resourceId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Value == nil {
+		return nil, fmt.Errorf("Error retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", resourceId)
+	}
 
-	metrics := make([]string, 0)
-	logs := make([]string, 0)
+	result := monitorDiagnosticCategories{
+		logs:              make([]string, 0),
+		logCategoryGroups: make([]string, 0),
+		metrics:           make([]string, 0),
+	}
+	categoryGroups := make(map[string]struct{})
 
-	for _, v := range val {
+	for _, v := range *resp.Model.Value {
 		if v.Name == nil {
 			continue
 		}
 
-		if category := v.DiagnosticSettingsCategory; category != nil {
-			switch category.CategoryType {
-			case insights.Logs:
-				logs = append(logs, *v.Name)
-			case insights.Metrics:
-				metrics = append(metrics, *v.Name)
+		if category := v.Properties; category != nil && category.CategoryType != nil {
+			switch *category.CategoryType {
+			case diagnosticsettingscategories.CategoryTypeLogs:
+				result.logs = append(result.logs, *v.Name)
+
+				if category.CategoryGroups != nil {
+					for _, group := range *category.CategoryGroups {
+						if _, ok := categoryGroups[group]; !ok {
+							categoryGroups[group] = struct{}{}
+							result.logCategoryGroups = append(result.logCategoryGroups, group)
+						}
+					}
+				}
+			case diagnosticsettingscategories.CategoryTypeMetrics:
+				result.metrics = append(result.metrics, *v.Name)
 			default:
-				return fmt.Errorf("Unsupported category type %q", string(category.CategoryType))
+				return nil, fmt.Errorf("Unsupported category type %q", string(*category.CategoryType))
 			}
 		}
 	}
 
-	if err := d.Set("logs", logs); err != nil {
-		return fmt.Errorf("Error setting `logs`: %+v", err)
-	}
-
-	if err := d.Set("metrics", metrics); err != nil {
-		return fmt.Errorf("Error setting `metrics`: %+v", err)
-	}
-
-	return nil
+	return &result, nil
 }
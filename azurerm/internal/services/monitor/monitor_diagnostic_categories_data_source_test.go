@@ -21,6 +21,7 @@ func TestAccDataSourceMonitorDiagnosticCategories_appService(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("metrics.#").Exists(),
 				check.That(data.ResourceName).Key("logs.#").Exists(),
+				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
 			),
 		},
 	})
@@ -36,6 +37,7 @@ func TestAccDataSourceMonitorDiagnosticCategories_storageAccount(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("metrics.#").Exists(),
 				check.That(data.ResourceName).Key("logs.#").Exists(),
+				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
 			),
 		},
 	})
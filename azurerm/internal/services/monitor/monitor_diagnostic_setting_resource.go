@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -16,6 +15,7 @@ import (
 	eventhubValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/diagnosticsettings"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/validate"
 	storageParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
@@ -34,6 +34,8 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(monitorDiagnosticSettingCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -94,13 +96,23 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			},
 
 			"log": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"enable_all"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
 							Type:     pluginsdk.TypeString,
-							Required: true,
+							Optional: true,
+						},
+
+						"category_group": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"allLogs",
+								"audit",
+							}, false),
 						},
 
 						"enabled": {
@@ -133,8 +145,9 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			},
 
 			"metric": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"enable_all"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -170,36 +183,135 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			// when enabled the available categories are resolved during plan (using the same API as the
+			// `azurerm_monitor_diagnostic_categories` Data Source) so that newly added categories get enabled
+			"enable_all": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"log", "metric"},
+			},
+
+			// the categories which were available when `enable_all` was last applied, a change is only planned
+			// when these differ - since the API may not accept every available category (which would otherwise
+			// cause a perpetual diff against `enabled_log_categories` / `enabled_metric_categories`)
+			"resolved_log_categories": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"resolved_metric_categories": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"enabled_log_categories": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"enabled_metric_categories": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+				Set: pluginsdk.HashString,
+			},
 		},
 	}
 }
 
 func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
 	name := d.Get("name").(string)
 	actualResourceId := d.Get("target_resource_id").(string)
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, actualResourceId, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("Error checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", name, actualResourceId, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", fmt.Sprintf("%s|%s", actualResourceId, name))
 		}
 	}
 
-	logsRaw := d.Get("log").(*pluginsdk.Set).List()
-	logs := expandMonitorDiagnosticsSettingsLogs(logsRaw)
-	metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
-	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
+	var logs []diagnosticsettings.LogSettings
+	var metrics []diagnosticsettings.MetricSettings
+	if d.Get("enable_all").(bool) {
+		// use the categories resolved during plan, unless the target resource didn't exist at that point
+		logCategories := utils.ExpandStringSlice(d.Get("resolved_log_categories").(*pluginsdk.Set).List())
+		metricCategories := utils.ExpandStringSlice(d.Get("resolved_metric_categories").(*pluginsdk.Set).List())
+		if len(*logCategories) == 0 && len(*metricCategories) == 0 {
+			categories, err := monitorDiagnosticCategoriesForResource(ctx, categoriesClient, actualResourceId)
+			if err != nil {
+				return err
+			}
+			logCategories = &categories.logs
+			metricCategories = &categories.metrics
+		}
+
+		logs = make([]diagnosticsettings.LogSettings, 0)
+		for _, category := range *logCategories {
+			logs = append(logs, diagnosticsettings.LogSettings{
+				Category: utils.String(category),
+				Enabled:  true,
+			})
+		}
+
+		metrics = make([]diagnosticsettings.MetricSettings, 0)
+		for _, category := range *metricCategories {
+			metrics = append(metrics, diagnosticsettings.MetricSettings{
+				Category: utils.String(category),
+				Enabled:  true,
+			})
+		}
+
+		if err := d.Set("resolved_log_categories", *logCategories); err != nil {
+			return fmt.Errorf("Error setting `resolved_log_categories`: %+v", err)
+		}
+		if err := d.Set("resolved_metric_categories", *metricCategories); err != nil {
+			return fmt.Errorf("Error setting `resolved_metric_categories`: %+v", err)
+		}
+	} else {
+		logsRaw := d.Get("log").(*pluginsdk.Set).List()
+		expandedLogs, err := expandMonitorDiagnosticsSettingsLogs(logsRaw)
+		if err != nil {
+			return err
+		}
+		logs = expandedLogs
+		metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
+		metrics = expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
+
+		if err := d.Set("resolved_log_categories", []string{}); err != nil {
+			return fmt.Errorf("Error setting `resolved_log_categories`: %+v", err)
+		}
+		if err := d.Set("resolved_metric_categories", []string{}); err != nil {
+			return fmt.Errorf("Error setting `resolved_metric_categories`: %+v", err)
+		}
+	}
 
 	// if no blocks are specified  the API "creates" but 404's on Read
 	if len(logs) == 0 && len(metrics) == 0 {
@@ -209,14 +321,14 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 	// also if there's none enabled
 	valid := false
 	for _, v := range logs {
-		if v.Enabled != nil && *v.Enabled {
+		if v.Enabled {
 			valid = true
 			break
 		}
 	}
 	if !valid {
 		for _, v := range metrics {
-			if v.Enabled != nil && *v.Enabled {
+			if v.Enabled {
 				valid = true
 				break
 			}
@@ -227,8 +339,8 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("At least one `log` or `metric` must be enabled")
 	}
 
-	properties := insights.DiagnosticSettingsResource{
-		DiagnosticSettings: &insights.DiagnosticSettings{
+	properties := diagnosticsettings.DiagnosticSettingsResource{
+		Properties: &diagnosticsettings.DiagnosticSettings{
 			Logs:    &logs,
 			Metrics: &metrics,
 		},
@@ -238,26 +350,26 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
 	eventHubName := d.Get("eventhub_name").(string)
 	if eventHubAuthorizationRuleId != "" {
-		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
-		properties.DiagnosticSettings.EventHubName = utils.String(eventHubName)
+		properties.Properties.EventHubAuthorizationRuleId = utils.String(eventHubAuthorizationRuleId)
+		properties.Properties.EventHubName = utils.String(eventHubName)
 		valid = true
 	}
 
 	workspaceId := d.Get("log_analytics_workspace_id").(string)
 	if workspaceId != "" {
-		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
+		properties.Properties.WorkspaceId = utils.String(workspaceId)
 		valid = true
 	}
 
 	storageAccountId := d.Get("storage_account_id").(string)
 	if storageAccountId != "" {
-		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
+		properties.Properties.StorageAccountId = utils.String(storageAccountId)
 		valid = true
 	}
 
 	if v := d.Get("log_analytics_destination_type").(string); v != "" {
 		if workspaceId != "" {
-			properties.DiagnosticSettings.LogAnalyticsDestinationType = &v
+			properties.Properties.LogAnalyticsDestinationType = &v
 		} else {
 			return fmt.Errorf("`log_analytics_workspace_id` must be set for `log_analytics_destination_type` to be used")
 		}
@@ -267,17 +379,15 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be set")
 	}
 
-	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
 		return fmt.Errorf("Error creating Monitor Diagnostics Setting %q for Resource %q: %+v", name, actualResourceId, err)
 	}
 
-	read, err := client.Get(ctx, targetResourceId, name)
+	read, err := client.Get(ctx, id)
 	if err != nil {
 		return err
 	}
-	if read.ID == nil {
+	if read.Model == nil || read.Model.Id == nil {
 		return fmt.Errorf("Cannot read ID for Monitor Diagnostics %q for Resource ID %q", name, actualResourceId)
 	}
 
@@ -297,10 +407,9 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	}
 
 	actualResourceId := id.ResourceID
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	resp, err := client.Get(ctx, targetResourceId, id.Name)
+	resp, err := client.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource %q - removing from state!", id.Name, actualResourceId)
 			d.SetId("")
 			return nil
@@ -312,48 +421,80 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("name", id.Name)
 	d.Set("target_resource_id", id.ResourceID)
 
-	d.Set("eventhub_name", resp.EventHubName)
-	eventhubAuthorizationRuleId := ""
-	if resp.EventHubAuthorizationRuleID != nil && *resp.EventHubAuthorizationRuleID != "" {
-		parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*resp.EventHubAuthorizationRuleID)
-		if err != nil {
-			return err
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
+		d.Set("eventhub_name", props.EventHubName)
+		eventhubAuthorizationRuleId := ""
+		if props.EventHubAuthorizationRuleId != nil && *props.EventHubAuthorizationRuleId != "" {
+			parsedId, err := eventhubParse.NamespaceAuthorizationRuleIDInsensitively(*props.EventHubAuthorizationRuleId)
+			if err != nil {
+				return err
+			}
+
+			eventhubAuthorizationRuleId = parsedId.ID()
 		}
+		d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
 
-		eventhubAuthorizationRuleId = parsedId.ID()
-	}
-	d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
+		workspaceId := ""
+		if props.WorkspaceId != nil && *props.WorkspaceId != "" {
+			parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceId)
+			if err != nil {
+				return err
+			}
 
-	workspaceId := ""
-	if resp.WorkspaceID != nil && *resp.WorkspaceID != "" {
-		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*resp.WorkspaceID)
-		if err != nil {
-			return err
+			workspaceId = parsedId.ID()
 		}
+		d.Set("log_analytics_workspace_id", workspaceId)
 
-		workspaceId = parsedId.ID()
-	}
-	d.Set("log_analytics_workspace_id", workspaceId)
+		storageAccountId := ""
+		if props.StorageAccountId != nil && *props.StorageAccountId != "" {
+			parsedId, err := storageParse.StorageAccountID(*props.StorageAccountId)
+			if err != nil {
+				return err
+			}
 
-	storageAccountId := ""
-	if resp.StorageAccountID != nil && *resp.StorageAccountID != "" {
-		parsedId, err := storageParse.StorageAccountID(*resp.StorageAccountID)
-		if err != nil {
-			return err
+			storageAccountId = parsedId.ID()
 		}
+		d.Set("storage_account_id", storageAccountId)
 
-		storageAccountId = parsedId.ID()
-	}
-	d.Set("storage_account_id", storageAccountId)
+		d.Set("log_analytics_destination_type", props.LogAnalyticsDestinationType)
 
-	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
+		// when `enable_all` is set the categories are managed by the provider, so the `log` and `metric`
+		// blocks aren't set to avoid a diff each time a new category becomes available
+		if !d.Get("enable_all").(bool) {
+			if err := d.Set("log", flattenMonitorDiagnosticLogs(props.Logs)); err != nil {
+				return fmt.Errorf("Error setting `log`: %+v", err)
+			}
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
-		return fmt.Errorf("Error setting `log`: %+v", err)
-	}
+			if err := d.Set("metric", flattenMonitorDiagnosticMetrics(props.Metrics)); err != nil {
+				return fmt.Errorf("Error setting `metric`: %+v", err)
+			}
+		}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
-		return fmt.Errorf("Error setting `metric`: %+v", err)
+		enabledLogCategories := make([]string, 0)
+		if props.Logs != nil {
+			for _, v := range *props.Logs {
+				if v.Enabled && v.Category != nil {
+					enabledLogCategories = append(enabledLogCategories, *v.Category)
+				}
+			}
+		}
+		if err := d.Set("enabled_log_categories", enabledLogCategories); err != nil {
+			return fmt.Errorf("Error setting `enabled_log_categories`: %+v", err)
+		}
+
+		enabledMetricCategories := make([]string, 0)
+		if props.Metrics != nil {
+			for _, v := range *props.Metrics {
+				if v.Enabled && v.Category != nil {
+					enabledMetricCategories = append(enabledMetricCategories, *v.Category)
+				}
+			}
+		}
+		if err := d.Set("enabled_metric_categories", enabledMetricCategories); err != nil {
+			return fmt.Errorf("Error setting `enabled_metric_categories`: %+v", err)
+		}
 	}
 
 	return nil
@@ -369,11 +510,11 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	targetResourceId := strings.TrimPrefix(id.ResourceID, "/")
-	resp, err := client.Delete(ctx, targetResourceId, id.Name)
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ResourceID, id.Name)
+	resp, err := client.Delete(ctx, settingId)
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("Error deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, targetResourceId, err)
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("Error deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, id.ResourceID, err)
 		}
 	}
 
@@ -382,7 +523,7 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:                   []string{"Exists"},
 		Target:                    []string{"NotFound"},
-		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, targetResourceId, id.Name),
+		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, settingId),
 		MinTimeout:                15 * time.Second,
 		ContinuousTargetOccurence: 5,
 		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
@@ -395,11 +536,11 @@ func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta inte
 	return nil
 }
 
-func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *insights.DiagnosticSettingsClient, targetResourceId string, name string) pluginsdk.StateRefreshFunc {
+func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id diagnosticsettings.ScopedDiagnosticSettingId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, targetResourceId, name)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
+			if response.WasNotFound(res.HttpResponse) {
 				return "NotFound", "NotFound", nil
 			}
 			return nil, "", fmt.Errorf("Error issuing read request in monitorDiagnosticSettingDeletedRefreshFunc: %s", err)
@@ -409,39 +550,123 @@ func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *ins
 	}
 }
 
-func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSettings {
-	results := make([]insights.LogSettings, 0)
+func monitorDiagnosticSettingCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !diff.Get("enable_all").(bool) {
+		if diff.HasChange("enable_all") {
+			if err := diff.SetNew("resolved_log_categories", []string{}); err != nil {
+				return fmt.Errorf("setting `resolved_log_categories`: %+v", err)
+			}
+			if err := diff.SetNew("resolved_metric_categories", []string{}); err != nil {
+				return fmt.Errorf("setting `resolved_metric_categories`: %+v", err)
+			}
+		}
+
+		if diff.HasChange("log") || diff.HasChange("metric") || diff.HasChange("enable_all") {
+			return monitorDiagnosticSettingEnabledCategoriesComputed(diff)
+		}
+		return nil
+	}
+
+	// the target resource may not exist yet, in which case the categories are resolved during the apply
+	if !diff.NewValueKnown("target_resource_id") {
+		if err := diff.SetNewComputed("resolved_log_categories"); err != nil {
+			return err
+		}
+		if err := diff.SetNewComputed("resolved_metric_categories"); err != nil {
+			return err
+		}
+		return monitorDiagnosticSettingEnabledCategoriesComputed(diff)
+	}
+
+	client := v.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+	categories, err := monitorDiagnosticCategoriesForResource(ctx, client, diff.Get("target_resource_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// only compare against the categories which were resolved previously, rather than those which were enabled,
+	// since the API can refuse to enable some of the available categories
+	if diff.Id() != "" && !diff.HasChange("enable_all") &&
+		monitorDiagnosticCategoriesMatch(diff.Get("resolved_log_categories").(*pluginsdk.Set), categories.logs) &&
+		monitorDiagnosticCategoriesMatch(diff.Get("resolved_metric_categories").(*pluginsdk.Set), categories.metrics) {
+		return nil
+	}
+
+	if err := diff.SetNew("resolved_log_categories", categories.logs); err != nil {
+		return fmt.Errorf("setting `resolved_log_categories`: %+v", err)
+	}
+
+	if err := diff.SetNew("resolved_metric_categories", categories.metrics); err != nil {
+		return fmt.Errorf("setting `resolved_metric_categories`: %+v", err)
+	}
+
+	// which of these categories are enabled is only known once the API has accepted them
+	return monitorDiagnosticSettingEnabledCategoriesComputed(diff)
+}
+
+func monitorDiagnosticSettingEnabledCategoriesComputed(diff *pluginsdk.ResourceDiff) error {
+	if err := diff.SetNewComputed("enabled_log_categories"); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("enabled_metric_categories")
+}
+
+func monitorDiagnosticCategoriesMatch(existing *pluginsdk.Set, available []string) bool {
+	if existing.Len() != len(available) {
+		return false
+	}
+
+	for _, category := range available {
+		if !existing.Contains(category) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandMonitorDiagnosticsSettingsLogs(input []interface{}) ([]diagnosticsettings.LogSettings, error) {
+	results := make([]diagnosticsettings.LogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
 		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within a `log` block")
+		}
+
 		enabled := v["enabled"].(bool)
 		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
+		var retentionPolicy *diagnosticsettings.RetentionPolicy
 		if len(policiesRaw) != 0 {
 			policyRaw := policiesRaw[0].(map[string]interface{})
 			retentionDays := policyRaw["days"].(int)
 			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
+			retentionPolicy = &diagnosticsettings.RetentionPolicy{
+				Days:    int64(retentionDays),
+				Enabled: retentionEnabled,
 			}
 		}
 
-		output := insights.LogSettings{
-			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
+		output := diagnosticsettings.LogSettings{
+			Enabled:         enabled,
 			RetentionPolicy: retentionPolicy,
 		}
+		if category != "" {
+			output.Category = utils.String(category)
+		} else {
+			output.CategoryGroup = utils.String(categoryGroup)
+		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
-func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
+func flattenMonitorDiagnosticLogs(input *[]diagnosticsettings.LogSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -450,28 +675,27 @@ func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
 	for _, v := range *input {
 		output := make(map[string]interface{})
 
+		category := ""
 		if v.Category != nil {
-			output["category"] = *v.Category
+			category = *v.Category
 		}
+		output["category"] = category
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
+		categoryGroup := ""
+		if v.CategoryGroup != nil {
+			categoryGroup = *v.CategoryGroup
 		}
+		output["category_group"] = categoryGroup
+
+		output["enabled"] = v.Enabled
 
 		policies := make([]interface{}, 0)
 
 		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
-
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
-
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
-
-			policies = append(policies, outputPolicy)
+			policies = append(policies, map[string]interface{}{
+				"days":    int(inputPolicy.Days),
+				"enabled": inputPolicy.Enabled,
+			})
 		}
 
 		output["retention_policy"] = policies
@@ -482,8 +706,8 @@ func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
 	return results
 }
 
-func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.MetricSettings {
-	results := make([]insights.MetricSettings, 0)
+func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
+	results := make([]diagnosticsettings.MetricSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
@@ -492,19 +716,19 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 		enabled := v["enabled"].(bool)
 
 		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
+		var retentionPolicy *diagnosticsettings.RetentionPolicy
 		if len(policiesRaw) > 0 && policiesRaw[0] != nil {
 			policyRaw := policiesRaw[0].(map[string]interface{})
 			retentionDays := policyRaw["days"].(int)
 			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
+			retentionPolicy = &diagnosticsettings.RetentionPolicy{
+				Days:    int64(retentionDays),
+				Enabled: retentionEnabled,
 			}
 		}
-		output := insights.MetricSettings{
+		output := diagnosticsettings.MetricSettings{
 			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
+			Enabled:         enabled,
 			RetentionPolicy: retentionPolicy,
 		}
 
@@ -514,7 +738,7 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 	return results
 }
 
-func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interface{} {
+func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -527,24 +751,15 @@ func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interfa
 			output["category"] = *v.Category
 		}
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
-		}
+		output["enabled"] = v.Enabled
 
 		policies := make([]interface{}, 0)
 
 		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
-
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
-
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
-
-			policies = append(policies, outputPolicy)
+			policies = append(policies, map[string]interface{}{
+				"days":    int(inputPolicy.Days),
+				"enabled": inputPolicy.Enabled,
+			})
 		}
 
 		output["retention_policy"] = policies
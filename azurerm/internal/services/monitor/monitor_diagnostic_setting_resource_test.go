@@ -3,13 +3,13 @@ package monitor_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/diagnosticsettings"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -28,7 +28,7 @@ func TestAccMonitorDiagnosticSetting_eventhub(t *testing.T) {
 				check.That(data.ResourceName).Key("eventhub_name").Exists(),
 				check.That(data.ResourceName).Key("eventhub_authorization_rule_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -66,7 +66,7 @@ func TestAccMonitorDiagnosticSetting_logAnalyticsWorkspace(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("log_analytics_workspace_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -101,7 +101,7 @@ func TestAccMonitorDiagnosticSetting_storageAccount(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("storage_account_id").Exists(),
 				check.That(data.ResourceName).Key("log.#").HasValue("1"),
-				check.That(data.ResourceName).Key("log.782743152.category").HasValue("AuditEvent"),
+				check.That(data.ResourceName).Key("log.2862966835.category").HasValue("AuditEvent"),
 				check.That(data.ResourceName).Key("metric.#").HasValue("1"),
 				check.That(data.ResourceName).Key("metric.1439188313.category").HasValue("AllMetrics"),
 			),
@@ -125,20 +125,63 @@ func TestAccMonitorDiagnosticSetting_activityLog(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDiagnosticSetting_logCategoryGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "test")
+	r := MonitorDiagnosticSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.logCategoryGroup(data, "allLogs"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.logCategoryGroup(data, "audit"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorDiagnosticSetting_enableAll(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "test")
+	r := MonitorDiagnosticSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.enableAll(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled_log_categories.#").Exists(),
+				check.That(data.ResourceName).Key("enabled_metric_categories.#").Exists(),
+			),
+		},
+		{
+			// ensures the categories resolved during plan match those enabled during apply
+			Config:   r.enableAll(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func (t MonitorDiagnosticSettingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := monitor.ParseMonitorDiagnosticId(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	actualResourceId := id.ResourceID
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
 
-	resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, targetResourceId, id.Name)
+	resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(id.ResourceID, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading diagnostic setting (%s): %+v", id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func (MonitorDiagnosticSettingResource) eventhub(data acceptance.TestData) string {
@@ -536,3 +579,88 @@ resource "azurerm_monitor_diagnostic_setting" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17))
 }
+
+func (MonitorDiagnosticSettingResource) logCategoryGroup(data acceptance.TestData, categoryGroup string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[1]d"
+  target_resource_id         = azurerm_key_vault.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  log {
+    category_group = "%[4]s"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17), categoryGroup)
+}
+
+func (MonitorDiagnosticSettingResource) enableAll(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[1]d"
+  target_resource_id         = azurerm_key_vault.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  enable_all                 = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(17))
+}
@@ -0,0 +1,748 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/sdk/scheduledqueryrules"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type monitorScheduledQueryRulesAlertV2Identity = identity.SystemAssignedUserAssigned
+
+func resourceMonitorScheduledQueryRulesAlertV2() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorScheduledQueryRulesAlertV2CreateUpdate,
+		Read:   resourceMonitorScheduledQueryRulesAlertV2Read,
+		Update: resourceMonitorScheduledQueryRulesAlertV2CreateUpdate,
+		Delete: resourceMonitorScheduledQueryRulesAlertV2Delete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := scheduledqueryrules.ParseScheduledQueryRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("<>*%&:\\?+/"),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"criteria": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"time_aggregation_method": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduledqueryrules.TimeAggregationAverage),
+								string(scheduledqueryrules.TimeAggregationCount),
+								string(scheduledqueryrules.TimeAggregationMaximum),
+								string(scheduledqueryrules.TimeAggregationMinimum),
+								string(scheduledqueryrules.TimeAggregationTotal),
+							}, false),
+						},
+
+						"operator": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(scheduledqueryrules.ConditionOperatorEquals),
+								string(scheduledqueryrules.ConditionOperatorGreaterThan),
+								string(scheduledqueryrules.ConditionOperatorGreaterThanOrEqual),
+								string(scheduledqueryrules.ConditionOperatorLessThan),
+								string(scheduledqueryrules.ConditionOperatorLessThanOrEqual),
+							}, false),
+						},
+
+						"threshold": {
+							Type:     pluginsdk.TypeFloat,
+							Required: true,
+						},
+
+						"dimension": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"operator": {
+										Type:     pluginsdk.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(scheduledqueryrules.DimensionOperatorExclude),
+											string(scheduledqueryrules.DimensionOperatorInclude),
+										}, false),
+									},
+
+									"values": {
+										Type:     pluginsdk.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+						},
+
+						"failing_periods": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"minimum_failing_periods_to_trigger_alert": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+
+									"number_of_evaluation_periods": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 6),
+									},
+								},
+							},
+						},
+
+						"metric_measure_column": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"resource_id_column": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"evaluation_frequency": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(monitorScheduledQueryRulesAlertV2EvaluationFrequencies(), false),
+			},
+
+			"severity": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 4),
+			},
+
+			"window_size": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(monitorScheduledQueryRulesAlertV2WindowSizes(), false),
+			},
+
+			"action": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action_groups": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+						},
+
+						"custom_properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"auto_mitigation_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"mute_actions_after_alert_duration"},
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"identity": monitorScheduledQueryRulesAlertV2IdentitySchema(),
+
+			"mute_actions_after_alert_duration": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"auto_mitigation_enabled"},
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT5M",
+					"PT10M",
+					"PT15M",
+					"PT30M",
+					"PT45M",
+					"PT1H",
+					"PT2H",
+					"PT3H",
+					"PT4H",
+					"PT5H",
+					"PT6H",
+					"P1D",
+					"P2D",
+				}, false),
+			},
+
+			"query_time_range_override": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"PT5M",
+					"PT10M",
+					"PT15M",
+					"PT20M",
+					"PT30M",
+					"PT45M",
+					"PT1H",
+					"PT2H",
+					"PT3H",
+					"PT4H",
+					"PT5H",
+					"PT6H",
+					"P1D",
+					"P2D",
+				}, false),
+			},
+
+			"skip_query_validation": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"target_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"workspace_alerts_storage_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"created_with_api_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"is_a_legacy_log_analytics_rule": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"is_workspace_alerts_storage_configured": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2CreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := scheduledqueryrules.NewScheduledQueryRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_scheduled_query_rules_alert_v2", id.ID())
+		}
+	}
+
+	criteria, err := expandMonitorScheduledQueryRulesAlertV2Criteria(d.Get("criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	ruleIdentity, err := expandMonitorScheduledQueryRulesAlertV2Identity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	kind := scheduledqueryrules.KindLogAlert
+	severity := scheduledqueryrules.AlertSeverity(int64(d.Get("severity").(int)))
+	props := scheduledqueryrules.ScheduledQueryRuleProperties{
+		Actions:                               expandMonitorScheduledQueryRulesAlertV2Action(d.Get("action").([]interface{})),
+		AutoMitigate:                          utils.Bool(d.Get("auto_mitigation_enabled").(bool)),
+		CheckWorkspaceAlertsStorageConfigured: utils.Bool(d.Get("workspace_alerts_storage_enabled").(bool)),
+		Criteria:                              criteria,
+		Enabled:                               utils.Bool(d.Get("enabled").(bool)),
+		EvaluationFrequency:                   utils.String(d.Get("evaluation_frequency").(string)),
+		Scopes:                                utils.ExpandStringSlice(d.Get("scopes").([]interface{})),
+		Severity:                              &severity,
+		SkipQueryValidation:                   utils.Bool(d.Get("skip_query_validation").(bool)),
+		TargetResourceTypes:                   utils.ExpandStringSlice(d.Get("target_resource_types").([]interface{})),
+		WindowSize:                            utils.String(d.Get("window_size").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		props.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		props.DisplayName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("mute_actions_after_alert_duration"); ok {
+		props.MuteActionsDuration = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("query_time_range_override"); ok {
+		props.OverrideQueryTimeRange = utils.String(v.(string))
+	}
+
+	parameters := scheduledqueryrules.ScheduledQueryRuleResource{
+		Identity:   ruleIdentity,
+		Kind:       &kind,
+		Location:   location.Normalize(d.Get("location").(string)),
+		Properties: props,
+		Tags:       expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMonitorScheduledQueryRulesAlertV2Read(d, meta)
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2Read(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("identity", flattenMonitorScheduledQueryRulesAlertV2Identity(model.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		props := model.Properties
+		if err := d.Set("action", flattenMonitorScheduledQueryRulesAlertV2Action(props.Actions)); err != nil {
+			return fmt.Errorf("setting `action`: %+v", err)
+		}
+
+		if err := d.Set("criteria", flattenMonitorScheduledQueryRulesAlertV2Criteria(props.Criteria)); err != nil {
+			return fmt.Errorf("setting `criteria`: %+v", err)
+		}
+
+		if err := d.Set("scopes", utils.FlattenStringSlice(props.Scopes)); err != nil {
+			return fmt.Errorf("setting `scopes`: %+v", err)
+		}
+
+		if err := d.Set("target_resource_types", utils.FlattenStringSlice(props.TargetResourceTypes)); err != nil {
+			return fmt.Errorf("setting `target_resource_types`: %+v", err)
+		}
+
+		d.Set("auto_mitigation_enabled", props.AutoMitigate != nil && *props.AutoMitigate)
+		d.Set("workspace_alerts_storage_enabled", props.CheckWorkspaceAlertsStorageConfigured != nil && *props.CheckWorkspaceAlertsStorageConfigured)
+		d.Set("description", props.Description)
+		d.Set("display_name", props.DisplayName)
+		d.Set("enabled", props.Enabled != nil && *props.Enabled)
+		d.Set("evaluation_frequency", props.EvaluationFrequency)
+		d.Set("mute_actions_after_alert_duration", props.MuteActionsDuration)
+		d.Set("query_time_range_override", props.OverrideQueryTimeRange)
+		d.Set("skip_query_validation", props.SkipQueryValidation != nil && *props.SkipQueryValidation)
+		d.Set("window_size", props.WindowSize)
+		d.Set("created_with_api_version", props.CreatedWithApiVersion)
+		d.Set("is_a_legacy_log_analytics_rule", props.IsLegacyLogAnalyticsRule != nil && *props.IsLegacyLogAnalyticsRule)
+		d.Set("is_workspace_alerts_storage_configured", props.IsWorkspaceAlertsStorageConfigured != nil && *props.IsWorkspaceAlertsStorageConfigured)
+
+		severity := 0
+		if props.Severity != nil {
+			severity = int(*props.Severity)
+		}
+		d.Set("severity", severity)
+
+		if err := tags.FlattenAndSet(d, flattenTags(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorScheduledQueryRulesAlertV2Delete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ScheduledQueryRulesV2Client
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := scheduledqueryrules.ParseScheduledQueryRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func monitorScheduledQueryRulesAlertV2EvaluationFrequencies() []string {
+	return []string{
+		"PT1M",
+		"PT5M",
+		"PT10M",
+		"PT15M",
+		"PT30M",
+		"PT45M",
+		"PT1H",
+		"PT2H",
+		"PT3H",
+		"PT4H",
+		"PT5H",
+		"PT6H",
+		"P1D",
+	}
+}
+
+func monitorScheduledQueryRulesAlertV2WindowSizes() []string {
+	return append(monitorScheduledQueryRulesAlertV2EvaluationFrequencies(), "P2D")
+}
+
+func expandMonitorScheduledQueryRulesAlertV2Criteria(input []interface{}) (*scheduledqueryrules.ScheduledQueryRuleCriteria, error) {
+	conditions := make([]scheduledqueryrules.Condition, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		operator := scheduledqueryrules.ConditionOperator(v["operator"].(string))
+		timeAggregation := scheduledqueryrules.TimeAggregation(v["time_aggregation_method"].(string))
+		condition := scheduledqueryrules.Condition{
+			Dimensions:      expandMonitorScheduledQueryRulesAlertV2Dimensions(v["dimension"].([]interface{})),
+			Operator:        &operator,
+			Query:           utils.String(v["query"].(string)),
+			Threshold:       utils.Float(v["threshold"].(float64)),
+			TimeAggregation: &timeAggregation,
+		}
+
+		if periods := v["failing_periods"].([]interface{}); len(periods) > 0 && periods[0] != nil {
+			raw := periods[0].(map[string]interface{})
+			minimum := raw["minimum_failing_periods_to_trigger_alert"].(int)
+			total := raw["number_of_evaluation_periods"].(int)
+			if minimum > total {
+				return nil, fmt.Errorf("`minimum_failing_periods_to_trigger_alert` must be less than or equal to `number_of_evaluation_periods`")
+			}
+
+			condition.FailingPeriods = &scheduledqueryrules.ConditionFailingPeriods{
+				MinFailingPeriodsToAlert:  utils.Int64(int64(minimum)),
+				NumberOfEvaluationPeriods: utils.Int64(int64(total)),
+			}
+		}
+
+		if column := v["metric_measure_column"].(string); column != "" {
+			condition.MetricMeasureColumn = utils.String(column)
+		}
+
+		if column := v["resource_id_column"].(string); column != "" {
+			condition.ResourceIdColumn = utils.String(column)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return &scheduledqueryrules.ScheduledQueryRuleCriteria{
+		AllOf: &conditions,
+	}, nil
+}
+
+func flattenMonitorScheduledQueryRulesAlertV2Criteria(input *scheduledqueryrules.ScheduledQueryRuleCriteria) []interface{} {
+	if input == nil || input.AllOf == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, condition := range *input.AllOf {
+		failingPeriods := make([]interface{}, 0)
+		if periods := condition.FailingPeriods; periods != nil {
+			var minimum, total int64
+			if periods.MinFailingPeriodsToAlert != nil {
+				minimum = *periods.MinFailingPeriodsToAlert
+			}
+			if periods.NumberOfEvaluationPeriods != nil {
+				total = *periods.NumberOfEvaluationPeriods
+			}
+
+			failingPeriods = append(failingPeriods, map[string]interface{}{
+				"minimum_failing_periods_to_trigger_alert": minimum,
+				"number_of_evaluation_periods":             total,
+			})
+		}
+
+		var metricMeasureColumn, query, resourceIdColumn, operator, timeAggregation string
+		if condition.MetricMeasureColumn != nil {
+			metricMeasureColumn = *condition.MetricMeasureColumn
+		}
+		if condition.Query != nil {
+			query = *condition.Query
+		}
+		if condition.ResourceIdColumn != nil {
+			resourceIdColumn = *condition.ResourceIdColumn
+		}
+		if condition.Operator != nil {
+			operator = string(*condition.Operator)
+		}
+		if condition.TimeAggregation != nil {
+			timeAggregation = string(*condition.TimeAggregation)
+		}
+
+		threshold := 0.0
+		if condition.Threshold != nil {
+			threshold = *condition.Threshold
+		}
+
+		results = append(results, map[string]interface{}{
+			"dimension":               flattenMonitorScheduledQueryRulesAlertV2Dimensions(condition.Dimensions),
+			"failing_periods":         failingPeriods,
+			"metric_measure_column":   metricMeasureColumn,
+			"operator":                operator,
+			"query":                   query,
+			"resource_id_column":      resourceIdColumn,
+			"threshold":               threshold,
+			"time_aggregation_method": timeAggregation,
+		})
+	}
+
+	return results
+}
+
+func expandMonitorScheduledQueryRulesAlertV2Dimensions(input []interface{}) *[]scheduledqueryrules.Dimension {
+	dimensions := make([]scheduledqueryrules.Dimension, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, value := range v["values"].([]interface{}) {
+			values = append(values, value.(string))
+		}
+
+		dimensions = append(dimensions, scheduledqueryrules.Dimension{
+			Name:     v["name"].(string),
+			Operator: scheduledqueryrules.DimensionOperator(v["operator"].(string)),
+			Values:   values,
+		})
+	}
+
+	return &dimensions
+}
+
+func flattenMonitorScheduledQueryRulesAlertV2Dimensions(input *[]scheduledqueryrules.Dimension) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, dimension := range *input {
+		results = append(results, map[string]interface{}{
+			"name":     dimension.Name,
+			"operator": string(dimension.Operator),
+			"values":   utils.FlattenStringSlice(&dimension.Values),
+		})
+	}
+
+	return results
+}
+
+func expandMonitorScheduledQueryRulesAlertV2Action(input []interface{}) *scheduledqueryrules.Actions {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &scheduledqueryrules.Actions{
+		ActionGroups:     utils.ExpandStringSlice(v["action_groups"].([]interface{})),
+		CustomProperties: expandTags(v["custom_properties"].(map[string]interface{})),
+	}
+}
+
+func flattenMonitorScheduledQueryRulesAlertV2Action(input *scheduledqueryrules.Actions) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	customProperties := make(map[string]interface{})
+	if input.CustomProperties != nil {
+		for k, v := range *input.CustomProperties {
+			customProperties[k] = v
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"action_groups":     utils.FlattenStringSlice(input.ActionGroups),
+			"custom_properties": customProperties,
+		},
+	}
+}
+
+// monitorScheduledQueryRulesAlertV2IdentitySchema returns the identity schema limited to the types supported by the
+// API, which supports either a `SystemAssigned` or a `UserAssigned` identity, but not both
+func monitorScheduledQueryRulesAlertV2IdentitySchema() *pluginsdk.Schema {
+	schema := monitorScheduledQueryRulesAlertV2Identity{}.Schema()
+	schema.Elem.(*pluginsdk.Resource).Schema["type"].ValidateFunc = validation.StringInSlice([]string{
+		string(scheduledqueryrules.IdentityTypeSystemAssigned),
+		string(scheduledqueryrules.IdentityTypeUserAssigned),
+	}, false)
+	return schema
+}
+
+func expandMonitorScheduledQueryRulesAlertV2Identity(input []interface{}) (*scheduledqueryrules.Identity, error) {
+	config, err := monitorScheduledQueryRulesAlertV2Identity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var identityIds *map[string]scheduledqueryrules.UserIdentityProperties
+	if config.UserAssignedIdentityIds != nil {
+		ids := make(map[string]scheduledqueryrules.UserIdentityProperties)
+		for _, id := range *config.UserAssignedIdentityIds {
+			ids[id] = scheduledqueryrules.UserIdentityProperties{}
+		}
+		identityIds = &ids
+	}
+
+	return &scheduledqueryrules.Identity{
+		Type:                   scheduledqueryrules.IdentityType(config.Type),
+		UserAssignedIdentities: identityIds,
+	}, nil
+}
+
+func flattenMonitorScheduledQueryRulesAlertV2Identity(input *scheduledqueryrules.Identity) []interface{} {
+	var config *identity.ExpandedConfig
+	if input != nil {
+		config = &identity.ExpandedConfig{
+			Type:        identity.Type(input.Type),
+			PrincipalId: input.PrincipalId,
+			TenantId:    input.TenantId,
+		}
+
+		if input.UserAssignedIdentities != nil {
+			ids := make([]string, 0)
+			for id := range *input.UserAssignedIdentities {
+				ids = append(ids, id)
+			}
+			config.UserAssignedIdentityIds = &ids
+		}
+	}
+
+	return monitorScheduledQueryRulesAlertV2Identity{}.Flatten(config)
+}
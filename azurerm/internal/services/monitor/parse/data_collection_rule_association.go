@@ -0,0 +1,58 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+const dataCollectionRuleAssociationIdSeparator = "/providers/Microsoft.Insights/dataCollectionRuleAssociations/"
+
+// DataCollectionRuleAssociationId is the ID of a Data Collection Rule Association, which is scoped to the
+// resource it's associated with (e.g. a Virtual Machine or a Virtual Machine Scale Set)
+type DataCollectionRuleAssociationId struct {
+	TargetResourceId string
+	Name             string
+}
+
+func NewDataCollectionRuleAssociationID(targetResourceId, name string) DataCollectionRuleAssociationId {
+	return DataCollectionRuleAssociationId{
+		TargetResourceId: targetResourceId,
+		Name:             name,
+	}
+}
+
+func (id DataCollectionRuleAssociationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Target Resource %q", id.TargetResourceId),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Data Collection Rule Association", segmentsStr)
+}
+
+func (id DataCollectionRuleAssociationId) ID() string {
+	return fmt.Sprintf("%s%s%s", id.TargetResourceId, dataCollectionRuleAssociationIdSeparator, id.Name)
+}
+
+// DataCollectionRuleAssociationID parses a DataCollectionRuleAssociation ID into an DataCollectionRuleAssociationId struct
+func DataCollectionRuleAssociationID(input string) (*DataCollectionRuleAssociationId, error) {
+	segments := strings.Split(input, dataCollectionRuleAssociationIdSeparator)
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format `{targetResourceId}%s{name}` but got %q", dataCollectionRuleAssociationIdSeparator, input)
+	}
+
+	if _, err := azure.ParseAzureResourceID(segments[0]); err != nil {
+		return nil, fmt.Errorf("parsing target resource ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" || strings.Contains(segments[1], "/") {
+		return nil, fmt.Errorf("expected a Data Collection Rule Association name in %q", input)
+	}
+
+	return &DataCollectionRuleAssociationId{
+		TargetResourceId: segments[0],
+		Name:             segments[1],
+	}, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestDataCollectionRuleAssociationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DataCollectionRuleAssociationId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing association segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+			Error: true,
+		},
+
+		{
+			// missing association name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/dataCollectionRuleAssociations/",
+			Error: true,
+		},
+
+		{
+			// invalid target resource
+			Input: "vm1/providers/Microsoft.Insights/dataCollectionRuleAssociations/association1",
+			Error: true,
+		},
+
+		{
+			// valid virtual machine
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/dataCollectionRuleAssociations/association1",
+			Expected: &DataCollectionRuleAssociationId{
+				TargetResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+				Name:             "association1",
+			},
+		},
+
+		{
+			// valid virtual machine scale set
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1/providers/Microsoft.Insights/dataCollectionRuleAssociations/association1",
+			Expected: &DataCollectionRuleAssociationId{
+				TargetResourceId: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1",
+				Name:             "association1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := DataCollectionRuleAssociationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TargetResourceId != v.Expected.TargetResourceId {
+			t.Fatalf("Expected %q but got %q for TargetResourceId", v.Expected.TargetResourceId, actual.TargetResourceId)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID()", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package diagnosticsettings
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ScopedDiagnosticSettingId struct {
+	ResourceUri string
+	Name        string
+}
+
+func NewScopedDiagnosticSettingID(resourceUri, name string) ScopedDiagnosticSettingId {
+	return ScopedDiagnosticSettingId{
+		ResourceUri: resourceUri,
+		Name:        name,
+	}
+}
+
+func (id ScopedDiagnosticSettingId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Uri %q", id.ResourceUri),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Scoped Diagnostic Setting", segmentsStr)
+}
+
+func (id ScopedDiagnosticSettingId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Insights/diagnosticSettings/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.ResourceUri, "/"), id.Name)
+}
+
+// ParseScopedDiagnosticSettingID parses a ScopedDiagnosticSetting ID into an ScopedDiagnosticSettingId struct
+func ParseScopedDiagnosticSettingID(input string) (*ScopedDiagnosticSettingId, error) {
+	segments := strings.Split(input, "/providers/Microsoft.Insights/diagnosticSettings/")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format `{resourceUri}/providers/Microsoft.Insights/diagnosticSettings/{name}` but got %q", input)
+	}
+
+	if segments[0] == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceUri' element")
+	}
+
+	if segments[1] == "" || strings.Contains(segments[1], "/") {
+		return nil, fmt.Errorf("ID was missing the 'diagnosticSettings' element")
+	}
+
+	return &ScopedDiagnosticSettingId{
+		ResourceUri: segments[0],
+		Name:        segments[1],
+	}, nil
+}
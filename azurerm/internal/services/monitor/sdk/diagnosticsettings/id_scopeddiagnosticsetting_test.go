@@ -0,0 +1,71 @@
+package diagnosticsettings
+
+import (
+	"testing"
+)
+
+func TestScopedDiagnosticSettingIDFormatter(t *testing.T) {
+	actual := NewScopedDiagnosticSettingID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1", "setting1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/setting1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseScopedDiagnosticSettingID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScopedDiagnosticSettingId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing diagnostic setting
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			Error: true,
+		},
+
+		{
+			// missing diagnostic setting name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: &ScopedDiagnosticSettingId{
+				ResourceUri: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+				Name:        "setting1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScopedDiagnosticSettingID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ResourceUri != v.Expected.ResourceUri {
+			t.Fatalf("Expected %q but got %q for ResourceUri", v.Expected.ResourceUri, actual.ResourceUri)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package diagnosticsettings
+
+type DiagnosticSettings struct {
+	EventHubAuthorizationRuleId *string           `json:"eventHubAuthorizationRuleId,omitempty"`
+	EventHubName                *string           `json:"eventHubName,omitempty"`
+	LogAnalyticsDestinationType *string           `json:"logAnalyticsDestinationType,omitempty"`
+	Logs                        *[]LogSettings    `json:"logs,omitempty"`
+	Metrics                     *[]MetricSettings `json:"metrics,omitempty"`
+	ServiceBusRuleId            *string           `json:"serviceBusRuleId,omitempty"`
+	StorageAccountId            *string           `json:"storageAccountId,omitempty"`
+	WorkspaceId                 *string           `json:"workspaceId,omitempty"`
+}
@@ -0,0 +1,8 @@
+package diagnosticsettingscategories
+
+type CategoryType string
+
+const (
+	CategoryTypeLogs    CategoryType = "Logs"
+	CategoryTypeMetrics CategoryType = "Metrics"
+)
@@ -0,0 +1,66 @@
+package diagnosticsettingscategories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListResponse struct {
+	HttpResponse *http.Response
+	Model        *DiagnosticSettingsCategoryResourceCollection
+}
+
+// List ...
+func (c DiagnosticSettingsCategoriesClient) List(ctx context.Context, resourceUri string) (result ListResponse, err error) {
+	req, err := c.preparerForList(ctx, resourceUri)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "List", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "List", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForList(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "List", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForList prepares the List request.
+func (c DiagnosticSettingsCategoriesClient) preparerForList(ctx context.Context, resourceUri string) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("/%s/providers/Microsoft.Insights/diagnosticSettingsCategories", strings.TrimPrefix(resourceUri, "/"))),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForList handles the response to the List request. The method always
+// closes the http.Response Body.
+func (c DiagnosticSettingsCategoriesClient) responderForList(resp *http.Response) (result ListResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
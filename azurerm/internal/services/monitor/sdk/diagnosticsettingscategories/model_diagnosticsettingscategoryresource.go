@@ -0,0 +1,8 @@
+package diagnosticsettingscategories
+
+type DiagnosticSettingsCategoryResource struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *DiagnosticSettingsCategory `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
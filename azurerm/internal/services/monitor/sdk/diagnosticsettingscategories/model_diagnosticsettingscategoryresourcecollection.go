@@ -0,0 +1,5 @@
+package diagnosticsettingscategories
+
+type DiagnosticSettingsCategoryResourceCollection struct {
+	Value *[]DiagnosticSettingsCategoryResource `json:"value,omitempty"`
+}
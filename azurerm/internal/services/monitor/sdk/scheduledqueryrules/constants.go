@@ -0,0 +1,53 @@
+package scheduledqueryrules
+
+type AlertSeverity int64
+
+const (
+	AlertSeverityFour  AlertSeverity = 4
+	AlertSeverityOne   AlertSeverity = 1
+	AlertSeverityThree AlertSeverity = 3
+	AlertSeverityTwo   AlertSeverity = 2
+	AlertSeverityZero  AlertSeverity = 0
+)
+
+type ConditionOperator string
+
+const (
+	ConditionOperatorEquals             ConditionOperator = "Equals"
+	ConditionOperatorGreaterThan        ConditionOperator = "GreaterThan"
+	ConditionOperatorGreaterThanOrEqual ConditionOperator = "GreaterThanOrEqual"
+	ConditionOperatorLessThan           ConditionOperator = "LessThan"
+	ConditionOperatorLessThanOrEqual    ConditionOperator = "LessThanOrEqual"
+)
+
+type DimensionOperator string
+
+const (
+	DimensionOperatorExclude DimensionOperator = "Exclude"
+	DimensionOperatorInclude DimensionOperator = "Include"
+)
+
+type IdentityType string
+
+const (
+	IdentityTypeNone           IdentityType = "None"
+	IdentityTypeSystemAssigned IdentityType = "SystemAssigned"
+	IdentityTypeUserAssigned   IdentityType = "UserAssigned"
+)
+
+type Kind string
+
+const (
+	KindLogAlert    Kind = "LogAlert"
+	KindLogToMetric Kind = "LogToMetric"
+)
+
+type TimeAggregation string
+
+const (
+	TimeAggregationAverage TimeAggregation = "Average"
+	TimeAggregationCount   TimeAggregation = "Count"
+	TimeAggregationMaximum TimeAggregation = "Maximum"
+	TimeAggregationMinimum TimeAggregation = "Minimum"
+	TimeAggregationTotal   TimeAggregation = "Total"
+)
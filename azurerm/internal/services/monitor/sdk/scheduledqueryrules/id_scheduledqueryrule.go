@@ -0,0 +1,108 @@
+package scheduledqueryrules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ScheduledQueryRuleId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewScheduledQueryRuleID(subscriptionId, resourceGroup, name string) ScheduledQueryRuleId {
+	return ScheduledQueryRuleId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ScheduledQueryRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Scheduled Query Rule", segmentsStr)
+}
+
+func (id ScheduledQueryRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Insights/scheduledQueryRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ParseScheduledQueryRuleID parses a Scheduled Query Rule ID into an ScheduledQueryRuleId struct
+func ParseScheduledQueryRuleID(input string) (*ScheduledQueryRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ScheduledQueryRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("scheduledQueryRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseScheduledQueryRuleIDInsensitively parses a Scheduled Query Rule ID into an ScheduledQueryRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseScheduledQueryRuleID method should be used instead for validation etc.
+func ParseScheduledQueryRuleIDInsensitively(input string) (*ScheduledQueryRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ScheduledQueryRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'scheduledQueryRules' segment
+	scheduledQueryRulesKey := "scheduledQueryRules"
+	for key := range id.Path {
+		if strings.EqualFold(key, scheduledQueryRulesKey) {
+			scheduledQueryRulesKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(scheduledQueryRulesKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,6 @@
+package scheduledqueryrules
+
+type Actions struct {
+	ActionGroups     *[]string          `json:"actionGroups,omitempty"`
+	CustomProperties *map[string]string `json:"customProperties,omitempty"`
+}
@@ -0,0 +1,7 @@
+package scheduledqueryrules
+
+type Dimension struct {
+	Name     string            `json:"name"`
+	Operator DimensionOperator `json:"operator"`
+	Values   []string          `json:"values"`
+}
@@ -0,0 +1,12 @@
+package scheduledqueryrules
+
+type ScheduledQueryRuleResource struct {
+	Id         *string                      `json:"id,omitempty"`
+	Identity   *Identity                    `json:"identity,omitempty"`
+	Kind       *Kind                        `json:"kind,omitempty"`
+	Location   string                       `json:"location"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties ScheduledQueryRuleProperties `json:"properties"`
+	Tags       *map[string]string           `json:"tags,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package scheduledqueryrules
+
+type UserIdentityProperties struct {
+	ClientId    *string `json:"clientId,omitempty"`
+	PrincipalId *string `json:"principalId,omitempty"`
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
@@ -119,14 +119,14 @@ func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) er
 		if props.MaxSizeBytes != nil {
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
 		}
-		d.Set("read_replica_count", props.ReadReplicaCount)
+		d.Set("read_replica_count", props.HighAvailabilityReplicaCount)
 		if props.ReadScale == sql.DatabaseReadScaleEnabled {
 			d.Set("read_scale", true)
 		} else if props.ReadScale == sql.DatabaseReadScaleDisabled {
 			d.Set("read_scale", false)
 		}
 		d.Set("sku_name", props.CurrentServiceObjectiveName)
-		d.Set("storage_account_type", props.StorageAccountType)
+		d.Set("storage_account_type", flattenMsSqlDatabaseBackupStorageRedundancy(props.RequestedBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
 
@@ -7,7 +7,8 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	legacySql "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/response"
@@ -38,7 +39,6 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 			return err
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			replicationLinksClient := meta.(*clients.Client).MSSQL.ReplicationLinksClient
-			resourceClient := meta.(*clients.Client).Resource.ResourcesClient
 
 			id, err := parse.DatabaseID(d.Id())
 			if err != nil {
@@ -49,49 +49,30 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				return nil, fmt.Errorf("reading Replication Links for MsSql Database %s (MsSql Server Name %q / Resource Group %q): %s", id.Name, id.ServerName, id.ResourceGroup, err)
 			}
 
-			for _, link := range *resp.Value {
+			for _, link := range resp.Values() {
 				linkProps := *link.ReplicationLinkProperties
 				if linkProps.Role == sql.ReplicationRoleSecondary || linkProps.Role == sql.ReplicationRoleNonReadableSecondary {
 					d.Set("create_mode", string(sql.CreateModeSecondary))
 					log.Printf("[INFO] replication link found for %s MsSql Database %s (MsSql Server Name %q / Resource Group %q) with Database %q on MsSql Server %q ", string(sql.CreateModeSecondary), id.Name, id.ServerName, id.ResourceGroup, *linkProps.PartnerDatabase, *linkProps.PartnerServer)
 
-					// get all SQL Servers with the name of the linked Primary
-					filter := fmt.Sprintf("(resourceType eq 'Microsoft.Sql/servers') and ((name eq '%s'))", *linkProps.PartnerServer)
-					var resourceList []resources.GenericResourceExpanded
-					for resourcesIterator, err := resourceClient.ListComplete(ctx, filter, "", nil); resourcesIterator.NotDone(); err = resourcesIterator.NextWithContext(ctx) {
-						if err != nil {
-							return nil, fmt.Errorf("loading SQL Server List: %+v", err)
-						}
-
-						resourceList = append(resourceList, resourcesIterator.Value())
-					}
-					if err != nil {
-						return nil, fmt.Errorf("reading Linked Servers for MsSql Database %s (MsSql Server Name %q / Resource Group %q): %s", id.Name, id.ServerName, id.ResourceGroup, err)
-					}
-
-					for _, server := range resourceList {
-						serverID, err := parse.ServerID(*server.ID)
+					// the Primary can be on a SQL Server in another Subscription, so these are searched when it's not found in this one
+					subscriptionIds := []string{id.SubscriptionId}
+					for i := 0; i < len(subscriptionIds); i++ {
+						primaryId, err := findMsSqlDatabaseReplicationPrimary(ctx, meta, subscriptionIds[i], *id, *linkProps.PartnerServer, *linkProps.PartnerDatabase)
 						if err != nil {
 							return nil, err
 						}
-
-						// check if server named like the replication linked server has a database named like the partner database with a replication link
-						linksPossiblePrimary, err := replicationLinksClient.ListByDatabase(ctx, serverID.ResourceGroup, serverID.Name, *linkProps.PartnerDatabase)
-						if err != nil && !utils.ResponseWasNotFound(linksPossiblePrimary.Response) {
-							return nil, fmt.Errorf("reading Replication Links for MsSql Database %s (MsSql Server Name %q / Resource Group %q): %s", *linkProps.PartnerDatabase, serverID.Name, serverID.ResourceGroup, err)
-						}
-						if err != nil && utils.ResponseWasNotFound(linksPossiblePrimary.Response) {
-							log.Printf("[INFO] no replication link found for Database %q (MsSql Server %q / Resource Group %q): %s", *linkProps.PartnerDatabase, serverID.Name, serverID.ResourceGroup, err)
-							continue
+						if primaryId != nil {
+							d.Set("creation_source_database_id", primaryId.ID())
+							break
 						}
 
-						for _, linkPossiblePrimary := range *linksPossiblePrimary.Value {
-							linkPropsPossiblePrimary := *linkPossiblePrimary.ReplicationLinkProperties
-
-							// check if the database has a replication link for a primary role and specific partner location
-							if linkPropsPossiblePrimary.Role == sql.ReplicationRolePrimary && *linkPossiblePrimary.Location == *linkProps.PartnerLocation {
-								d.Set("creation_source_database_id", parse.NewDatabaseID(serverID.SubscriptionId, serverID.ResourceGroup, serverID.Name, *linkProps.PartnerDatabase).ID())
+						if i == 0 {
+							otherSubscriptionIds, err := listMsSqlDatabaseOtherSubscriptionIds(ctx, meta, id.SubscriptionId)
+							if err != nil {
+								return nil, err
 							}
+							subscriptionIds = append(subscriptionIds, otherSubscriptionIds...)
 						}
 					}
 					return []*pluginsdk.ResourceData{d}, nil
@@ -176,8 +157,8 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(sql.BasePrice),
-					string(sql.LicenseIncluded),
+					string(sql.DatabaseLicenseTypeBasePrice),
+					string(sql.DatabaseLicenseTypeLicenseIncluded),
 				}, false),
 			},
 
@@ -237,7 +218,7 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(sql.AdventureWorksLT),
+					string(sql.SampleNameAdventureWorksLT),
 				}, false),
 			},
 
@@ -261,11 +242,11 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(sql.GRS),
+				Default:  string(sql.StorageAccountTypeGRS),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(sql.GRS),
-					string(sql.LRS),
-					string(sql.ZRS),
+					string(sql.StorageAccountTypeGRS),
+					string(sql.StorageAccountTypeLRS),
+					string(sql.StorageAccountTypeZRS),
 				}, false),
 			},
 
@@ -275,6 +256,13 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"threat_detection_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -300,10 +288,10 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 							Type:             pluginsdk.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
-							Default:          string(sql.SecurityAlertPolicyEmailAccountAdminsDisabled),
+							Default:          string(legacySql.SecurityAlertPolicyEmailAccountAdminsDisabled),
 							ValidateFunc: validation.StringInSlice([]string{
-								string(sql.SecurityAlertPolicyEmailAccountAdminsDisabled),
-								string(sql.SecurityAlertPolicyEmailAccountAdminsEnabled),
+								string(legacySql.SecurityAlertPolicyEmailAccountAdminsDisabled),
+								string(legacySql.SecurityAlertPolicyEmailAccountAdminsEnabled),
 							}, true),
 						},
 
@@ -351,10 +339,10 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 							Type:             pluginsdk.TypeString,
 							Optional:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
-							Default:          string(sql.SecurityAlertPolicyUseServerDefaultDisabled),
+							Default:          string(legacySql.SecurityAlertPolicyUseServerDefaultDisabled),
 							ValidateFunc: validation.StringInSlice([]string{
-								string(sql.SecurityAlertPolicyUseServerDefaultDisabled),
-								string(sql.SecurityAlertPolicyUseServerDefaultEnabled),
+								string(legacySql.SecurityAlertPolicyUseServerDefaultDisabled),
+								string(legacySql.SecurityAlertPolicyUseServerDefaultEnabled),
 							}, true),
 						},
 					},
@@ -384,7 +372,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	auditingClient := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
 	serverClient := meta.(*clients.Client).MSSQL.ServersClient
 	threatClient := meta.(*clients.Client).MSSQL.DatabaseThreatDetectionPoliciesClient
-	longTermRetentionClient := meta.(*clients.Client).MSSQL.BackupLongTermRetentionPoliciesClient
+	longTermRetentionClient := meta.(*clients.Client).MSSQL.LongTermRetentionPoliciesClient
 	shortTermRetentionClient := meta.(*clients.Client).MSSQL.BackupShortTermRetentionPoliciesClient
 	geoBackupPoliciesClient := meta.(*clients.Client).MSSQL.GeoBackupPoliciesClient
 
@@ -414,7 +402,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	serverResp, err := serverClient.Get(ctx, serverId.ResourceGroup, serverId.Name)
+	serverResp, err := serverClient.Get(ctx, serverId.ResourceGroup, serverId.Name, "")
 	if err != nil {
 		return fmt.Errorf("making Read request on MsSql Server %q (Resource Group %q): %s", serverId.Name, serverId.ResourceGroup, err)
 	}
@@ -436,15 +424,16 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		Name:     &name,
 		Location: &location,
 		DatabaseProperties: &sql.DatabaseProperties{
-			AutoPauseDelay:     utils.Int32(int32(d.Get("auto_pause_delay_in_minutes").(int))),
-			Collation:          utils.String(d.Get("collation").(string)),
-			ElasticPoolID:      utils.String(d.Get("elastic_pool_id").(string)),
-			LicenseType:        sql.DatabaseLicenseType(d.Get("license_type").(string)),
-			MinCapacity:        utils.Float(d.Get("min_capacity").(float64)),
-			ReadReplicaCount:   utils.Int32(int32(d.Get("read_replica_count").(int))),
-			SampleName:         sql.SampleName(d.Get("sample_name").(string)),
-			StorageAccountType: sql.StorageAccountType(d.Get("storage_account_type").(string)),
-			ZoneRedundant:      utils.Bool(d.Get("zone_redundant").(bool)),
+			AutoPauseDelay:                   utils.Int32(int32(d.Get("auto_pause_delay_in_minutes").(int))),
+			Collation:                        utils.String(d.Get("collation").(string)),
+			ElasticPoolID:                    utils.String(d.Get("elastic_pool_id").(string)),
+			LicenseType:                      sql.DatabaseLicenseType(d.Get("license_type").(string)),
+			MinCapacity:                      utils.Float(d.Get("min_capacity").(float64)),
+			HighAvailabilityReplicaCount:     utils.Int32(int32(d.Get("read_replica_count").(int))),
+			SampleName:                       sql.SampleName(d.Get("sample_name").(string)),
+			RequestedBackupStorageRedundancy: expandMsSqlDatabaseBackupStorageRedundancy(d.Get("storage_account_type").(string)),
+			ZoneRedundant:                    utils.Bool(d.Get("zone_redundant").(bool)),
+			IsLedgerOn:                       utils.Bool(d.Get("ledger_enabled").(bool)),
 		},
 
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -464,17 +453,17 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	params.DatabaseProperties.CreateMode = sql.CreateMode(createMode.(string))
 
 	auditingPolicies := d.Get("extended_auditing_policy").([]interface{})
-	if (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.Secondary)) && len(auditingPolicies) > 0 {
+	if (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary)) && len(auditingPolicies) > 0 {
 		return fmt.Errorf("could not configure auditing policies on SQL Database %q (Resource Group %q, Server %q) in secondary create mode", name, serverId.ResourceGroup, serverId.Name)
 	}
 
 	if v, ok := d.GetOk("max_size_gb"); ok {
 		// `max_size_gb` is Computed, so has a value after the first run
-		if createMode != string(sql.CreateModeOnlineSecondary) && createMode != string(sql.Secondary) {
+		if createMode != string(sql.CreateModeOnlineSecondary) && createMode != string(sql.CreateModeSecondary) {
 			params.DatabaseProperties.MaxSizeBytes = utils.Int64(int64(v.(int) * 1073741824))
 		}
 		// `max_size_gb` only has change if it is configured
-		if d.HasChange("max_size_gb") && (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.Secondary)) {
+		if d.HasChange("max_size_gb") && (createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary)) {
 			return fmt.Errorf("it is not possible to change maximum size nor advised to configure maximum size on SQL Database %q (Resource Group %q, Server %q) in secondary create mode", name, serverId.ResourceGroup, serverId.Name)
 		}
 	}
@@ -575,10 +564,10 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		v := d.Get("long_term_retention_policy")
 		longTermRetentionProps := helper.ExpandLongTermRetentionPolicy(v.([]interface{}))
 		if longTermRetentionProps != nil {
-			longTermRetentionPolicy := sql.BackupLongTermRetentionPolicy{}
+			longTermRetentionPolicy := sql.LongTermRetentionPolicy{}
 
 			if !strings.HasPrefix(skuName.(string), "HS") && !strings.HasPrefix(skuName.(string), "DW") {
-				longTermRetentionPolicy.LongTermRetentionPolicyProperties = longTermRetentionProps
+				longTermRetentionPolicy.BaseLongTermRetentionPolicyProperties = longTermRetentionProps
 			}
 
 			longTermRetentionfuture, err := longTermRetentionClient.CreateOrUpdate(ctx, serverId.ResourceGroup, serverId.Name, name, longTermRetentionPolicy)
@@ -620,7 +609,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	client := meta.(*clients.Client).MSSQL.DatabasesClient
 	threatClient := meta.(*clients.Client).MSSQL.DatabaseThreatDetectionPoliciesClient
 	auditingClient := meta.(*clients.Client).MSSQL.DatabaseExtendedBlobAuditingPoliciesClient
-	longTermRetentionClient := meta.(*clients.Client).MSSQL.BackupLongTermRetentionPoliciesClient
+	longTermRetentionClient := meta.(*clients.Client).MSSQL.LongTermRetentionPoliciesClient
 	shortTermRetentionClient := meta.(*clients.Client).MSSQL.BackupShortTermRetentionPoliciesClient
 	geoBackupPoliciesClient := meta.(*clients.Client).MSSQL.GeoBackupPoliciesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -644,7 +633,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	serverClient := meta.(*clients.Client).MSSQL.ServersClient
 
-	serverResp, err := serverClient.Get(ctx, id.ResourceGroup, id.ServerName)
+	serverResp, err := serverClient.Get(ctx, id.ResourceGroup, id.ServerName, "")
 	if err != nil || *serverResp.ID == "" {
 		return fmt.Errorf("making Read request on MsSql Server  %q (Resource Group %q): %s", id.ServerName, id.ResourceGroup, err)
 	}
@@ -660,7 +649,8 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
 		}
 		d.Set("min_capacity", props.MinCapacity)
-		d.Set("read_replica_count", props.ReadReplicaCount)
+		// `readReplicaCount` has been renamed to `highAvailabilityReplicaCount` in the API
+		d.Set("read_replica_count", props.HighAvailabilityReplicaCount)
 		if props.ReadScale == sql.DatabaseReadScaleEnabled {
 			d.Set("read_scale", true)
 		} else if props.ReadScale == sql.DatabaseReadScaleDisabled {
@@ -670,8 +660,14 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			skuName = *props.CurrentServiceObjectiveName
 		}
 		d.Set("sku_name", props.CurrentServiceObjectiveName)
-		d.Set("storage_account_type", props.StorageAccountType)
+		d.Set("storage_account_type", flattenMsSqlDatabaseBackupStorageRedundancy(props.RequestedBackupStorageRedundancy))
 		d.Set("zone_redundant", props.ZoneRedundant)
+
+		ledgerEnabled := false
+		if props.IsLedgerOn != nil {
+			ledgerEnabled = *props.IsLedgerOn
+		}
+		d.Set("ledger_enabled", ledgerEnabled)
 	}
 
 	threat, err := threatClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
@@ -766,7 +762,7 @@ func resourceMsSqlDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
-func flattenMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, policy sql.DatabaseSecurityAlertPolicy) []interface{} {
+func flattenMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, policy legacySql.DatabaseSecurityAlertPolicy) []interface{} {
 	// The SQL database threat detection API always returns the default value even if never set.
 	// If the values are on their default one, threat it as not set.
 	properties := policy.DatabaseSecurityAlertPolicyProperties
@@ -815,11 +811,11 @@ func flattenMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, policy s
 	return []interface{}{threatDetectionPolicy}
 }
 
-func expandMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, location string) *sql.DatabaseSecurityAlertPolicy {
-	policy := sql.DatabaseSecurityAlertPolicy{
+func expandMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, location string) *legacySql.DatabaseSecurityAlertPolicy {
+	policy := legacySql.DatabaseSecurityAlertPolicy{
 		Location: utils.String(location),
-		DatabaseSecurityAlertPolicyProperties: &sql.DatabaseSecurityAlertPolicyProperties{
-			State: sql.SecurityAlertPolicyStateDisabled,
+		DatabaseSecurityAlertPolicyProperties: &legacySql.DatabaseSecurityAlertPolicyProperties{
+			State: legacySql.SecurityAlertPolicyStateDisabled,
 		},
 	}
 	properties := policy.DatabaseSecurityAlertPolicyProperties
@@ -832,9 +828,9 @@ func expandMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, location
 	if tdl := td.([]interface{}); len(tdl) > 0 {
 		threatDetection := tdl[0].(map[string]interface{})
 
-		properties.State = sql.SecurityAlertPolicyState(threatDetection["state"].(string))
-		properties.EmailAccountAdmins = sql.SecurityAlertPolicyEmailAccountAdmins(threatDetection["email_account_admins"].(string))
-		properties.UseServerDefault = sql.SecurityAlertPolicyUseServerDefault(threatDetection["use_server_default"].(string))
+		properties.State = legacySql.SecurityAlertPolicyState(threatDetection["state"].(string))
+		properties.EmailAccountAdmins = legacySql.SecurityAlertPolicyEmailAccountAdmins(threatDetection["email_account_admins"].(string))
+		properties.UseServerDefault = legacySql.SecurityAlertPolicyUseServerDefault(threatDetection["use_server_default"].(string))
 
 		if v, ok := threatDetection["disabled_alerts"]; ok {
 			alerts := v.(*pluginsdk.Set).List()
@@ -867,3 +863,113 @@ func expandMsSqlServerThreatDetectionPolicy(d *pluginsdk.ResourceData, location
 
 	return &policy
 }
+
+// findMsSqlDatabaseReplicationPrimary looks for a Primary Database within the specified Subscription which has a
+// Replication Link to the Secondary Database, returning nil when one isn't found
+func findMsSqlDatabaseReplicationPrimary(ctx context.Context, meta interface{}, subscriptionId string, secondaryId parse.DatabaseId, partnerServer, partnerDatabase string) (*parse.DatabaseId, error) {
+	// the clients are scoped to the Subscription being searched
+	resourcesClient := *meta.(*clients.Client).Resource.ResourcesClient
+	resourcesClient.SubscriptionID = subscriptionId
+	replicationLinksClient := *meta.(*clients.Client).MSSQL.ReplicationLinksClient
+	replicationLinksClient.SubscriptionID = subscriptionId
+
+	// get all SQL Servers with the name of the linked Primary
+	filter := fmt.Sprintf("(resourceType eq 'Microsoft.Sql/servers') and ((name eq '%s'))", partnerServer)
+	resourceList := make([]resources.GenericResourceExpanded, 0)
+	iterator, err := resourcesClient.ListComplete(ctx, filter, "", nil)
+	if err != nil {
+		return nil, fmt.Errorf("loading SQL Server List for Subscription %q: %+v", subscriptionId, err)
+	}
+	for iterator.NotDone() {
+		resourceList = append(resourceList, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("loading SQL Server List for Subscription %q: %+v", subscriptionId, err)
+		}
+	}
+
+	for _, server := range resourceList {
+		if server.ID == nil {
+			continue
+		}
+
+		serverID, err := parse.ServerID(*server.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		// check if server named like the replication linked server has a database named like the partner database with a replication link
+		linksPossiblePrimary, err := replicationLinksClient.ListByDatabase(ctx, serverID.ResourceGroup, serverID.Name, partnerDatabase)
+		if err != nil {
+			if utils.ResponseWasNotFound(linksPossiblePrimary.Response().Response) {
+				log.Printf("[INFO] no replication link found for Database %q (MsSql Server %q / Resource Group %q): %s", partnerDatabase, serverID.Name, serverID.ResourceGroup, err)
+				continue
+			}
+
+			return nil, fmt.Errorf("reading Replication Links for MsSql Database %s (MsSql Server Name %q / Resource Group %q): %s", partnerDatabase, serverID.Name, serverID.ResourceGroup, err)
+		}
+
+		for _, linkPossiblePrimary := range linksPossiblePrimary.Values() {
+			if linkPossiblePrimary.ReplicationLinkProperties == nil {
+				continue
+			}
+			linkPropsPossiblePrimary := *linkPossiblePrimary.ReplicationLinkProperties
+
+			// check if the database has a replication link for a primary role pointing back at this database's server
+			if linkPropsPossiblePrimary.Role == sql.ReplicationRolePrimary && linkPropsPossiblePrimary.PartnerServer != nil && strings.EqualFold(*linkPropsPossiblePrimary.PartnerServer, secondaryId.ServerName) {
+				primaryId := parse.NewDatabaseID(serverID.SubscriptionId, serverID.ResourceGroup, serverID.Name, partnerDatabase)
+				return &primaryId, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listMsSqlDatabaseOtherSubscriptionIds returns the IDs of the Subscriptions which can be accessed, other than the one specified
+func listMsSqlDatabaseOtherSubscriptionIds(ctx context.Context, meta interface{}, subscriptionId string) ([]string, error) {
+	client := meta.(*clients.Client).Subscription.Client
+
+	subscriptionIds := make([]string, 0)
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Subscriptions: %+v", err)
+	}
+	for iterator.NotDone() {
+		if v := iterator.Value().SubscriptionID; v != nil && !strings.EqualFold(*v, subscriptionId) {
+			subscriptionIds = append(subscriptionIds, *v)
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Subscriptions: %+v", err)
+		}
+	}
+
+	return subscriptionIds, nil
+}
+
+// the API has renamed `storageAccountType` to `requestedBackupStorageRedundancy` (with the values `Geo`, `Local` and `Zone`),
+// so these map between the two to keep the `GRS`, `LRS` and `ZRS` values used by `storage_account_type` unchanged
+func expandMsSqlDatabaseBackupStorageRedundancy(input string) sql.RequestedBackupStorageRedundancy {
+	switch input {
+	case string(sql.StorageAccountTypeGRS):
+		return sql.RequestedBackupStorageRedundancyGeo
+	case string(sql.StorageAccountTypeLRS):
+		return sql.RequestedBackupStorageRedundancyLocal
+	case string(sql.StorageAccountTypeZRS):
+		return sql.RequestedBackupStorageRedundancyZone
+	}
+
+	return sql.RequestedBackupStorageRedundancy(input)
+}
+
+func flattenMsSqlDatabaseBackupStorageRedundancy(input sql.RequestedBackupStorageRedundancy) string {
+	switch input {
+	case sql.RequestedBackupStorageRedundancyGeo:
+		return string(sql.StorageAccountTypeGRS)
+	case sql.RequestedBackupStorageRedundancyLocal:
+		return string(sql.StorageAccountTypeLRS)
+	case sql.RequestedBackupStorageRedundancyZone:
+		return string(sql.StorageAccountTypeZRS)
+	}
+
+	return string(input)
+}
@@ -3,6 +3,7 @@ package mssql_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -270,6 +271,26 @@ func TestAccMsSqlDatabase_createSecondaryMode(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_createSecondaryModeCrossSubscription(t *testing.T) {
+	if os.Getenv("ARM_SUBSCRIPTION_ID_ALT") == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "secondary")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.createSecondaryModeCrossSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("GP_Gen5_2"),
+			),
+		},
+		data.ImportStep("creation_source_database_id", "sample_name"),
+	})
+}
+
 func TestAccMsSqlDatabase_scaleReplicaSetWithFailovergroup(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "secondary")
 	r := MsSqlDatabaseResource{}
@@ -577,6 +598,22 @@ func TestAccMsSqlDatabase_withShortTermRetentionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_ledgerEnabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ledgerEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ledger_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlDatabase_geoBackupPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
 	r := MsSqlDatabaseResource{}
@@ -917,6 +954,69 @@ resource "azurerm_mssql_database" "secondary" {
 `, r.complete(data), data.RandomInteger, data.Locations.Secondary)
 }
 
+func (MsSqlDatabaseResource) createSecondaryModeCrossSubscription(data acceptance.TestData) string {
+	clientData := data.Client()
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm-alt" {
+  subscription_id = "%[1]s"
+  tenant_id       = "%[2]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "alt" {
+  provider = azurerm-alt
+
+  name     = "acctestRG-mssql-alt-%[3]d"
+  location = "%[4]s"
+}
+
+resource "azurerm_mssql_server" "alt" {
+  provider = azurerm-alt
+
+  name                         = "acctest-sqlserver-alt-%[3]d"
+  resource_group_name          = azurerm_resource_group.alt.name
+  location                     = azurerm_resource_group.alt.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "test" {
+  provider = azurerm-alt
+
+  name      = "acctest-db-%[3]d"
+  server_id = azurerm_mssql_server.alt.id
+  sku_name  = "GP_Gen5_2"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[3]d"
+  location = "%[5]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[3]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_database" "secondary" {
+  name                        = "acctest-dbs-%[3]d"
+  server_id                   = azurerm_mssql_server.test.id
+  create_mode                 = "Secondary"
+  creation_source_database_id = azurerm_mssql_database.test.id
+  sku_name                    = "GP_Gen5_2"
+}
+`, clientData.SubscriptionIDAlt, clientData.TenantID, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
+
 func (r MsSqlDatabaseResource) scaleReplicaSetWithFailovergroup(data acceptance.TestData, sku string, size int) string {
 	return fmt.Sprintf(`
 	%[1]s
@@ -1103,7 +1203,6 @@ resource "azurerm_mssql_database" "test" {
     email_account_admins       = "Enabled"
     storage_account_access_key = azurerm_storage_account.test.primary_access_key
     storage_endpoint           = azurerm_storage_account.test.primary_blob_endpoint
-    use_server_default         = "Disabled"
   }
 
   tags = {
@@ -1471,6 +1570,18 @@ resource "azurerm_mssql_database" "test" {
 `, r.template(data), data.RandomIntOfLength(15), data.RandomInteger)
 }
 
+func (r MsSqlDatabaseResource) ledgerEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name           = "acctest-db-%[2]d"
+  server_id      = azurerm_sql_server.test.id
+  ledger_enabled = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MsSqlDatabaseResource) withGeoBackupPoliciesEnabled(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
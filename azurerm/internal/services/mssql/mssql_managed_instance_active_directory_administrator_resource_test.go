@@ -0,0 +1,85 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlManagedInstanceActiveDirectoryAdministratorResource struct{}
+
+func TestAccMsSqlManagedInstanceActiveDirectoryAdministrator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_active_directory_administrator", "test")
+	r := MsSqlManagedInstanceActiveDirectoryAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "sqladmin"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		data.RequiresImportErrorStep(r.requiresImport),
+		{
+			Config: r.basic(data, "sqladmin2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("login_username").HasValue("sqladmin2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlManagedInstanceActiveDirectoryAdministratorResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceAzureActiveDirectoryAdministratorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstanceAdministratorsClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlManagedInstanceActiveDirectoryAdministratorResource) basic(data acceptance.TestData, login string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_mssql_managed_instance_active_directory_administrator" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+  login_username      = "%s"
+  object_id           = data.azurerm_client_config.current.object_id
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+}
+`, MsSqlManagedInstanceResource{}.basic(data), login)
+}
+
+func (r MsSqlManagedInstanceActiveDirectoryAdministratorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_active_directory_administrator" "import" {
+  managed_instance_id = azurerm_mssql_managed_instance_active_directory_administrator.test.managed_instance_id
+  login_username      = azurerm_mssql_managed_instance_active_directory_administrator.test.login_username
+  object_id           = azurerm_mssql_managed_instance_active_directory_administrator.test.object_id
+  tenant_id           = azurerm_mssql_managed_instance_active_directory_administrator.test.tenant_id
+}
+`, r.basic(data, "sqladmin"))
+}
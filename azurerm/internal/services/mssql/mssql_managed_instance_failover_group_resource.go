@@ -0,0 +1,352 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlManagedInstanceFailoverGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Read:   resourceMsSqlManagedInstanceFailoverGroupRead,
+		Update: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Delete: resourceMsSqlManagedInstanceFailoverGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.InstanceFailoverGroupID(id)
+			return err
+		}),
+
+		// seeding the databases into the partner Managed Instance can take several hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(24 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"partner_managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.ReadWriteEndpointFailoverPolicyAutomatic),
+								string(sql.ReadWriteEndpointFailoverPolicyManual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"partner_region": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			// the grace period is required when the failover is Automatic, but the mode can't be conditionally validated in the schema
+			mode := diff.Get("read_write_endpoint_failover_policy.0.mode").(string)
+			if mode != string(sql.ReadWriteEndpointFailoverPolicyAutomatic) || !diff.NewValueKnown("read_write_endpoint_failover_policy.0.grace_minutes") {
+				return nil
+			}
+
+			if diff.Get("read_write_endpoint_failover_policy.0.grace_minutes").(int) == 0 {
+				return fmt.Errorf("`grace_minutes` must be specified when the `mode` of the `read_write_endpoint_failover_policy` is `Automatic`")
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	instancesClient := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	instanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	partnerInstanceId, err := parse.ManagedInstanceID(d.Get("partner_managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewInstanceFailoverGroupID(instanceId.SubscriptionId, instanceId.ResourceGroup, location.Normalize(d.Get("location").(string)), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_managed_instance_failover_group", id.ID())
+		}
+	}
+
+	partnerInstance, err := instancesClient.Get(ctx, partnerInstanceId.ResourceGroup, partnerInstanceId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving partner %s: %+v", *partnerInstanceId, err)
+	}
+	if partnerInstance.Location == nil {
+		return fmt.Errorf("retrieving partner %s: `location` was nil", *partnerInstanceId)
+	}
+
+	readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if d.Get("readonly_endpoint_failover_policy_enabled").(bool) {
+		readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	parameters := sql.InstanceFailoverGroup{
+		InstanceFailoverGroupProperties: &sql.InstanceFailoverGroupProperties{
+			ReadOnlyEndpoint: &sql.InstanceFailoverGroupReadOnlyEndpoint{
+				FailoverPolicy: readOnlyFailoverPolicy,
+			},
+			ReadWriteEndpoint: expandMsSqlManagedInstanceFailoverGroupReadWritePolicy(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			PartnerRegions: &[]sql.PartnerRegionInfo{
+				{
+					Location: partnerInstance.Location,
+				},
+			},
+			ManagedInstancePairs: &[]sql.ManagedInstancePairInfo{
+				{
+					PrimaryManagedInstanceID: utils.String(instanceId.ID()),
+					PartnerManagedInstanceID: utils.String(partnerInstanceId.ID()),
+				},
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlManagedInstanceFailoverGroupRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.InstanceFailoverGroupProperties; props != nil {
+		d.Set("role", string(props.ReplicationRole))
+
+		if err := d.Set("read_write_endpoint_failover_policy", flattenMsSqlManagedInstanceFailoverGroupReadWritePolicy(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		readOnlyFailoverPolicyEnabled := false
+		if props.ReadOnlyEndpoint != nil {
+			readOnlyFailoverPolicyEnabled = props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+		}
+		d.Set("readonly_endpoint_failover_policy_enabled", readOnlyFailoverPolicyEnabled)
+
+		if props.ManagedInstancePairs != nil && len(*props.ManagedInstancePairs) > 0 {
+			pair := (*props.ManagedInstancePairs)[0]
+
+			// the API may return these with a different casing, so parse them to normalize them
+			if pair.PrimaryManagedInstanceID != nil {
+				instanceId, err := parse.ManagedInstanceID(*pair.PrimaryManagedInstanceID)
+				if err != nil {
+					return fmt.Errorf("parsing `primaryManagedInstanceId`: %+v", err)
+				}
+				d.Set("managed_instance_id", instanceId.ID())
+			}
+
+			if pair.PartnerManagedInstanceID != nil {
+				partnerInstanceId, err := parse.ManagedInstanceID(*pair.PartnerManagedInstanceID)
+				if err != nil {
+					return fmt.Errorf("parsing `partnerManagedInstanceId`: %+v", err)
+				}
+				d.Set("partner_managed_instance_id", partnerInstanceId.ID())
+			}
+		}
+
+		if err := d.Set("partner_region", flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(props.PartnerRegions)); err != nil {
+			return fmt.Errorf("setting `partner_region`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlManagedInstanceFailoverGroupReadWritePolicy(input []interface{}) *sql.InstanceFailoverGroupReadWriteEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	mode := sql.ReadWriteEndpointFailoverPolicy(v["mode"].(string))
+	policy := &sql.InstanceFailoverGroupReadWriteEndpoint{
+		FailoverPolicy: mode,
+	}
+
+	if mode != sql.ReadWriteEndpointFailoverPolicyManual {
+		policy.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(v["grace_minutes"].(int)))
+	}
+
+	return policy
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupReadWritePolicy(input *sql.InstanceFailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(input *[]sql.PartnerRegionInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"location": location.NormalizeNilable(item.Location),
+			"role":     string(item.ReplicationRole),
+		})
+	}
+
+	return results
+}
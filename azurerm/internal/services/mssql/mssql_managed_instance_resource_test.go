@@ -0,0 +1,332 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlManagedInstanceResource struct{}
+
+func TestAccMsSqlManagedInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+				check.That(data.ResourceName).Key("dns_zone").Exists(),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccMsSqlManagedInstance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance", "test")
+	r := MsSqlManagedInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func (MsSqlManagedInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.ManagedInstancesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlManagedInstanceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance" "test" {
+  name                = "acctestsqlmi%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  license_type       = "BasePrice"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 32
+  subnet_id          = azurerm_subnet.test.id
+  vcores             = 4
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+}
+`, r.template(data, data.Locations.Primary), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance" "test" {
+  name                = "acctestsqlmi%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  license_type       = "LicenseIncluded"
+  sku_name           = "GP_Gen5"
+  storage_size_in_gb = 64
+  subnet_id          = azurerm_subnet.test.id
+  vcores             = 8
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  minimum_tls_version          = "1.1"
+  proxy_override               = "Redirect"
+  public_data_endpoint_enabled = true
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+
+  tags = {
+    environment = "staging"
+    database    = "test"
+  }
+}
+`, r.template(data, data.Locations.Primary), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance" "import" {
+  name                = azurerm_mssql_managed_instance.test.name
+  resource_group_name = azurerm_mssql_managed_instance.test.resource_group_name
+  location            = azurerm_mssql_managed_instance.test.location
+
+  license_type       = azurerm_mssql_managed_instance.test.license_type
+  sku_name           = azurerm_mssql_managed_instance.test.sku_name
+  storage_size_in_gb = azurerm_mssql_managed_instance.test.storage_size_in_gb
+  subnet_id          = azurerm_mssql_managed_instance.test.subnet_id
+  vcores             = azurerm_mssql_managed_instance.test.vcores
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+}
+`, r.basic(data))
+}
+
+func (r MsSqlManagedInstanceResource) template(data acceptance.TestData, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sql-%[1]d"
+  location = "%[2]s"
+}
+
+%[3]s
+`, data.RandomInteger, location, r.templateNetwork(data, "test", "azurerm_resource_group.test", "10.0"))
+}
+
+// templateNetwork provisions the Virtual Network, Subnet, Network Security Group and Route Table which a Managed Instance requires,
+// see https://docs.microsoft.com/azure/azure-sql/managed-instance/connectivity-architecture-overview#network-requirements
+func (MsSqlManagedInstanceResource) templateNetwork(data acceptance.TestData, name, resourceGroup, addressPrefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_virtual_network" "%[2]s" {
+  name                = "acctest-vnet-%[2]s-%[1]d"
+  resource_group_name = %[3]s.name
+  location            = %[3]s.location
+  address_space       = ["%[4]s.0.0/16"]
+}
+
+resource "azurerm_subnet" "%[2]s" {
+  name                 = "subnet-%[2]s-%[1]d"
+  resource_group_name  = %[3]s.name
+  virtual_network_name = azurerm_virtual_network.%[2]s.name
+  address_prefixes     = ["%[4]s.0.0/24"]
+
+  delegation {
+    name = "managedinstancedelegation"
+
+    service_delegation {
+      name    = "Microsoft.Sql/managedInstances"
+      actions = ["Microsoft.Network/virtualNetworks/subnets/join/action", "Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action", "Microsoft.Network/virtualNetworks/subnets/unprepareNetworkPolicies/action"]
+    }
+  }
+}
+
+resource "azurerm_network_security_group" "%[2]s" {
+  name                = "acctest-nsg-%[2]s-%[1]d"
+  resource_group_name = %[3]s.name
+  location            = %[3]s.location
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_management_inbound" {
+  name                        = "allow_management_inbound"
+  priority                    = 106
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_ranges     = ["9000", "9003", "1438", "1440", "1452"]
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_misubnet_inbound" {
+  name                        = "allow_misubnet_inbound"
+  priority                    = 200
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "*"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "%[4]s.0.0/24"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_health_probe_inbound" {
+  name                        = "allow_health_probe_inbound"
+  priority                    = 300
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "*"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "AzureLoadBalancer"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_tds_inbound" {
+  name                        = "allow_tds_inbound"
+  priority                    = 1000
+  direction                   = "Inbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_range      = "1433"
+  source_address_prefix       = "VirtualNetwork"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_management_outbound" {
+  name                        = "allow_management_outbound"
+  priority                    = 102
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "Tcp"
+  source_port_range           = "*"
+  destination_port_ranges     = ["80", "443", "12000"]
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_network_security_rule" "%[2]s_allow_misubnet_outbound" {
+  name                        = "allow_misubnet_outbound"
+  priority                    = 200
+  direction                   = "Outbound"
+  access                      = "Allow"
+  protocol                    = "*"
+  source_port_range           = "*"
+  destination_port_range      = "*"
+  source_address_prefix       = "%[4]s.0.0/24"
+  destination_address_prefix  = "*"
+  resource_group_name         = %[3]s.name
+  network_security_group_name = azurerm_network_security_group.%[2]s.name
+}
+
+resource "azurerm_subnet_network_security_group_association" "%[2]s" {
+  subnet_id                 = azurerm_subnet.%[2]s.id
+  network_security_group_id = azurerm_network_security_group.%[2]s.id
+}
+
+resource "azurerm_route_table" "%[2]s" {
+  name                          = "acctest-rt-%[2]s-%[1]d"
+  resource_group_name           = %[3]s.name
+  location                      = %[3]s.location
+  disable_bgp_route_propagation = false
+}
+
+resource "azurerm_subnet_route_table_association" "%[2]s" {
+  subnet_id      = azurerm_subnet.%[2]s.id
+  route_table_id = azurerm_route_table.%[2]s.id
+}
+`, data.RandomInteger, name, resourceGroup, addressPrefix)
+}
@@ -6,7 +6,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/gofrs/uuid"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -156,6 +156,12 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"outbound_network_restriction_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"extended_auditing_policy": helper.ExtendedAuditingSchema(),
 
 			"fully_qualified_domain_name": {
@@ -196,7 +202,7 @@ func resourceMsSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	metadata := tags.Expand(t)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
@@ -212,9 +218,10 @@ func resourceMsSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Location: utils.String(location),
 		Tags:     metadata,
 		ServerProperties: &sql.ServerProperties{
-			Version:             utils.String(version),
-			AdministratorLogin:  utils.String(adminUsername),
-			PublicNetworkAccess: sql.ServerPublicNetworkAccessEnabled,
+			Version:                       utils.String(version),
+			AdministratorLogin:            utils.String(adminUsername),
+			PublicNetworkAccess:           sql.ServerNetworkAccessFlagEnabled,
+			RestrictOutboundNetworkAccess: sql.ServerNetworkAccessFlagDisabled,
 		},
 	}
 
@@ -224,7 +231,11 @@ func resourceMsSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	if v := d.Get("public_network_access_enabled"); !v.(bool) {
-		props.ServerProperties.PublicNetworkAccess = sql.ServerPublicNetworkAccessDisabled
+		props.ServerProperties.PublicNetworkAccess = sql.ServerNetworkAccessFlagDisabled
+	}
+
+	if v := d.Get("outbound_network_restriction_enabled"); v.(bool) {
+		props.ServerProperties.RestrictOutboundNetworkAccess = sql.ServerNetworkAccessFlagEnabled
 	}
 
 	if d.HasChange("administrator_login_password") {
@@ -249,7 +260,7 @@ func resourceMsSqlServerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting on create/update future for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
 		return fmt.Errorf("issuing get request for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -320,7 +331,7 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["servers"]
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, err := client.Get(ctx, resGroup, name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Error reading SQL Server %q - removing from state", d.Id())
@@ -346,7 +357,8 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("administrator_login", props.AdministratorLogin)
 		d.Set("fully_qualified_domain_name", props.FullyQualifiedDomainName)
 		d.Set("minimum_tls_version", props.MinimalTLSVersion)
-		d.Set("public_network_access_enabled", props.PublicNetworkAccess == sql.ServerPublicNetworkAccessEnabled)
+		d.Set("public_network_access_enabled", props.PublicNetworkAccess == sql.ServerNetworkAccessFlagEnabled)
+		d.Set("outbound_network_restriction_enabled", props.RestrictOutboundNetworkAccess == sql.ServerNetworkAccessFlagEnabled)
 	}
 
 	adminResp, err := adminClient.Get(ctx, resGroup, name)
@@ -378,11 +390,11 @@ func resourceMsSqlServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("setting `extended_auditing_policy`: %+v", err)
 	}
 
-	restorableResp, err := restorableDroppedDatabasesClient.ListByServer(ctx, resGroup, name)
+	restorableDroppedDatabases, err := listSqlServerRestorableDroppedDatabases(ctx, restorableDroppedDatabasesClient, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("listing SQL Server %s Restorable Dropped Databases: %v", name, err)
 	}
-	if err := d.Set("restorable_dropped_database_ids", flattenSqlServerRestorableDatabases(restorableResp)); err != nil {
+	if err := d.Set("restorable_dropped_database_ids", flattenSqlServerRestorableDatabases(restorableDroppedDatabases)); err != nil {
 		return fmt.Errorf("setting `restorable_dropped_database_ids`: %+v", err)
 	}
 
@@ -485,12 +497,26 @@ func flatternMsSqlServerAdministrator(admin sql.ServerAzureADAdministrator) []in
 	}
 }
 
-func flattenSqlServerRestorableDatabases(resp sql.RestorableDroppedDatabaseListResult) []string {
-	if resp.Value == nil || len(*resp.Value) == 0 {
-		return []string{}
+func listSqlServerRestorableDroppedDatabases(ctx context.Context, client *sql.RestorableDroppedDatabasesClient, resourceGroup, serverName string) ([]sql.RestorableDroppedDatabase, error) {
+	results := make([]sql.RestorableDroppedDatabase, 0)
+
+	iterator, err := client.ListByServerComplete(ctx, resourceGroup, serverName)
+	if err != nil {
+		return nil, err
+	}
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
 	}
+
+	return results, nil
+}
+
+func flattenSqlServerRestorableDatabases(input []sql.RestorableDroppedDatabase) []string {
 	res := make([]string, 0)
-	for _, r := range *resp.Value {
+	for _, r := range input {
 		var id string
 		if r.ID != nil {
 			id = *r.ID
@@ -200,7 +200,7 @@ func (MsSqlServerResource) Exists(ctx context.Context, client *clients.Client, s
 		return nil, err
 	}
 
-	resp, err := client.MSSQL.ServersClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.MSSQL.ServersClient.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return nil, fmt.Errorf("SQL Server %q (Resource Group %q) does not exist", id.Name, id.ResourceGroup)
@@ -415,7 +415,8 @@ resource "azurerm_mssql_server" "test" {
   administrator_login_password = "thisIsKat11"
   minimum_tls_version          = "1.0"
 
-  public_network_access_enabled = false
+  public_network_access_enabled        = false
+  outbound_network_restriction_enabled = true
 
   extended_auditing_policy {
     storage_account_access_key              = azurerm_storage_account.testb.primary_access_key
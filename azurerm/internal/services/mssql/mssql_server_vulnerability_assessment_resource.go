@@ -5,7 +5,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
@@ -112,7 +112,7 @@ func resourceMsSqlServerVulnerabilityAssessmentCreateUpdate(d *pluginsdk.Resourc
 	if err != nil {
 		return fmt.Errorf("Error retrieving Security Alert Policy: %+v", err)
 	}
-	if policy.State != sql.SecurityAlertPolicyStateEnabled {
+	if policy.State != sql.SecurityAlertsPolicyStateEnabled {
 		return fmt.Errorf("Security Alert Policy is not enabled")
 	}
 
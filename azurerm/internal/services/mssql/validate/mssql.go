@@ -51,3 +51,12 @@ func ValidateMsSqlJobAgentName(i interface{}, k string) (_ []string, errors []er
 
 	return nil, nil
 }
+
+// Managed Instance name can contain only lowercase letters, numbers, and '-', but can't start or end with '-' or have more than 63 characters.
+func ValidateMsSqlManagedInstanceName(i interface{}, k string) (_ []string, errors []error) {
+	if m, regexErrs := validate.RegExHelper(i, k, `^[0-9a-z]([-0-9a-z]{0,61}[0-9a-z])?$`); !m {
+		return nil, append(regexErrs, fmt.Errorf("%q can contain only lowercase letters, numbers, and '-', but can't start or end with '-' or have more than 63 characters.", k))
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,248 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApplicationGatewayBackendAddressPoolAddress() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayBackendAddressPoolAddressCreate,
+		Read:   resourceApplicationGatewayBackendAddressPoolAddressRead,
+		Delete: resourceApplicationGatewayBackendAddressPoolAddressDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayBackendAddressPoolAddressID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"backend_address_pool_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayBackendAddressPoolID,
+			},
+
+			"ip_address": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+				ExactlyOneOf: []string{"ip_address", "fqdn"},
+			},
+
+			"fqdn": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"ip_address", "fqdn"},
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayBackendAddressPoolAddressCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	poolId, err := parse.ApplicationGatewayBackendAddressPoolID(d.Get("backend_address_pool_id").(string))
+	if err != nil {
+		return err
+	}
+
+	address := network.ApplicationGatewayBackendAddress{}
+	addressValue := ""
+	if v := d.Get("ip_address").(string); v != "" {
+		address.IPAddress = utils.String(v)
+		addressValue = v
+	}
+	if v := d.Get("fqdn").(string); v != "" {
+		address.Fqdn = utils.String(v)
+		addressValue = v
+	}
+
+	id := parse.NewApplicationGatewayBackendAddressPoolAddressID(*poolId, addressValue)
+	gatewayId := parse.NewApplicationGatewayID(poolId.SubscriptionId, poolId.ResourceGroup, poolId.ApplicationGatewayName)
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	pool := findApplicationGatewayBackendAddressPool(gateway, poolId.BackendAddressPoolName)
+	if pool == nil {
+		return fmt.Errorf("%s was not found", *poolId)
+	}
+
+	addresses := make([]network.ApplicationGatewayBackendAddress, 0)
+	if pool.BackendAddresses != nil {
+		for _, existing := range *pool.BackendAddresses {
+			if applicationGatewayBackendAddressValue(existing) == addressValue {
+				return tf.ImportAsExistsError("azurerm_application_gateway_backend_address_pool_address", id.ID())
+			}
+
+			addresses = append(addresses, existing)
+		}
+	}
+	addresses = append(addresses, address)
+	pool.BackendAddresses = &addresses
+
+	future, err := client.CreateOrUpdate(ctx, gatewayId.ResourceGroup, gatewayId.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("adding %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be added: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayBackendAddressPoolAddressRead(d, meta)
+}
+
+func resourceApplicationGatewayBackendAddressPoolAddressRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayBackendAddressPoolAddressID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.BackendAddressPool.SubscriptionId, id.BackendAddressPool.ResourceGroup, id.BackendAddressPool.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	found := false
+	if pool := findApplicationGatewayBackendAddressPool(gateway, id.BackendAddressPool.BackendAddressPoolName); pool != nil && pool.BackendAddresses != nil {
+		for _, address := range *pool.BackendAddresses {
+			if applicationGatewayBackendAddressValue(address) == id.Address {
+				found = true
+				break
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend_address_pool_id", id.BackendAddressPool.ID())
+
+	// the Address is either an IP Address or an FQDN
+	if net.ParseIP(id.Address) != nil {
+		d.Set("ip_address", id.Address)
+		d.Set("fqdn", "")
+	} else {
+		d.Set("ip_address", "")
+		d.Set("fqdn", id.Address)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayBackendAddressPoolAddressDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayBackendAddressPoolAddressID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.BackendAddressPool.SubscriptionId, id.BackendAddressPool.ResourceGroup, id.BackendAddressPool.ApplicationGatewayName)
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	pool := findApplicationGatewayBackendAddressPool(gateway, id.BackendAddressPool.BackendAddressPoolName)
+	if pool == nil || pool.BackendAddresses == nil {
+		return nil
+	}
+
+	addresses := make([]network.ApplicationGatewayBackendAddress, 0)
+	for _, address := range *pool.BackendAddresses {
+		if applicationGatewayBackendAddressValue(address) != id.Address {
+			addresses = append(addresses, address)
+		}
+	}
+	pool.BackendAddresses = &addresses
+
+	future, err := client.CreateOrUpdate(ctx, gatewayId.ResourceGroup, gatewayId.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("removing %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to be removed: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// findApplicationGatewayBackendAddressPool returns the properties of the named Backend Address Pool, which can be
+// modified in place prior to updating the Application Gateway
+func findApplicationGatewayBackendAddressPool(gateway network.ApplicationGateway, name string) *network.ApplicationGatewayBackendAddressPoolPropertiesFormat {
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.BackendAddressPools == nil {
+		return nil
+	}
+
+	pools := *gateway.BackendAddressPools
+	for i := range pools {
+		if pools[i].Name != nil && *pools[i].Name == name {
+			if pools[i].ApplicationGatewayBackendAddressPoolPropertiesFormat == nil {
+				pools[i].ApplicationGatewayBackendAddressPoolPropertiesFormat = &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{}
+			}
+			return pools[i].ApplicationGatewayBackendAddressPoolPropertiesFormat
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ApplicationGatewayBackendAddressPoolAddressResource struct {
+}
+
+func TestAccApplicationGatewayBackendAddressPoolAddress_ipAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool_address", "test")
+	r := ApplicationGatewayBackendAddressPoolAddressResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipAddress(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the Application Gateway shouldn't pick up the address managed by this resource
+				check.That("azurerm_application_gateway.test").Key("backend_address_pool.0.ip_addresses.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPoolAddress_fqdn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool_address", "test")
+	r := ApplicationGatewayBackendAddressPoolAddressResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fqdn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPoolAddress_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool_address", "test")
+	r := ApplicationGatewayBackendAddressPoolAddressResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipAddress(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway mustn't remove the address managed by this resource
+			Config: r.applicationGatewayWithTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("tags.%").HasValue("1"),
+				check.That("azurerm_application_gateway.test").Key("backend_address_pool.0.ip_addresses.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayBackendAddressPoolAddress_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_backend_address_pool_address", "test")
+	r := ApplicationGatewayBackendAddressPoolAddressResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipAddress(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t ApplicationGatewayBackendAddressPoolAddressResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayBackendAddressPoolAddressID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.BackendAddressPool.ResourceGroup, id.BackendAddressPool.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.BackendAddressPools != nil {
+		for _, pool := range *props.BackendAddressPools {
+			if pool.Name == nil || *pool.Name != id.BackendAddressPool.BackendAddressPoolName {
+				continue
+			}
+
+			if pool.ApplicationGatewayBackendAddressPoolPropertiesFormat == nil || pool.BackendAddresses == nil {
+				continue
+			}
+
+			for _, address := range *pool.BackendAddresses {
+				if (address.IPAddress != nil && *address.IPAddress == id.Address) || (address.Fqdn != nil && *address.Fqdn == id.Address) {
+					return utils.Bool(true), nil
+				}
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayBackendAddressPoolAddressResource) ipAddress(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool_address" "test" {
+  backend_address_pool_id = azurerm_application_gateway.test.backend_address_pool.0.id
+  ip_address              = "10.0.1.10"
+}
+`, ApplicationGatewayResource{}.basic(data))
+}
+
+func (ApplicationGatewayBackendAddressPoolAddressResource) fqdn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool_address" "test" {
+  backend_address_pool_id = azurerm_application_gateway.test.backend_address_pool.0.id
+  fqdn                    = "backend-%d.example.com"
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger)
+}
+
+func (ApplicationGatewayBackendAddressPoolAddressResource) applicationGatewayWithTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool_address" "test" {
+  backend_address_pool_id = azurerm_application_gateway.test.backend_address_pool.0.id
+  ip_address              = "10.0.1.10"
+}
+`, ApplicationGatewayResource{}.basicWithTags(data))
+}
+
+func (r ApplicationGatewayBackendAddressPoolAddressResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_backend_address_pool_address" "import" {
+  backend_address_pool_id = azurerm_application_gateway_backend_address_pool_address.test.backend_address_pool_id
+  ip_address              = azurerm_application_gateway_backend_address_pool_address.test.ip_address
+}
+`, r.ipAddress(data))
+}
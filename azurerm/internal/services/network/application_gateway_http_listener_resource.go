@@ -0,0 +1,479 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApplicationGatewayHTTPListener() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Read:   resourceApplicationGatewayHTTPListenerRead,
+		Update: resourceApplicationGatewayHTTPListenerCreateUpdate,
+		Delete: resourceApplicationGatewayHTTPListenerDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayHTTPListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_gateway_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayID,
+			},
+
+			"frontend_ip_configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"frontend_port_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protocol": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolHTTP),
+					string(network.ProtocolHTTPS),
+				}, true),
+			},
+
+			"request_routing_rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"rule_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ApplicationGatewayRequestRoutingRuleTypeBasic),
+								string(network.ApplicationGatewayRequestRoutingRuleTypePathBasedRouting),
+							}, false),
+						},
+
+						"backend_address_pool_name": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"backend_http_settings_name": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"url_path_map_name": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"redirect_configuration_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"rewrite_rule_set_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"host_name": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"host_names"},
+			},
+
+			"host_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ConflictsWith: []string{"host_name"},
+			},
+
+			"ssl_certificate_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"ssl_profile_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"require_sni": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"custom_error_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status_code": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus403),
+								string(network.ApplicationGatewayCustomErrorStatusCodeHTTPStatus502),
+							}, false),
+						},
+
+						"custom_error_page_url": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationGatewayHTTPListenerCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	gatewayId, err := parse.ApplicationGatewayID(d.Get("application_gateway_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewApplicationGatewayHTTPListenerID(gatewayId.SubscriptionId, gatewayId.ResourceGroup, gatewayId.Name, d.Get("name").(string))
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *gatewayId, err)
+	}
+	if gateway.ApplicationGatewayPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *gatewayId)
+	}
+	props := gateway.ApplicationGatewayPropertiesFormat
+
+	if d.IsNewResource() && findApplicationGatewayHTTPListener(props.HTTPListeners, id.HttpListenerName) != nil {
+		return tf.ImportAsExistsError("azurerm_application_gateway_http_listener", id.ID())
+	}
+
+	listener, err := expandApplicationGatewayHTTPListener(map[string]interface{}{
+		"name":                           id.HttpListenerName,
+		"frontend_ip_configuration_name": d.Get("frontend_ip_configuration_name").(string),
+		"frontend_port_name":             d.Get("frontend_port_name").(string),
+		"protocol":                       d.Get("protocol").(string),
+		"require_sni":                    d.Get("require_sni").(bool),
+		"firewall_policy_id":             d.Get("firewall_policy_id").(string),
+		"custom_error_configuration":     d.Get("custom_error_configuration").([]interface{}),
+		"host_name":                      d.Get("host_name").(string),
+		"host_names":                     d.Get("host_names").(*pluginsdk.Set),
+		"ssl_certificate_name":           d.Get("ssl_certificate_name").(string),
+		"ssl_profile_name":               d.Get("ssl_profile_name").(string),
+	}, gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	ruleRaw := d.Get("request_routing_rule").([]interface{})[0].(map[string]interface{})
+	ruleRaw["http_listener_name"] = id.HttpListenerName
+	rule, err := expandApplicationGatewayRequestRoutingRule(ruleRaw, gatewayId.ID())
+	if err != nil {
+		return fmt.Errorf("expanding `request_routing_rule`: %+v", err)
+	}
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	if props.HTTPListeners != nil {
+		for _, v := range *props.HTTPListeners {
+			if v.Name != nil && *v.Name == id.HttpListenerName {
+				continue
+			}
+			listeners = append(listeners, v)
+		}
+	}
+	listeners = append(listeners, *listener)
+	props.HTTPListeners = &listeners
+
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if applicationGatewayRequestRoutingRuleTargetsListener(v, id.HttpListenerName) {
+				continue
+			}
+			if v.Name != nil && *v.Name == *rule.Name {
+				return fmt.Errorf("a Request Routing Rule named %q already exists within %s for a different HTTP Listener", *rule.Name, *gatewayId)
+			}
+			rules = append(rules, v)
+		}
+	}
+	rules = append(rules, *rule)
+	props.RequestRoutingRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, gatewayId.ResourceGroup, gatewayId.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceApplicationGatewayHTTPListenerRead(d, meta)
+}
+
+func resourceApplicationGatewayHTTPListenerRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", gatewayId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	var listener *network.ApplicationGatewayHTTPListener
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if props := gateway.ApplicationGatewayPropertiesFormat; props != nil {
+		listener = findApplicationGatewayHTTPListener(props.HTTPListeners, id.HttpListenerName)
+		if props.RequestRoutingRules != nil {
+			for _, v := range *props.RequestRoutingRules {
+				if applicationGatewayRequestRoutingRuleTargetsListener(v, id.HttpListenerName) {
+					rules = append(rules, v)
+					break
+				}
+			}
+		}
+	}
+
+	if listener == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	flattenedListeners, err := flattenApplicationGatewayHTTPListeners(&[]network.ApplicationGatewayHTTPListener{*listener})
+	if err != nil {
+		return fmt.Errorf("flattening `%s`: %+v", id, err)
+	}
+	flattenedListener := flattenedListeners[0].(map[string]interface{})
+
+	d.Set("name", id.HttpListenerName)
+	d.Set("application_gateway_id", gatewayId.ID())
+	d.Set("frontend_ip_configuration_name", flattenedListener["frontend_ip_configuration_name"])
+	d.Set("frontend_port_name", flattenedListener["frontend_port_name"])
+	d.Set("protocol", flattenedListener["protocol"])
+	d.Set("host_name", flattenedListener["host_name"])
+	d.Set("ssl_certificate_name", flattenedListener["ssl_certificate_name"])
+	d.Set("ssl_profile_name", flattenedListener["ssl_profile_name"])
+	d.Set("require_sni", flattenedListener["require_sni"])
+	d.Set("firewall_policy_id", flattenedListener["firewall_policy_id"])
+
+	hostNames := make([]interface{}, 0)
+	if v, ok := flattenedListener["host_names"]; ok {
+		hostNames = v.([]interface{})
+	}
+	if err := d.Set("host_names", hostNames); err != nil {
+		return fmt.Errorf("setting `host_names`: %+v", err)
+	}
+
+	if err := d.Set("custom_error_configuration", flattenedListener["custom_error_configuration"]); err != nil {
+		return fmt.Errorf("setting `custom_error_configuration`: %+v", err)
+	}
+
+	flattenedRules, err := flattenApplicationGatewayRequestRoutingRules(&rules)
+	if err != nil {
+		return fmt.Errorf("flattening `request_routing_rule`: %+v", err)
+	}
+	if err := d.Set("request_routing_rule", flattenApplicationGatewayHTTPListenerRequestRoutingRule(flattenedRules)); err != nil {
+		return fmt.Errorf("setting `request_routing_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceApplicationGatewayHTTPListenerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+
+	locks.ByID(gatewayId.ID())
+	defer locks.UnlockByID(gatewayId.ID())
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	props := gateway.ApplicationGatewayPropertiesFormat
+	if props == nil || findApplicationGatewayHTTPListener(props.HTTPListeners, id.HttpListenerName) == nil {
+		return nil
+	}
+
+	// the Request Routing Rule references the HTTP Listener, so both need to be removed together
+	rules := make([]network.ApplicationGatewayRequestRoutingRule, 0)
+	if props.RequestRoutingRules != nil {
+		for _, v := range *props.RequestRoutingRules {
+			if !applicationGatewayRequestRoutingRuleTargetsListener(v, id.HttpListenerName) {
+				rules = append(rules, v)
+			}
+		}
+	}
+	props.RequestRoutingRules = &rules
+
+	listeners := make([]network.ApplicationGatewayHTTPListener, 0)
+	for _, v := range *props.HTTPListeners {
+		if v.Name == nil || *v.Name != id.HttpListenerName {
+			listeners = append(listeners, v)
+		}
+	}
+	props.HTTPListeners = &listeners
+
+	future, err := client.CreateOrUpdate(ctx, gatewayId.ResourceGroup, gatewayId.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func findApplicationGatewayHTTPListener(input *[]network.ApplicationGatewayHTTPListener, name string) *network.ApplicationGatewayHTTPListener {
+	if input == nil {
+		return nil
+	}
+
+	for _, v := range *input {
+		if v.Name != nil && *v.Name == name {
+			listener := v
+			return &listener
+		}
+	}
+
+	return nil
+}
+
+func applicationGatewayRequestRoutingRuleTargetsListener(rule network.ApplicationGatewayRequestRoutingRule, listenerName string) bool {
+	props := rule.ApplicationGatewayRequestRoutingRulePropertiesFormat
+	if props == nil || props.HTTPListener == nil || props.HTTPListener.ID == nil {
+		return false
+	}
+
+	return strings.HasSuffix(strings.ToLower(*props.HTTPListener.ID), strings.ToLower("/httpListeners/"+listenerName))
+}
+
+// flattenApplicationGatewayHTTPListenerRequestRoutingRule trims the flattened Request Routing Rules down to the
+// fields exposed by this resource, since the HTTP Listener is implied
+func flattenApplicationGatewayHTTPListenerRequestRoutingRule(input []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		output := make(map[string]interface{})
+		for _, key := range []string{"name", "rule_type", "backend_address_pool_name", "backend_http_settings_name", "url_path_map_name", "redirect_configuration_name", "rewrite_rule_set_name", "id"} {
+			if value, ok := v[key]; ok {
+				output[key] = value
+			}
+		}
+		results = append(results, output)
+	}
+
+	return results
+}
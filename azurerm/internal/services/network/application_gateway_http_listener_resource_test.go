@@ -0,0 +1,220 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ApplicationGatewayHTTPListenerResource struct {
+}
+
+func TestAccApplicationGatewayHTTPListener_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("request_routing_rule.0.id").Exists(),
+				// the Application Gateway shouldn't pick up the listener or rule managed by this resource
+				check.That("azurerm_application_gateway.test").Key("http_listener.#").HasValue("1"),
+				check.That("azurerm_application_gateway.test").Key("request_routing_rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_updateApplicationGateway(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Application Gateway mustn't remove the listener or rule managed by this resource
+			Config: r.applicationGatewayWithTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_application_gateway.test").Key("tags.%").HasValue("1"),
+				check.That("azurerm_application_gateway.test").Key("http_listener.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApplicationGatewayHTTPListener_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway_http_listener", "test")
+	r := ApplicationGatewayHTTPListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t ApplicationGatewayHTTPListenerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayHTTPListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.HTTPListeners != nil {
+		for _, listener := range *props.HTTPListeners {
+			if listener.Name != nil && *listener.Name == id.HttpListenerName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ApplicationGatewayHTTPListenerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "acctest-%d.example.com"
+
+  request_routing_rule {
+    name                       = "acctest-rule-%d"
+    rule_type                  = "Basic"
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (ApplicationGatewayHTTPListenerResource) applicationGatewayWithTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_name                      = "acctest-%d.example.com"
+
+  request_routing_rule {
+    name                       = "acctest-rule-%d"
+    rule_type                  = "Basic"
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, ApplicationGatewayResource{}.basicWithTags(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (ApplicationGatewayHTTPListenerResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "test" {
+  name                           = "acctest-listener-%d"
+  application_gateway_id         = azurerm_application_gateway.test.id
+  frontend_ip_configuration_name = local.frontend_ip_configuration_name
+  frontend_port_name             = local.frontend_port_name
+  protocol                       = "Http"
+  host_names                     = ["acctest-%d.example.com", "www.acctest-%d.example.com"]
+
+  custom_error_configuration {
+    status_code           = "HttpStatus403"
+    custom_error_page_url = "http://azure.com/error403_page.html"
+  }
+
+  request_routing_rule {
+    name                       = "acctest-rule-updated-%d"
+    rule_type                  = "Basic"
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, ApplicationGatewayResource{}.basic(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ApplicationGatewayHTTPListenerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_gateway_http_listener" "import" {
+  name                           = azurerm_application_gateway_http_listener.test.name
+  application_gateway_id         = azurerm_application_gateway_http_listener.test.application_gateway_id
+  frontend_ip_configuration_name = azurerm_application_gateway_http_listener.test.frontend_ip_configuration_name
+  frontend_port_name             = azurerm_application_gateway_http_listener.test.frontend_port_name
+  protocol                       = azurerm_application_gateway_http_listener.test.protocol
+  host_name                      = azurerm_application_gateway_http_listener.test.host_name
+
+  request_routing_rule {
+    name                       = "acctest-rule-%d"
+    rule_type                  = "Basic"
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, r.basic(data), data.RandomInteger)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -312,6 +313,17 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							}, true),
 						},
 
+						"private_link_configuration_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"private_link_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -423,6 +435,17 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Optional: true,
 						},
 
+						"ssl_profile_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ssl_profile_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"frontend_ip_configuration_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -751,52 +774,132 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				Computed: true,
+				Elem:     applicationGatewaySslPolicySchema(),
+			},
+
+			"trusted_client_certificate": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
-						"disabled_protocols": {
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"data": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Sensitive:    true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ssl_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"trusted_client_certificate_names": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
-								Type: pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(network.ApplicationGatewaySslProtocolTLSv10),
-									string(network.ApplicationGatewaySslProtocolTLSv11),
-									string(network.ApplicationGatewaySslProtocolTLSv12),
-								}, false),
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
 							},
 						},
 
-						"policy_type": {
-							Type:     pluginsdk.TypeString,
+						"verify_client_cert_issuer_dn": {
+							Type:     pluginsdk.TypeBool,
 							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewaySslPolicyTypeCustom),
-								string(network.ApplicationGatewaySslPolicyTypePredefined),
-							}, false),
+							Default:  false,
 						},
 
-						"policy_name": {
-							Type:     pluginsdk.TypeString,
+						"ssl_policy": {
+							Type:     pluginsdk.TypeList,
 							Optional: true,
+							MaxItems: 1,
+							Elem:     applicationGatewaySslPolicySchema(),
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_link_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"cipher_suites": {
+						"ip_configuration": {
 							Type:     pluginsdk.TypeList,
-							Optional: true,
-							Elem: &pluginsdk.Schema{
-								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringInSlice(possibleApplicationGatewaySslCipherSuiteValues(), false),
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"subnet_id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceID,
+									},
+
+									"private_ip_address_allocation": {
+										Type:             pluginsdk.TypeString,
+										Required:         true,
+										DiffSuppressFunc: suppress.CaseDifference,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(network.IPAllocationMethodDynamic),
+											string(network.IPAllocationMethodStatic),
+										}, true),
+									},
+
+									"primary": {
+										Type:     pluginsdk.TypeBool,
+										Required: true,
+									},
+
+									"private_ip_address": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
 							},
 						},
 
-						"min_protocol_version": {
+						"id": {
 							Type:     pluginsdk.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.ApplicationGatewaySslProtocolTLSv10),
-								string(network.ApplicationGatewaySslProtocolTLSv11),
-								string(network.ApplicationGatewaySslProtocolTLSv12),
-							}, false),
+							Computed: true,
 						},
 					},
 				},
@@ -1352,6 +1455,58 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 	}
 }
 
+func applicationGatewaySslPolicySchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"disabled_protocols": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.ApplicationGatewaySslProtocolTLSv10),
+						string(network.ApplicationGatewaySslProtocolTLSv11),
+						string(network.ApplicationGatewaySslProtocolTLSv12),
+					}, false),
+				},
+			},
+
+			"policy_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ApplicationGatewaySslPolicyTypeCustom),
+					string(network.ApplicationGatewaySslPolicyTypePredefined),
+				}, false),
+			},
+
+			"policy_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"cipher_suites": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(possibleApplicationGatewaySslCipherSuiteValues(), false),
+				},
+			},
+
+			"min_protocol_version": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ApplicationGatewaySslProtocolTLSv10),
+					string(network.ApplicationGatewaySslProtocolTLSv11),
+					string(network.ApplicationGatewaySslProtocolTLSv12),
+				}, false),
+			},
+		},
+	}
+}
+
 func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -1374,6 +1529,9 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	enablehttp2 := d.Get("enable_http2").(bool)
 	t := d.Get("tags").(map[string]interface{})
@@ -1413,6 +1571,8 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("error expanding `rewrite_rule_set`: %v", err)
 	}
 
+	sslProfiles := expandApplicationGatewaySslProfiles(d, id.ID())
+
 	gateway := network.ApplicationGateway{
 		Location: utils.String(location),
 		Zones:    azure.ExpandZones(d.Get("zones").([]interface{})),
@@ -1426,7 +1586,7 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
 			BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, id.ID()),
 			EnableHTTP2:                   utils.Bool(enablehttp2),
-			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
+			FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d, id.ID()),
 			FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
 			GatewayIPConfigurations:       gatewayIPConfigurations,
 			HTTPListeners:                 httpListeners,
@@ -1435,7 +1595,10 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			RedirectConfigurations:        redirectConfigurations,
 			Sku:                           expandApplicationGatewaySku(d),
 			SslCertificates:               sslCertificates,
-			SslPolicy:                     expandApplicationGatewaySslPolicy(d),
+			SslPolicy:                     expandApplicationGatewaySslPolicy(d.Get("ssl_policy").([]interface{})),
+			SslProfiles:                   sslProfiles,
+			TrustedClientCertificates:     expandApplicationGatewayTrustedClientCertificates(d.Get("trusted_client_certificate").([]interface{})),
+			PrivateLinkConfigurations:     expandApplicationGatewayPrivateLinkConfigurations(d.Get("private_link_configuration").([]interface{})),
 
 			RewriteRuleSets: rewriteRuleSets,
 			URLPathMaps:     urlPathMaps,
@@ -1493,6 +1656,19 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	// HTTP Listeners, Request Routing Rules and Backend Addresses can also be managed using their own resources,
+	// so retain any which this resource doesn't manage rather than removing them
+	if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if props := existing.ApplicationGatewayPropertiesFormat; props != nil {
+			retainUnmanagedApplicationGatewaySubResources(d, gateway.ApplicationGatewayPropertiesFormat, props)
+		}
+	}
+
 	if stopApplicationGateway {
 		future, err := client.Stop(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1573,7 +1749,8 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `trusted_root_certificate`: %+v", err)
 		}
 
-		if setErr := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); setErr != nil {
+		backendAddressPools := filterApplicationGatewayManagedBackendAddresses(d.Get("backend_address_pool").([]interface{}), flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools))
+		if setErr := d.Set("backend_address_pool", backendAddressPools); setErr != nil {
 			return fmt.Errorf("Error setting `backend_address_pool`: %+v", setErr)
 		}
 
@@ -1589,12 +1766,29 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `ssl_policy`: %+v", setErr)
 		}
 
+		if setErr := d.Set("trusted_client_certificate", flattenApplicationGatewayTrustedClientCertificates(props.TrustedClientCertificates, d)); setErr != nil {
+			return fmt.Errorf("Error setting `trusted_client_certificate`: %+v", setErr)
+		}
+
+		sslProfiles, err := flattenApplicationGatewaySslProfiles(props.SslProfiles)
+		if err != nil {
+			return fmt.Errorf("Error flattening `ssl_profile`: %+v", err)
+		}
+		if setErr := d.Set("ssl_profile", sslProfiles); setErr != nil {
+			return fmt.Errorf("Error setting `ssl_profile`: %+v", setErr)
+		}
+
+		if setErr := d.Set("private_link_configuration", flattenApplicationGatewayPrivateLinkConfigurations(props.PrivateLinkConfigurations)); setErr != nil {
+			return fmt.Errorf("Error setting `private_link_configuration`: %+v", setErr)
+		}
+
 		d.Set("enable_http2", props.EnableHTTP2)
 
 		httpListeners, err := flattenApplicationGatewayHTTPListeners(props.HTTPListeners)
 		if err != nil {
 			return fmt.Errorf("Error flattening `http_listener`: %+v", err)
 		}
+		httpListeners = filterApplicationGatewayManagedItems(d.Get("http_listener").([]interface{}), httpListeners)
 		if setErr := d.Set("http_listener", httpListeners); setErr != nil {
 			return fmt.Errorf("Error setting `http_listener`: %+v", setErr)
 		}
@@ -1603,7 +1797,11 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `frontend_port`: %+v", setErr)
 		}
 
-		if setErr := d.Set("frontend_ip_configuration", flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)); setErr != nil {
+		frontendIPConfigurations, err := flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)
+		if err != nil {
+			return fmt.Errorf("Error flattening `frontend_ip_configuration`: %+v", err)
+		}
+		if setErr := d.Set("frontend_ip_configuration", frontendIPConfigurations); setErr != nil {
 			return fmt.Errorf("Error setting `frontend_ip_configuration`: %+v", setErr)
 		}
 
@@ -1619,6 +1817,7 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("Error flattening `request_routing_rule`: %+v", err)
 		}
+		requestRoutingRules = filterApplicationGatewayManagedItems(d.Get("request_routing_rule").(*pluginsdk.Set).List(), requestRoutingRules)
 		if setErr := d.Set("request_routing_rule", requestRoutingRules); setErr != nil {
 			return fmt.Errorf("Error setting `request_routing_rule`: %+v", setErr)
 		}
@@ -1682,6 +1881,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -2171,12 +2373,10 @@ func flattenApplicationGatewayConnectionDraining(input *network.ApplicationGatew
 	return []interface{}{result}
 }
 
-func expandApplicationGatewaySslPolicy(d *pluginsdk.ResourceData) *network.ApplicationGatewaySslPolicy {
+func expandApplicationGatewaySslPolicy(vs []interface{}) *network.ApplicationGatewaySslPolicy {
 	policy := network.ApplicationGatewaySslPolicy{}
 	disabledSSLProtocols := make([]network.ApplicationGatewaySslProtocol, 0)
 
-	vs := d.Get("ssl_policy").([]interface{})
-
 	if len(vs) > 0 && vs[0] != nil {
 		v := vs[0].(map[string]interface{})
 		policyType := network.ApplicationGatewaySslPolicyType(v["policy_type"].(string))
@@ -2248,74 +2448,319 @@ func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPoli
 	return results
 }
 
-func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
-	vs := d.Get("http_listener").([]interface{})
-	results := make([]network.ApplicationGatewayHTTPListener, 0)
+func expandApplicationGatewayTrustedClientCertificates(certs []interface{}) *[]network.ApplicationGatewayTrustedClientCertificate {
+	results := make([]network.ApplicationGatewayTrustedClientCertificate, 0)
 
-	for _, raw := range vs {
+	for _, raw := range certs {
 		v := raw.(map[string]interface{})
 
-		name := v["name"].(string)
-		frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
-		frontendPortName := v["frontend_port_name"].(string)
-		protocol := v["protocol"].(string)
-		requireSNI := v["require_sni"].(bool)
+		output := network.ApplicationGatewayTrustedClientCertificate{
+			Name: utils.String(v["name"].(string)),
+			ApplicationGatewayTrustedClientCertificatePropertiesFormat: &network.ApplicationGatewayTrustedClientCertificatePropertiesFormat{
+				Data: utils.String(utils.Base64EncodeIfNot(v["data"].(string))),
+			},
+		}
 
-		frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
-		frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
-		firewallPolicyID := v["firewall_policy_id"].(string)
+		results = append(results, output)
+	}
 
-		customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+	return &results
+}
 
-		listener := network.ApplicationGatewayHTTPListener{
-			Name: utils.String(name),
-			ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
-				FrontendIPConfiguration: &network.SubResource{
-					ID: utils.String(frontendIPConfigID),
-				},
-				FrontendPort: &network.SubResource{
-					ID: utils.String(frontendPortID),
+func flattenApplicationGatewayTrustedClientCertificates(certs *[]network.ApplicationGatewayTrustedClientCertificate, d *pluginsdk.ResourceData) []interface{} {
+	results := make([]interface{}, 0)
+	if certs == nil {
+		return results
+	}
+
+	// since the certificate data isn't returned lets load any existing data
+	nameToDataMap := map[string]string{}
+	if existing, ok := d.GetOk("trusted_client_certificate"); ok && existing != nil {
+		for _, c := range existing.([]interface{}) {
+			b := c.(map[string]interface{})
+			nameToDataMap[b["name"].(string)] = b["data"].(string)
+		}
+	}
+
+	for _, cert := range *certs {
+		output := map[string]interface{}{}
+
+		if v := cert.ID; v != nil {
+			output["id"] = *v
+		}
+
+		if v := cert.Name; v != nil {
+			output["name"] = *v
+
+			if data, ok := nameToDataMap[*v]; ok && data != "" {
+				output["data"] = data
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewaySslProfiles(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewaySslProfile {
+	vs := d.Get("ssl_profile").([]interface{})
+	results := make([]network.ApplicationGatewaySslProfile, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		trustedClientCertificates := make([]network.SubResource, 0)
+		for _, certName := range v["trusted_client_certificate_names"].([]interface{}) {
+			certID := fmt.Sprintf("%s/trustedClientCertificates/%s", gatewayID, certName.(string))
+			trustedClientCertificates = append(trustedClientCertificates, network.SubResource{
+				ID: utils.String(certID),
+			})
+		}
+
+		output := network.ApplicationGatewaySslProfile{
+			Name: utils.String(v["name"].(string)),
+			ApplicationGatewaySslProfilePropertiesFormat: &network.ApplicationGatewaySslProfilePropertiesFormat{
+				TrustedClientCertificates: &trustedClientCertificates,
+				ClientAuthConfiguration: &network.ApplicationGatewayClientAuthConfiguration{
+					VerifyClientCertIssuerDN: utils.Bool(v["verify_client_cert_issuer_dn"].(bool)),
 				},
-				Protocol:                    network.ApplicationGatewayProtocol(protocol),
-				RequireServerNameIndication: utils.Bool(requireSNI),
-				CustomErrorConfigurations:   customErrorConfigurations,
 			},
 		}
 
-		host := v["host_name"].(string)
-		hosts := v["host_names"].(*pluginsdk.Set).List()
+		if sslPolicy := v["ssl_policy"].([]interface{}); len(sslPolicy) > 0 {
+			output.ApplicationGatewaySslProfilePropertiesFormat.SslPolicy = expandApplicationGatewaySslPolicy(sslPolicy)
+		}
+
+		results = append(results, output)
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewaySslProfiles(input *[]network.ApplicationGatewaySslProfile) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
 
-		if host != "" && len(hosts) > 0 {
-			return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+		if v.ID != nil {
+			output["id"] = *v.ID
 		}
 
-		if host != "" {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+		if v.Name != nil {
+			output["name"] = *v.Name
 		}
 
-		if len(hosts) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+		if props := v.ApplicationGatewaySslProfilePropertiesFormat; props != nil {
+			trustedClientCertificateNames := make([]interface{}, 0)
+			if props.TrustedClientCertificates != nil {
+				for _, cert := range *props.TrustedClientCertificates {
+					if cert.ID == nil {
+						continue
+					}
+
+					certId, err := azure.ParseAzureResourceID(*cert.ID)
+					if err != nil {
+						return nil, err
+					}
+					trustedClientCertificateNames = append(trustedClientCertificateNames, certId.Path["trustedClientCertificates"])
+				}
+			}
+			output["trusted_client_certificate_names"] = trustedClientCertificateNames
+
+			verifyClientCertIssuerDN := false
+			if props.ClientAuthConfiguration != nil && props.ClientAuthConfiguration.VerifyClientCertIssuerDN != nil {
+				verifyClientCertIssuerDN = *props.ClientAuthConfiguration.VerifyClientCertIssuerDN
+			}
+			output["verify_client_cert_issuer_dn"] = verifyClientCertIssuerDN
+
+			output["ssl_policy"] = flattenApplicationGatewaySslPolicy(props.SslPolicy)
 		}
 
-		if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
-			certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
-				ID: utils.String(certID),
+		results = append(results, output)
+	}
+
+	return results, nil
+}
+
+func expandApplicationGatewayPrivateLinkConfigurations(vs []interface{}) *[]network.ApplicationGatewayPrivateLinkConfiguration {
+	results := make([]network.ApplicationGatewayPrivateLinkConfiguration, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		ipConfigurations := make([]network.ApplicationGatewayPrivateLinkIPConfiguration, 0)
+		for _, rawIPConfig := range v["ip_configuration"].([]interface{}) {
+			ipConfig := rawIPConfig.(map[string]interface{})
+
+			properties := network.ApplicationGatewayPrivateLinkIPConfigurationProperties{
+				Primary:                   utils.Bool(ipConfig["primary"].(bool)),
+				PrivateIPAllocationMethod: network.IPAllocationMethod(ipConfig["private_ip_address_allocation"].(string)),
+				Subnet: &network.SubResource{
+					ID: utils.String(ipConfig["subnet_id"].(string)),
+				},
+			}
+
+			if val := ipConfig["private_ip_address"].(string); val != "" {
+				properties.PrivateIPAddress = utils.String(val)
 			}
+
+			ipConfigurations = append(ipConfigurations, network.ApplicationGatewayPrivateLinkIPConfiguration{
+				Name: utils.String(ipConfig["name"].(string)),
+				ApplicationGatewayPrivateLinkIPConfigurationProperties: &properties,
+			})
+		}
+
+		results = append(results, network.ApplicationGatewayPrivateLinkConfiguration{
+			Name: utils.String(v["name"].(string)),
+			ApplicationGatewayPrivateLinkConfigurationProperties: &network.ApplicationGatewayPrivateLinkConfigurationProperties{
+				IPConfigurations: &ipConfigurations,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayPrivateLinkConfigurations(input *[]network.ApplicationGatewayPrivateLinkConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
 		}
 
-		if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
-			listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
-				ID: utils.String(firewallPolicyID),
+		ipConfigurations := make([]interface{}, 0)
+		if props := v.ApplicationGatewayPrivateLinkConfigurationProperties; props != nil && props.IPConfigurations != nil {
+			for _, ipConfig := range *props.IPConfigurations {
+				ipConfigOutput := map[string]interface{}{}
+
+				if ipConfig.Name != nil {
+					ipConfigOutput["name"] = *ipConfig.Name
+				}
+
+				if ipProps := ipConfig.ApplicationGatewayPrivateLinkIPConfigurationProperties; ipProps != nil {
+					ipConfigOutput["private_ip_address_allocation"] = string(ipProps.PrivateIPAllocationMethod)
+
+					if ipProps.Primary != nil {
+						ipConfigOutput["primary"] = *ipProps.Primary
+					}
+
+					if ipProps.PrivateIPAddress != nil {
+						ipConfigOutput["private_ip_address"] = *ipProps.PrivateIPAddress
+					}
+
+					if ipProps.Subnet != nil && ipProps.Subnet.ID != nil {
+						ipConfigOutput["subnet_id"] = *ipProps.Subnet.ID
+					}
+				}
+
+				ipConfigurations = append(ipConfigurations, ipConfigOutput)
 			}
 		}
+		output["ip_configuration"] = ipConfigurations
 
-		results = append(results, listener)
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayHTTPListeners(d *pluginsdk.ResourceData, gatewayID string) (*[]network.ApplicationGatewayHTTPListener, error) {
+	vs := d.Get("http_listener").([]interface{})
+	results := make([]network.ApplicationGatewayHTTPListener, 0)
+
+	for _, raw := range vs {
+		listener, err := expandApplicationGatewayHTTPListener(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *listener)
 	}
 
 	return &results, nil
 }
 
+func expandApplicationGatewayHTTPListener(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayHTTPListener, error) {
+	name := v["name"].(string)
+	frontendIPConfigName := v["frontend_ip_configuration_name"].(string)
+	frontendPortName := v["frontend_port_name"].(string)
+	protocol := v["protocol"].(string)
+	requireSNI := v["require_sni"].(bool)
+
+	frontendIPConfigID := fmt.Sprintf("%s/frontendIPConfigurations/%s", gatewayID, frontendIPConfigName)
+	frontendPortID := fmt.Sprintf("%s/frontendPorts/%s", gatewayID, frontendPortName)
+	firewallPolicyID := v["firewall_policy_id"].(string)
+
+	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(v["custom_error_configuration"].([]interface{}))
+
+	listener := network.ApplicationGatewayHTTPListener{
+		Name: utils.String(name),
+		ApplicationGatewayHTTPListenerPropertiesFormat: &network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration: &network.SubResource{
+				ID: utils.String(frontendIPConfigID),
+			},
+			FrontendPort: &network.SubResource{
+				ID: utils.String(frontendPortID),
+			},
+			Protocol:                    network.ApplicationGatewayProtocol(protocol),
+			RequireServerNameIndication: utils.Bool(requireSNI),
+			CustomErrorConfigurations:   customErrorConfigurations,
+		},
+	}
+
+	host := v["host_name"].(string)
+	hosts := v["host_names"].(*pluginsdk.Set).List()
+
+	if host != "" && len(hosts) > 0 {
+		return nil, fmt.Errorf("`host_name` and `host_names` cannot be specified together")
+	}
+
+	if host != "" {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostName = &host
+	}
+
+	if len(hosts) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.HostNames = utils.ExpandStringSlice(hosts)
+	}
+
+	if sslCertName := v["ssl_certificate_name"].(string); sslCertName != "" {
+		certID := fmt.Sprintf("%s/sslCertificates/%s", gatewayID, sslCertName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslCertificate = &network.SubResource{
+			ID: utils.String(certID),
+		}
+	}
+
+	if firewallPolicyID != "" && len(firewallPolicyID) > 0 {
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.FirewallPolicy = &network.SubResource{
+			ID: utils.String(firewallPolicyID),
+		}
+	}
+
+	if sslProfileName := v["ssl_profile_name"].(string); sslProfileName != "" {
+		sslProfileID := fmt.Sprintf("%s/sslProfiles/%s", gatewayID, sslProfileName)
+		listener.ApplicationGatewayHTTPListenerPropertiesFormat.SslProfile = &network.SubResource{
+			ID: utils.String(sslProfileID),
+		}
+	}
+
+	return &listener, nil
+}
+
 func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -2389,6 +2834,15 @@ func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayH
 				output["firewall_policy_id"] = *fwp.ID
 			}
 
+			if sslProfile := props.SslProfile; sslProfile != nil && sslProfile.ID != nil {
+				sslProfileId, err := azure.ParseAzureResourceID(*sslProfile.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["ssl_profile_name"] = sslProfileId.Path["sslProfiles"]
+				output["ssl_profile_id"] = *sslProfile.ID
+			}
+
 			output["custom_error_configuration"] = flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)
 		}
 
@@ -2533,7 +2987,7 @@ func flattenApplicationGatewayFrontendPorts(input *[]network.ApplicationGatewayF
 	return results
 }
 
-func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayFrontendIPConfiguration {
+func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData, gatewayID string) *[]network.ApplicationGatewayFrontendIPConfiguration {
 	vs := d.Get("frontend_ip_configuration").([]interface{})
 	results := make([]network.ApplicationGatewayFrontendIPConfiguration, 0)
 
@@ -2562,6 +3016,13 @@ func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData)
 			}
 		}
 
+		if val := v["private_link_configuration_name"].(string); val != "" {
+			privateLinkConfigurationID := fmt.Sprintf("%s/privateLinkConfigurations/%s", gatewayID, val)
+			properties.PrivateLinkConfiguration = &network.SubResource{
+				ID: utils.String(privateLinkConfigurationID),
+			}
+		}
+
 		name := v["name"].(string)
 		output := network.ApplicationGatewayFrontendIPConfiguration{
 			Name: utils.String(name),
@@ -2574,10 +3035,10 @@ func expandApplicationGatewayFrontendIPConfigurations(d *pluginsdk.ResourceData)
 	return &results
 }
 
-func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.ApplicationGatewayFrontendIPConfiguration) []interface{} {
+func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.ApplicationGatewayFrontendIPConfiguration) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
-		return results
+		return results, nil
 	}
 
 	for _, config := range *input {
@@ -2604,12 +3065,21 @@ func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.Applicat
 			if props.PublicIPAddress != nil && props.PublicIPAddress.ID != nil {
 				output["public_ip_address_id"] = *props.PublicIPAddress.ID
 			}
+
+			if props.PrivateLinkConfiguration != nil && props.PrivateLinkConfiguration.ID != nil {
+				privateLinkConfigurationId, err := azure.ParseAzureResourceID(*props.PrivateLinkConfiguration.ID)
+				if err != nil {
+					return nil, err
+				}
+				output["private_link_configuration_name"] = privateLinkConfigurationId.Path["privateLinkConfigurations"]
+				output["private_link_configuration_id"] = *props.PrivateLinkConfiguration.ID
+			}
 		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
 func expandApplicationGatewayProbes(d *pluginsdk.ResourceData) *[]network.ApplicationGatewayProbe {
@@ -2756,73 +3226,80 @@ func expandApplicationGatewayRequestRoutingRules(d *pluginsdk.ResourceData, gate
 	results := make([]network.ApplicationGatewayRequestRoutingRule, 0)
 
 	for _, raw := range vs {
-		v := raw.(map[string]interface{})
+		rule, err := expandApplicationGatewayRequestRoutingRule(raw.(map[string]interface{}), gatewayID)
+		if err != nil {
+			return nil, err
+		}
 
-		name := v["name"].(string)
-		ruleType := v["rule_type"].(string)
-		httpListenerName := v["http_listener_name"].(string)
-		httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
-		backendAddressPoolName := v["backend_address_pool_name"].(string)
-		backendHTTPSettingsName := v["backend_http_settings_name"].(string)
-		redirectConfigName := v["redirect_configuration_name"].(string)
-
-		rule := network.ApplicationGatewayRequestRoutingRule{
-			Name: utils.String(name),
-			ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
-				RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
-				HTTPListener: &network.SubResource{
-					ID: utils.String(httpListenerID),
-				},
+		results = append(results, *rule)
+	}
+
+	return &results, nil
+}
+
+func expandApplicationGatewayRequestRoutingRule(v map[string]interface{}, gatewayID string) (*network.ApplicationGatewayRequestRoutingRule, error) {
+	name := v["name"].(string)
+	ruleType := v["rule_type"].(string)
+	httpListenerName := v["http_listener_name"].(string)
+	httpListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, httpListenerName)
+	backendAddressPoolName := v["backend_address_pool_name"].(string)
+	backendHTTPSettingsName := v["backend_http_settings_name"].(string)
+	redirectConfigName := v["redirect_configuration_name"].(string)
+
+	rule := network.ApplicationGatewayRequestRoutingRule{
+		Name: utils.String(name),
+		ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType: network.ApplicationGatewayRequestRoutingRuleType(ruleType),
+			HTTPListener: &network.SubResource{
+				ID: utils.String(httpListenerID),
 			},
-		}
+		},
+	}
 
-		if backendAddressPoolName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
-		}
+	if backendAddressPoolName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_address_pool_name` and `redirect_configuration_name` (back-end pool not applicable when redirection specified)")
+	}
 
-		if backendHTTPSettingsName != "" && redirectConfigName != "" {
-			return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
-		}
+	if backendHTTPSettingsName != "" && redirectConfigName != "" {
+		return nil, fmt.Errorf("Conflict between `backend_http_settings_name` and `redirect_configuration_name` (back-end settings not applicable when redirection specified)")
+	}
 
-		if backendAddressPoolName != "" {
-			backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
-				ID: utils.String(backendAddressPoolID),
-			}
+	if backendAddressPoolName != "" {
+		backendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, backendAddressPoolName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendAddressPool = &network.SubResource{
+			ID: utils.String(backendAddressPoolID),
 		}
+	}
 
-		if backendHTTPSettingsName != "" {
-			backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
-				ID: utils.String(backendHTTPSettingsID),
-			}
+	if backendHTTPSettingsName != "" {
+		backendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, backendHTTPSettingsName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.BackendHTTPSettings = &network.SubResource{
+			ID: utils.String(backendHTTPSettingsID),
 		}
+	}
 
-		if redirectConfigName != "" {
-			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
-				ID: utils.String(redirectConfigID),
-			}
+	if redirectConfigName != "" {
+		redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+			ID: utils.String(redirectConfigID),
 		}
+	}
 
-		if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
-			urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
-				ID: utils.String(urlPathMapID),
-			}
+	if urlPathMapName := v["url_path_map_name"].(string); urlPathMapName != "" {
+		urlPathMapID := fmt.Sprintf("%s/urlPathMaps/%s", gatewayID, urlPathMapName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.URLPathMap = &network.SubResource{
+			ID: utils.String(urlPathMapID),
 		}
+	}
 
-		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
-			rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
-			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
-				ID: utils.String(rewriteRuleSetID),
-			}
+	if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+		rewriteRuleSetID := fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RewriteRuleSet = &network.SubResource{
+			ID: utils.String(rewriteRuleSetID),
 		}
-
-		results = append(results, rule)
 	}
 
-	return &results, nil
+	return &rule, nil
 }
 
 func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
@@ -36,7 +36,7 @@ func TestAccApplicationGateway_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("waf_configuration.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -67,7 +67,7 @@ func TestAccApplicationGateway_autoscaleConfiguration(t *testing.T) {
 				check.That(data.ResourceName).Key("waf_configuration.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -86,7 +86,7 @@ func TestAccApplicationGateway_autoscaleConfigurationNoMaxCapacity(t *testing.T)
 				check.That(data.ResourceName).Key("waf_configuration.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -106,7 +106,7 @@ func TestAccApplicationGateway_zones(t *testing.T) {
 				check.That(data.ResourceName).Key("waf_configuration.#").HasValue("0"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -122,7 +122,7 @@ func TestAccApplicationGateway_overridePath(t *testing.T) {
 				check.That(data.ResourceName).Key("backend_http_settings.0.path").HasValue("/path1/"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -138,7 +138,7 @@ func TestAccApplicationGateway_http2(t *testing.T) {
 				check.That(data.ResourceName).Key("enable_http2").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -173,7 +173,7 @@ func TestAccApplicationGateway_authCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 
 			"authentication_certificate.0.data",
 		),
@@ -185,7 +185,7 @@ func TestAccApplicationGateway_authCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 
 			"authentication_certificate.0.data",
 		),
@@ -204,7 +204,7 @@ func TestAccApplicationGateway_customFirewallPolicy(t *testing.T) {
 				check.That(data.ResourceName).Key("firewall_policy_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -220,7 +220,7 @@ func TestAccApplicationGateway_customHttpListenerFirewallPolicy(t *testing.T) {
 				check.That(data.ResourceName).Key("http_listener.0.firewall_policy_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -235,7 +235,7 @@ func TestAccApplicationGateway_customPathRuleFirewallPolicy(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -254,7 +254,47 @@ func TestAccApplicationGateway_trustedRootCertificate_keyvault(t *testing.T) {
 				check.That(data.ResourceName).Key("trusted_root_certificate.0.name").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
+	})
+}
+
+func TestAccApplicationGateway_sslProfile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sslProfile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ssl_profile.0.name").Exists(),
+				check.That(data.ResourceName).Key("ssl_profile.0.id").Exists(),
+				check.That(data.ResourceName).Key("http_listener.0.ssl_profile_id").Exists(),
+			),
+		},
+		// since these are read from the existing state
+		applicationGatewayImportStep(data,
+			"ssl_certificate.0.data",
+			"ssl_certificate.0.password",
+			"trusted_client_certificate.0.data",
+		),
+	})
+}
+
+func TestAccApplicationGateway_privateLink(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_gateway", "test")
+	r := ApplicationGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateLink(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_link_configuration.0.id").Exists(),
+				check.That(data.ResourceName).Key("frontend_ip_configuration.0.private_link_configuration_id").Exists(),
+			),
+		},
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -271,7 +311,7 @@ func TestAccApplicationGateway_trustedRootCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 			"trusted_root_certificate.0.data",
 		),
 		{
@@ -282,7 +322,7 @@ func TestAccApplicationGateway_trustedRootCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 			"trusted_root_certificate.0.data",
 		),
 	})
@@ -299,7 +339,7 @@ func TestAccApplicationGateway_pathBasedRouting(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -314,7 +354,7 @@ func TestAccApplicationGateway_routingRedirect_httpListener(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -341,7 +381,7 @@ func TestAccApplicationGateway_routingRedirect_pathBased(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -356,7 +396,7 @@ func TestAccApplicationGateway_customErrorConfigurations(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -372,7 +412,7 @@ func TestAccApplicationGateway_rewriteRuleSets_backend(t *testing.T) {
 				check.That(data.ResourceName).Key("rewrite_rule_set.0.name").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -388,7 +428,7 @@ func TestAccApplicationGateway_rewriteRuleSets_redirect(t *testing.T) {
 				check.That(data.ResourceName).Key("rewrite_rule_set.0.name").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -404,7 +444,7 @@ func TestAccApplicationGateway_rewriteRuleSets_rewriteUrl(t *testing.T) {
 				check.That(data.ResourceName).Key("rewrite_rule_set.0.name").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -419,7 +459,7 @@ func TestAccApplicationGateway_probes(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -434,7 +474,7 @@ func TestAccApplicationGateway_probesEmptyMatch(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -450,7 +490,7 @@ func TestAccApplicationGateway_probesPickHostNameFromBackendHTTPSettings(t *test
 				check.That(data.ResourceName).Key("probe.0.pick_host_name_from_backend_http_settings").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -466,7 +506,7 @@ func TestAccApplicationGateway_probesWithPort(t *testing.T) {
 				check.That(data.ResourceName).Key("probe.0.port").HasValue("8082"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -483,7 +523,7 @@ func TestAccApplicationGateway_backendHttpSettingsHostName(t *testing.T) {
 				check.That(data.ResourceName).Key("backend_http_settings.0.host_name").HasValue(hostName),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -498,7 +538,7 @@ func TestAccApplicationGateway_withHttpListenerHostNames(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -527,7 +567,7 @@ func TestAccApplicationGateway_settingsPickHostNameFromBackendAddress(t *testing
 				check.That(data.ResourceName).Key("backend_http_settings.0.pick_host_name_from_backend_address").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -543,7 +583,7 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versionless(t *testing.T)
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -559,7 +599,7 @@ func TestAccApplicationGateway_sslCertificate_keyvault_versioned(t *testing.T) {
 				check.That(data.ResourceName).Key("ssl_certificate.0.key_vault_secret_id").Exists(),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -575,7 +615,7 @@ func TestAccApplicationGateway_sslCertificate_EmptyPassword(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 			"ssl_certificate.0.data",
 			"ssl_certificate.0.password",
 		),
@@ -617,7 +657,7 @@ func TestAccApplicationGateway_sslCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 			"ssl_certificate.0.data",
 			"ssl_certificate.0.password",
 		),
@@ -628,7 +668,7 @@ func TestAccApplicationGateway_sslCertificate(t *testing.T) {
 			),
 		},
 		// since these are read from the existing state
-		data.ImportStep(
+		applicationGatewayImportStep(data,
 			"ssl_certificate.0.data",
 			"ssl_certificate.0.password",
 		),
@@ -671,7 +711,7 @@ func TestAccApplicationGateway_connectionDraining(t *testing.T) {
 				check.That(data.ResourceName).Key("backend_http_settings.0.connection_draining.0.enabled").HasValue("true"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 		{
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -684,7 +724,7 @@ func TestAccApplicationGateway_connectionDraining(t *testing.T) {
 				acceptance.TestCheckNoResourceAttr(data.ResourceName, "backend_http_settings.0.connection_draining.0.drain_timeout_sec"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -862,7 +902,7 @@ func TestAccApplicationGateway_cookieAffinity(t *testing.T) {
 				check.That(data.ResourceName).Key("backend_http_settings.0.affinity_cookie_name").HasValue("testCookieName"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 		{
 			Config: r.cookieAffinityUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
@@ -870,7 +910,7 @@ func TestAccApplicationGateway_cookieAffinity(t *testing.T) {
 				check.That(data.ResourceName).Key("backend_http_settings.0.affinity_cookie_name").HasValue(""),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -885,14 +925,14 @@ func TestAccApplicationGateway_gatewayIP(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 		{
 			Config: r.gatewayIPUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -926,7 +966,7 @@ func TestAccApplicationGateway_V2SKUCapacity(t *testing.T) {
 				check.That(data.ResourceName).Key("sku.0.capacity").HasValue("124"),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -941,7 +981,7 @@ func TestAccApplicationGateway_IncludePathWithTargetURL(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
@@ -956,10 +996,16 @@ func TestAccApplicationGateway_backendAddressPoolEmptyIpList(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep(),
+		applicationGatewayImportStep(data),
 	})
 }
 
+// applicationGatewayImportStep ignores the HTTP Listeners, Request Routing Rules and Backend Addresses, since
+// these aren't imported - they're only tracked once they're defined in the configuration
+func applicationGatewayImportStep(data acceptance.TestData, ignore ...string) acceptance.TestStep {
+	return data.ImportStep(append(ignore, "http_listener", "request_routing_rule", "backend_address_pool")...)
+}
+
 func (t ApplicationGatewayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ApplicationGatewayID(state.ID)
 	if err != nil {
@@ -1044,6 +1090,80 @@ resource "azurerm_application_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApplicationGatewayResource) basicWithTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+# since these variables are re-used - a locals block makes this more maintainable
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.test.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ApplicationGatewayResource) UserDefinedIdentity(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -1893,6 +2013,211 @@ resource "azurerm_application_gateway" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r ApplicationGatewayResource) sslProfile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+# since these variables are re-used - a locals block makes this more maintainable
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+  ssl_certificate_name           = "${azurerm_virtual_network.test.name}-ssl1"
+  ssl_profile_name               = "${azurerm_virtual_network.test.name}-sslprofile"
+  trusted_client_cert_name       = "${azurerm_virtual_network.test.name}-trustedclient"
+}
+
+resource "azurerm_public_ip" "teststd" {
+  name                = "acctest-PubIpStd-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_v2"
+    tier     = "Standard_v2"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 443
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.teststd.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  trusted_client_certificate {
+    name = local.trusted_client_cert_name
+    data = file("testdata/application_gateway_test.cer")
+  }
+
+  ssl_profile {
+    name                             = local.ssl_profile_name
+    trusted_client_certificate_names = [local.trusted_client_cert_name]
+    verify_client_cert_issuer_dn     = true
+
+    ssl_policy {
+      policy_type = "Predefined"
+      policy_name = "AppGwSslPolicy20170401S"
+    }
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Https"
+    ssl_certificate_name           = local.ssl_certificate_name
+    ssl_profile_name               = local.ssl_profile_name
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+
+  ssl_certificate {
+    name     = local.ssl_certificate_name
+    data     = filebase64("testdata/application_gateway_test.pfx")
+    password = "terraform"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApplicationGatewayResource) privateLink(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+# since these variables are re-used - a locals block makes this more maintainable
+locals {
+  backend_address_pool_name       = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name              = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name  = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name               = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                   = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name       = "${azurerm_virtual_network.test.name}-rqrt"
+  private_link_configuration_name = "${azurerm_virtual_network.test.name}-pvtlink"
+}
+
+resource "azurerm_subnet" "privatelink" {
+  name                 = "subnet-privatelink-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+
+  enforce_private_link_service_network_policies = true
+}
+
+resource "azurerm_public_ip" "teststd" {
+  name                = "acctest-PubIpStd-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "Standard_v2"
+    tier     = "Standard_v2"
+    capacity = 2
+  }
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                            = local.frontend_ip_configuration_name
+    public_ip_address_id            = azurerm_public_ip.teststd.id
+    private_link_configuration_name = local.private_link_configuration_name
+  }
+
+  private_link_configuration {
+    name = local.private_link_configuration_name
+
+    ip_configuration {
+      name                          = "primary"
+      subnet_id                     = azurerm_subnet.privatelink.id
+      private_ip_address_allocation = "Dynamic"
+      primary                       = true
+    }
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = local.request_routing_rule_name
+    rule_type                  = "Basic"
+    http_listener_name         = local.listener_name
+    backend_address_pool_name  = local.backend_address_pool_name
+    backend_http_settings_name = local.http_setting_name
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r ApplicationGatewayResource) customFirewallPolicy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,174 @@
+package network
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// retainUnmanagedApplicationGatewaySubResources appends the HTTP Listeners, Request Routing Rules and Backend Addresses
+// which exist on the Application Gateway but aren't tracked by this resource (for example as they're managed by the
+// `azurerm_application_gateway_http_listener` or `azurerm_application_gateway_backend_address_pool_address` resources)
+// to the desired configuration, so that they're not removed when the Application Gateway is updated
+func retainUnmanagedApplicationGatewaySubResources(d *pluginsdk.ResourceData, desired *network.ApplicationGatewayPropertiesFormat, existing *network.ApplicationGatewayPropertiesFormat) {
+	oldListeners, newListeners := d.GetChange("http_listener")
+	oldRules, newRules := d.GetChange("request_routing_rule")
+	oldPools, _ := d.GetChange("backend_address_pool")
+
+	managed := applicationGatewayManagedSubResources{
+		listeners:        applicationGatewayManagedNames(oldListeners.([]interface{}), newListeners.([]interface{})),
+		rules:            applicationGatewayManagedNames(oldRules.(*pluginsdk.Set).List(), newRules.(*pluginsdk.Set).List()),
+		backendAddresses: applicationGatewayManagedBackendAddresses(oldPools.([]interface{})),
+	}
+	retainApplicationGatewaySubResourcesNotIn(managed, desired, existing)
+}
+
+// applicationGatewayManagedSubResources are the names of the HTTP Listeners and Request Routing Rules, and the
+// Backend Addresses (keyed by the name of the Backend Address Pool), which are managed by the Application Gateway resource
+type applicationGatewayManagedSubResources struct {
+	listeners        map[string]bool
+	rules            map[string]bool
+	backendAddresses map[string]map[string]bool
+}
+
+// retainApplicationGatewaySubResourcesNotIn appends the existing HTTP Listeners, Request Routing Rules and Backend Addresses
+// which aren't `managed` to the desired configuration
+func retainApplicationGatewaySubResourcesNotIn(managed applicationGatewayManagedSubResources, desired *network.ApplicationGatewayPropertiesFormat, existing *network.ApplicationGatewayPropertiesFormat) {
+	if existing.HTTPListeners != nil && desired.HTTPListeners != nil {
+		listeners := *desired.HTTPListeners
+		for _, listener := range *existing.HTTPListeners {
+			if listener.Name != nil && !managed.listeners[*listener.Name] {
+				listeners = append(listeners, listener)
+			}
+		}
+		desired.HTTPListeners = &listeners
+	}
+
+	if existing.RequestRoutingRules != nil && desired.RequestRoutingRules != nil {
+		rules := *desired.RequestRoutingRules
+		for _, rule := range *existing.RequestRoutingRules {
+			if rule.Name != nil && !managed.rules[*rule.Name] {
+				rules = append(rules, rule)
+			}
+		}
+		desired.RequestRoutingRules = &rules
+	}
+
+	if existing.BackendAddressPools == nil || desired.BackendAddressPools == nil {
+		return
+	}
+
+	for _, pool := range *desired.BackendAddressPools {
+		// the properties are a pointer, so updating the Backend Addresses below updates the desired Backend Address Pool
+		if pool.Name == nil || pool.ApplicationGatewayBackendAddressPoolPropertiesFormat == nil || pool.BackendAddresses == nil {
+			continue
+		}
+
+		desiredAddresses := make(map[string]bool)
+		for _, address := range *pool.BackendAddresses {
+			desiredAddresses[applicationGatewayBackendAddressValue(address)] = true
+		}
+
+		for _, existingPool := range *existing.BackendAddressPools {
+			if existingPool.Name == nil || *existingPool.Name != *pool.Name {
+				continue
+			}
+			if existingPool.ApplicationGatewayBackendAddressPoolPropertiesFormat == nil || existingPool.BackendAddresses == nil {
+				continue
+			}
+
+			addresses := *pool.BackendAddresses
+			for _, address := range *existingPool.BackendAddresses {
+				value := applicationGatewayBackendAddressValue(address)
+				if desiredAddresses[value] || managed.backendAddresses[*pool.Name][value] {
+					continue
+				}
+				addresses = append(addresses, address)
+			}
+			pool.BackendAddresses = &addresses
+		}
+	}
+}
+
+// filterApplicationGatewayManagedItems returns the flattened items whose names are tracked in the `configured`
+// blocks. Since these can also be managed by their own resources nothing is adopted when nothing is tracked (for
+// example during import), instead the items are tracked once they're specified in the configuration
+func filterApplicationGatewayManagedItems(configured []interface{}, items []interface{}) []interface{} {
+	managed := applicationGatewayManagedNames(configured)
+
+	results := make([]interface{}, 0)
+	for _, raw := range items {
+		item := raw.(map[string]interface{})
+		if name, ok := item["name"].(string); ok && managed[name] {
+			results = append(results, item)
+		}
+	}
+	return results
+}
+
+// filterApplicationGatewayManagedBackendAddresses removes any Backend Addresses which aren't tracked in the `configured`
+// Backend Address Pools. Since these can also be managed by the `azurerm_application_gateway_backend_address_pool_address`
+// resource nothing is adopted when nothing is tracked (for example during import)
+func filterApplicationGatewayManagedBackendAddresses(configured []interface{}, pools []interface{}) []interface{} {
+	managed := applicationGatewayManagedBackendAddresses(configured)
+	for _, raw := range pools {
+		pool := raw.(map[string]interface{})
+		managedAddresses := managed[pool["name"].(string)]
+
+		for _, key := range []string{"fqdns", "ip_addresses"} {
+			addresses := make([]interface{}, 0)
+			for _, address := range pool[key].([]interface{}) {
+				if managedAddresses[address.(string)] {
+					addresses = append(addresses, address)
+				}
+			}
+			pool[key] = addresses
+		}
+	}
+
+	return pools
+}
+
+func applicationGatewayManagedNames(blocks ...[]interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, block := range blocks {
+		for _, raw := range block {
+			if v, ok := raw.(map[string]interface{}); ok {
+				if name, ok := v["name"].(string); ok && name != "" {
+					names[name] = true
+				}
+			}
+		}
+	}
+	return names
+}
+
+func applicationGatewayManagedBackendAddresses(pools []interface{}) map[string]map[string]bool {
+	results := make(map[string]map[string]bool)
+	for _, raw := range pools {
+		pool, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		addresses := make(map[string]bool)
+		for _, key := range []string{"fqdns", "ip_addresses"} {
+			if values, ok := pool[key].([]interface{}); ok {
+				for _, value := range values {
+					addresses[value.(string)] = true
+				}
+			}
+		}
+		results[pool["name"].(string)] = addresses
+	}
+	return results
+}
+
+func applicationGatewayBackendAddressValue(input network.ApplicationGatewayBackendAddress) string {
+	if input.IPAddress != nil {
+		return *input.IPAddress
+	}
+	if input.Fqdn != nil {
+		return *input.Fqdn
+	}
+	return ""
+}
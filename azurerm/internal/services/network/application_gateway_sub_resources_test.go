@@ -0,0 +1,202 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFilterApplicationGatewayManagedItems(t *testing.T) {
+	items := func() []interface{} {
+		return []interface{}{
+			map[string]interface{}{"name": "inline"},
+			map[string]interface{}{"name": "standalone"},
+		}
+	}
+
+	testData := []struct {
+		Name       string
+		Configured []interface{}
+		Expected   []string
+	}{
+		{
+			// e.g. during import, nothing should be adopted
+			Name:       "Nothing Tracked",
+			Configured: []interface{}{},
+			Expected:   []string{},
+		},
+		{
+			Name: "Tracked",
+			Configured: []interface{}{
+				map[string]interface{}{"name": "inline"},
+			},
+			Expected: []string{"inline"},
+		},
+		{
+			Name: "Tracked but Removed",
+			Configured: []interface{}{
+				map[string]interface{}{"name": "removed"},
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := make([]string, 0)
+		for _, raw := range filterApplicationGatewayManagedItems(v.Configured, items()) {
+			actual = append(actual, raw.(map[string]interface{})["name"].(string))
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestFilterApplicationGatewayManagedBackendAddresses(t *testing.T) {
+	pools := func() []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name":         "pool1",
+				"fqdns":        []interface{}{"inline.example.com", "standalone.example.com"},
+				"ip_addresses": []interface{}{"10.0.0.1", "10.0.0.2"},
+			},
+		}
+	}
+
+	testData := []struct {
+		Name                string
+		Configured          []interface{}
+		ExpectedFqdns       []interface{}
+		ExpectedIPAddresses []interface{}
+	}{
+		{
+			// e.g. during import, nothing should be adopted
+			Name:                "Nothing Tracked",
+			Configured:          []interface{}{},
+			ExpectedFqdns:       []interface{}{},
+			ExpectedIPAddresses: []interface{}{},
+		},
+		{
+			Name: "Pool Tracked",
+			Configured: []interface{}{
+				map[string]interface{}{
+					"name":         "pool1",
+					"fqdns":        []interface{}{"inline.example.com"},
+					"ip_addresses": []interface{}{"10.0.0.1"},
+				},
+			},
+			ExpectedFqdns:       []interface{}{"inline.example.com"},
+			ExpectedIPAddresses: []interface{}{"10.0.0.1"},
+		},
+		{
+			Name: "Other Pool Tracked",
+			Configured: []interface{}{
+				map[string]interface{}{
+					"name":         "pool2",
+					"fqdns":        []interface{}{"inline.example.com"},
+					"ip_addresses": []interface{}{"10.0.0.1"},
+				},
+			},
+			ExpectedFqdns:       []interface{}{},
+			ExpectedIPAddresses: []interface{}{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := filterApplicationGatewayManagedBackendAddresses(v.Configured, pools())
+		pool := actual[0].(map[string]interface{})
+		if !reflect.DeepEqual(pool["fqdns"], v.ExpectedFqdns) {
+			t.Fatalf("Expected the FQDNs %+v but got %+v", v.ExpectedFqdns, pool["fqdns"])
+		}
+		if !reflect.DeepEqual(pool["ip_addresses"], v.ExpectedIPAddresses) {
+			t.Fatalf("Expected the IP Addresses %+v but got %+v", v.ExpectedIPAddresses, pool["ip_addresses"])
+		}
+	}
+}
+
+func TestRetainApplicationGatewaySubResourcesNotIn(t *testing.T) {
+	existing := &network.ApplicationGatewayPropertiesFormat{
+		HTTPListeners: &[]network.ApplicationGatewayHTTPListener{
+			{Name: utils.String("inline")},
+			{Name: utils.String("removed")},
+			{Name: utils.String("standalone")},
+		},
+		RequestRoutingRules: &[]network.ApplicationGatewayRequestRoutingRule{
+			{Name: utils.String("inline")},
+			{Name: utils.String("standalone")},
+		},
+		BackendAddressPools: &[]network.ApplicationGatewayBackendAddressPool{
+			{
+				Name: utils.String("pool1"),
+				ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+					BackendAddresses: &[]network.ApplicationGatewayBackendAddress{
+						{IPAddress: utils.String("10.0.0.1")},
+						{IPAddress: utils.String("10.0.0.2")},
+						{Fqdn: utils.String("standalone.example.com")},
+					},
+				},
+			},
+		},
+	}
+
+	desired := &network.ApplicationGatewayPropertiesFormat{
+		HTTPListeners: &[]network.ApplicationGatewayHTTPListener{
+			{Name: utils.String("inline")},
+		},
+		RequestRoutingRules: &[]network.ApplicationGatewayRequestRoutingRule{
+			{Name: utils.String("inline")},
+		},
+		BackendAddressPools: &[]network.ApplicationGatewayBackendAddressPool{
+			{
+				Name: utils.String("pool1"),
+				ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+					BackendAddresses: &[]network.ApplicationGatewayBackendAddress{
+						{IPAddress: utils.String("10.0.0.1")},
+					},
+				},
+			},
+		},
+	}
+
+	// `removed` was previously managed inline, as was the Backend Address `10.0.0.2`
+	managed := applicationGatewayManagedSubResources{
+		listeners: map[string]bool{"inline": true, "removed": true},
+		rules:     map[string]bool{"inline": true},
+		backendAddresses: map[string]map[string]bool{
+			"pool1": {"10.0.0.1": true, "10.0.0.2": true},
+		},
+	}
+
+	retainApplicationGatewaySubResourcesNotIn(managed, desired, existing)
+
+	listeners := make([]string, 0)
+	for _, v := range *desired.HTTPListeners {
+		listeners = append(listeners, *v.Name)
+	}
+	if expected := []string{"inline", "standalone"}; !reflect.DeepEqual(listeners, expected) {
+		t.Fatalf("Expected the HTTP Listeners %+v but got %+v", expected, listeners)
+	}
+
+	rules := make([]string, 0)
+	for _, v := range *desired.RequestRoutingRules {
+		rules = append(rules, *v.Name)
+	}
+	if expected := []string{"inline", "standalone"}; !reflect.DeepEqual(rules, expected) {
+		t.Fatalf("Expected the Request Routing Rules %+v but got %+v", expected, rules)
+	}
+
+	addresses := make([]string, 0)
+	for _, v := range *(*desired.BackendAddressPools)[0].BackendAddresses {
+		addresses = append(addresses, applicationGatewayBackendAddressValue(v))
+	}
+	if expected := []string{"10.0.0.1", "standalone.example.com"}; !reflect.DeepEqual(addresses, expected) {
+		t.Fatalf("Expected the Backend Addresses %+v but got %+v", expected, addresses)
+	}
+}
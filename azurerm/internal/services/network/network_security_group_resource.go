@@ -1,7 +1,10 @@
 package network
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -57,124 +60,141 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 				ConfigMode: pluginsdk.SchemaConfigModeAttr,
 				Optional:   true,
 				Computed:   true,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
-						"description": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringLenBetween(0, 140),
-						},
-
-						"protocol": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleProtocolAsterisk),
-								string(network.SecurityRuleProtocolTCP),
-								string(network.SecurityRuleProtocolUDP),
-								string(network.SecurityRuleProtocolIcmp),
-							}, true),
-							DiffSuppressFunc: suppress.CaseDifference,
-						},
-
-						"source_port_range": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"source_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_port_range": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"destination_port_ranges": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"source_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"source_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_address_prefix": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
-						},
-
-						"destination_address_prefixes": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"destination_application_security_group_ids": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"source_application_security_group_ids": {
-							Type:     pluginsdk.TypeSet,
-							Optional: true,
-							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-							Set:      pluginsdk.HashString,
-						},
-
-						"access": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleAccessAllow),
-								string(network.SecurityRuleAccessDeny),
-							}, true),
-							DiffSuppressFunc: suppress.CaseDifference,
-						},
-
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(100, 4096),
-						},
-
-						"direction": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.SecurityRuleDirectionInbound),
-								string(network.SecurityRuleDirectionOutbound),
-							}, true),
-							DiffSuppressFunc: suppress.CaseDifference,
-						},
-					},
-				},
+				Elem:       networkSecurityGroupRuleSchema(),
+				Set:        resourceNetworkSecurityGroupRuleHash,
+			},
+
+			// by default the `security_rule` blocks are authoritative, when enabled only the rules defined within
+			// them are managed - leaving those from `azurerm_network_security_rule` / `azurerm_network_security_rules`
+			"retain_unmanaged_security_rules": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkSecurityGroupCustomizeDiff),
+	}
+}
+
+// networkSecurityGroupRuleSchema is shared between the inline `security_rule` block and the
+// `azurerm_network_security_rules` resource, which manages a subset of the rules in bulk
+func networkSecurityGroupRuleSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 140),
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleProtocolAsterisk),
+					string(network.SecurityRuleProtocolTCP),
+					string(network.SecurityRuleProtocolUDP),
+					string(network.SecurityRuleProtocolIcmp),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"source_port_range": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"source_port_ranges": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_port_range": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"destination_port_ranges": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"source_address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"source_address_prefixes": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"destination_address_prefixes": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"destination_application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"source_application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+			},
+
+			"access": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleAccessAllow),
+					string(network.SecurityRuleAccessDeny),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 4096),
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.SecurityRuleDirectionInbound),
+					string(network.SecurityRuleDirectionOutbound),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+		},
 	}
 }
 
@@ -210,6 +230,20 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 	locks.ByName(name, networkSecurityGroupResourceName)
 	defer locks.UnlockByName(name, networkSecurityGroupResourceName)
 
+	if !d.IsNewResource() && d.Get("retain_unmanaged_security_rules").(bool) {
+		existing, err := client.Get(ctx, resGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving Network Security Group %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		// rules which have never been managed by this resource belong to `azurerm_network_security_rule`,
+		// `azurerm_network_security_rules` or something outside of Terraform, so should be left alone
+		if props := existing.SecurityGroupPropertiesFormat; props != nil {
+			oldRaw, _ := d.GetChange("security_rule")
+			sgRules = append(sgRules, unmanagedNetworkSecurityRules(props.SecurityRules, oldRaw.(*pluginsdk.Set).List(), sgRules)...)
+		}
+	}
+
 	sg := network.SecurityGroup{
 		Name:     &name,
 		Location: &location,
@@ -266,8 +300,17 @@ func resourceNetworkSecurityGroupRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	// this isn't returned by the API, when importing it defaults to `false` - meaning all of the rules are included
+	retainUnmanagedRules := d.Get("retain_unmanaged_security_rules").(bool)
+	d.Set("retain_unmanaged_security_rules", retainUnmanagedRules)
+
 	if props := resp.SecurityGroupPropertiesFormat; props != nil {
-		flattenedRules := flattenNetworkSecurityRules(props.SecurityRules)
+		rules := props.SecurityRules
+		if retainUnmanagedRules {
+			rules = managedNetworkSecurityRules(rules, d.Get("security_rule").(*pluginsdk.Set).List())
+		}
+
+		flattenedRules := flattenNetworkSecurityRules(rules)
 		if err := d.Set("security_rule", flattenedRules); err != nil {
 			return fmt.Errorf("Error setting `security_rule`: %+v", err)
 		}
@@ -298,8 +341,44 @@ func resourceNetworkSecurityGroupDelete(d *pluginsdk.ResourceData, meta interfac
 	return err
 }
 
+func resourceNetworkSecurityGroupCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	rules := d.Get("security_rule").(*pluginsdk.Set).List()
+	if err := validateNetworkSecurityRulePriorities(rules); err != nil {
+		return err
+	}
+
+	// a new Network Security Group can't contain rules managed elsewhere - otherwise the desired rules mustn't clash with
+	// any rules added outside of this resource, regardless of whether these are retained or replaced
+	if d.Id() == "" || !d.HasChange("security_rule") {
+		return nil
+	}
+
+	id, err := parse.NetworkSecurityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.SecurityGroupPropertiesFormat == nil {
+		return nil
+	}
+
+	oldRaw, _ := d.GetChange("security_rule")
+	return validateNetworkSecurityRulesDoNotConflict(existing.SecurityRules, oldRaw.(*pluginsdk.Set).List(), rules)
+}
+
 func expandAzureRmSecurityRules(d *pluginsdk.ResourceData) ([]network.SecurityRule, error) {
-	sgRules := d.Get("security_rule").(*pluginsdk.Set).List()
+	return expandNetworkSecurityRules(d.Get("security_rule").(*pluginsdk.Set).List())
+}
+
+func expandNetworkSecurityRules(sgRules []interface{}) ([]network.SecurityRule, error) {
 	rules := make([]network.SecurityRule, 0)
 
 	for _, sgRaw := range sgRules {
@@ -501,3 +580,138 @@ func validateSecurityRule(sgRule map[string]interface{}) error {
 
 	return err.ErrorOrNil()
 }
+
+func resourceNetworkSecurityGroupRuleHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	// rules are keyed on their direction and priority (which Azure requires to be unique) so that
+	// changing a single rule only shows up as a change to that rule, rather than the entire set
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(m["direction"].(string))))
+		buf.WriteString(fmt.Sprintf("%d-", m["priority"].(int)))
+		buf.WriteString(m["name"].(string))
+	}
+
+	return pluginsdk.HashString(buf.String())
+}
+
+// managedNetworkSecurityRules returns the rules from the API which are tracked within the given rule blocks
+func managedNetworkSecurityRules(input *[]network.SecurityRule, managed []interface{}) *[]network.SecurityRule {
+	results := make([]network.SecurityRule, 0)
+	if input == nil {
+		return &results
+	}
+
+	names := networkSecurityRuleNames(managed)
+	for _, rule := range *input {
+		if rule.Name != nil && names[*rule.Name] {
+			results = append(results, rule)
+		}
+	}
+
+	return &results
+}
+
+// unmanagedNetworkSecurityRules returns the rules from the API which are neither tracked within the
+// previously managed rule blocks nor part of the desired rules, and as such need to be retained
+func unmanagedNetworkSecurityRules(input *[]network.SecurityRule, previouslyManaged []interface{}, desired []network.SecurityRule) []network.SecurityRule {
+	results := make([]network.SecurityRule, 0)
+	if input == nil {
+		return results
+	}
+
+	names := networkSecurityRuleNames(previouslyManaged)
+	for _, rule := range desired {
+		if rule.Name != nil {
+			names[*rule.Name] = true
+		}
+	}
+
+	for _, rule := range *input {
+		if rule.Name != nil && !names[*rule.Name] {
+			results = append(results, rule)
+		}
+	}
+
+	return results
+}
+
+func networkSecurityRuleNames(input []interface{}) map[string]bool {
+	names := make(map[string]bool)
+	for _, raw := range input {
+		if v, ok := raw.(map[string]interface{}); ok {
+			names[v["name"].(string)] = true
+		}
+	}
+	return names
+}
+
+// validateNetworkSecurityRulePriorities ensures that each priority is used once per direction, since
+// otherwise the API will reject the rules at apply time
+func validateNetworkSecurityRulePriorities(input []interface{}) error {
+	priorities := make(map[string]string)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := v["name"].(string)
+		direction := v["direction"].(string)
+		priority := v["priority"].(int)
+		// these may not be known until apply time
+		if name == "" || direction == "" || priority == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s-%d", strings.ToLower(direction), priority)
+		if existing, ok := priorities[key]; ok && existing != name {
+			return fmt.Errorf("the Security Rules %q and %q both use the %s priority %d - priorities must be unique per direction", existing, name, direction, priority)
+		}
+		priorities[key] = name
+	}
+
+	return nil
+}
+
+// validateNetworkSecurityRulesDoNotConflict ensures that the desired rules don't clash by name or by priority with
+// any rules within the Network Security Group which are managed outside of the rule blocks being diffed, such as
+// those from `azurerm_network_security_rule` or `azurerm_network_security_rules`
+func validateNetworkSecurityRulesDoNotConflict(existing *[]network.SecurityRule, previouslyManaged []interface{}, desired []interface{}) error {
+	if existing == nil {
+		return nil
+	}
+
+	managed := networkSecurityRuleNames(previouslyManaged)
+	for _, raw := range desired {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := v["name"].(string)
+		direction := v["direction"].(string)
+		priority := v["priority"].(int)
+
+		for _, rule := range *existing {
+			if rule.Name == nil || managed[*rule.Name] {
+				continue
+			}
+
+			if *rule.Name == name {
+				return fmt.Errorf("the Security Rule %q already exists within the Network Security Group and is managed outside of this resource (for example by `azurerm_network_security_group`, `azurerm_network_security_rule` or `azurerm_network_security_rules`) - either remove it from this resource or from the other resource", name)
+			}
+
+			props := rule.SecurityRulePropertiesFormat
+			if props == nil || props.Priority == nil {
+				continue
+			}
+
+			if strings.EqualFold(string(props.Direction), direction) && int(*props.Priority) == priority {
+				return fmt.Errorf("the Security Rule %q uses the %s priority %d which is already used by the Security Rule %q, which is managed outside of this resource (for example by `azurerm_network_security_group`, `azurerm_network_security_rule` or `azurerm_network_security_rules`)", name, direction, priority, *rule.Name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -167,7 +169,52 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkSecurityRuleCustomizeDiff),
+	}
+}
+
+// resourceNetworkSecurityRuleCustomizeDiff surfaces priority conflicts with other rules in the Network Security
+// Group (for example those defined inline within `azurerm_network_security_group`) at plan time
+func resourceNetworkSecurityRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("priority") && !d.HasChange("direction") {
+		return nil
+	}
+
+	for _, key := range []string{"name", "resource_group_name", "network_security_group_name", "priority", "direction"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	name := d.Get("name").(string)
+	nsgName := d.Get("network_security_group_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	priority := d.Get("priority").(int)
+	direction := d.Get("direction").(string)
+
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	nsg, err := client.Get(ctx, resGroup, nsgName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving Network Security Group %q (Resource Group %q): %+v", nsgName, resGroup, err)
 	}
+
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			if rule.Name == nil || *rule.Name == name || rule.SecurityRulePropertiesFormat == nil || rule.Priority == nil {
+				continue
+			}
+
+			if strings.EqualFold(string(rule.Direction), direction) && int(*rule.Priority) == priority {
+				return fmt.Errorf("the %s priority %d is already used by the Security Rule %q within the Network Security Group %q (Resource Group %q)", direction, priority, *rule.Name, nsgName, resGroup)
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,307 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceNetworkSecurityRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkSecurityRulesCreateUpdate,
+		Read:   resourceNetworkSecurityRulesRead,
+		Update: resourceNetworkSecurityRulesCreateUpdate,
+		Delete: resourceNetworkSecurityRulesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, _, err := parseNetworkSecurityRulesImportId(id)
+			return err
+		}, resourceNetworkSecurityRulesImport),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"network_security_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityGroupID,
+			},
+
+			"security_rule": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     networkSecurityGroupRuleSchema(),
+				Set:      resourceNetworkSecurityGroupRuleHash,
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkSecurityRulesCustomizeDiff),
+	}
+}
+
+func resourceNetworkSecurityRulesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewNetworkSecurityRulesID(*nsgId, d.Get("name").(string))
+
+	rules, err := expandNetworkSecurityRules(d.Get("security_rule").(*pluginsdk.Set).List())
+	if err != nil {
+		return fmt.Errorf("expanding `security_rule`: %+v", err)
+	}
+
+	locks.ByName(nsgId.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(nsgId.Name, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, nsgId.ResourceGroup, nsgId.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *nsgId, err)
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *nsgId)
+	}
+
+	oldRaw, _ := d.GetChange("security_rule")
+	previouslyManaged := oldRaw.(*pluginsdk.Set).List()
+	if err := validateNetworkSecurityRulesDoNotConflict(nsg.SecurityRules, previouslyManaged, d.Get("security_rule").(*pluginsdk.Set).List()); err != nil {
+		return err
+	}
+
+	rules = append(rules, unmanagedNetworkSecurityRules(nsg.SecurityRules, previouslyManaged, rules)...)
+	nsg.SecurityGroupPropertiesFormat.SecurityRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, nsgId.ResourceGroup, nsgId.Name, nsg)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkSecurityRulesRead(d, meta)
+}
+
+func resourceNetworkSecurityRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nsg, err := client.Get(ctx, id.NetworkSecurityGroup.ResourceGroup, id.NetworkSecurityGroup.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id.NetworkSecurityGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkSecurityGroup, err)
+	}
+
+	rules := &[]network.SecurityRule{}
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil {
+		rules = props.SecurityRules
+		// when importing nothing is known about which rules are managed by this resource, so all are included
+		if d.Get("network_security_group_id").(string) != "" {
+			rules = managedNetworkSecurityRules(rules, d.Get("security_rule").(*pluginsdk.Set).List())
+		}
+	}
+
+	if rules == nil || len(*rules) == 0 {
+		log.Printf("[DEBUG] none of the rules for %s were found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.Name)
+	d.Set("network_security_group_id", id.NetworkSecurityGroup.ID())
+
+	if err := d.Set("security_rule", flattenNetworkSecurityRules(rules)); err != nil {
+		return fmt.Errorf("setting `security_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+// parseNetworkSecurityRulesImportId parses the ID used to import the Security Rules, which can optionally be suffixed
+// with a comma separated list of the names of the rules to import, e.g. `{networkSecurityGroupId}|{name}|{rule1},{rule2}`
+func parseNetworkSecurityRulesImportId(input string) (*parse.NetworkSecurityRulesId, []string, error) {
+	ruleNames := make([]string, 0)
+
+	segments := strings.Split(input, "|")
+	if len(segments) == 3 {
+		for _, v := range strings.Split(segments[2], ",") {
+			if v == "" {
+				return nil, nil, fmt.Errorf("the Security Rule names segment of %q contained an empty name", input)
+			}
+			ruleNames = append(ruleNames, v)
+		}
+		input = strings.Join(segments[:2], "|")
+	}
+
+	id, err := parse.NetworkSecurityRulesID(input)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return id, ruleNames, nil
+}
+
+func resourceNetworkSecurityRulesImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	id, ruleNames, err := parseNetworkSecurityRulesImportId(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	d.SetId(id.ID())
+
+	// without a list of rules nothing is known about which rules belong to this resource, so all are included
+	if len(ruleNames) == 0 {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	nsg, err := client.Get(ctx, id.NetworkSecurityGroup.ResourceGroup, id.NetworkSecurityGroup.Name, "")
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving %s: %+v", id.NetworkSecurityGroup, err)
+	}
+
+	existing := make(map[string]bool)
+	if props := nsg.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			if rule.Name != nil {
+				existing[*rule.Name] = true
+			}
+		}
+	}
+
+	managed := make([]interface{}, 0)
+	for _, name := range ruleNames {
+		if !existing[name] {
+			return []*pluginsdk.ResourceData{d}, fmt.Errorf("the Security Rule %q was not found within %s", name, id.NetworkSecurityGroup)
+		}
+		managed = append(managed, map[string]interface{}{
+			"name": name,
+		})
+	}
+
+	// only the rules which were specified are tracked, any others are left to the resources managing them
+	rules := managedNetworkSecurityRules(nsg.SecurityRules, managed)
+	d.Set("network_security_group_id", id.NetworkSecurityGroup.ID())
+	if err := d.Set("security_rule", flattenNetworkSecurityRules(rules)); err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("setting `security_rule`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func resourceNetworkSecurityRulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkSecurityRulesID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.NetworkSecurityGroup.Name, networkSecurityGroupResourceName)
+	defer locks.UnlockByName(id.NetworkSecurityGroup.Name, networkSecurityGroupResourceName)
+
+	nsg, err := client.Get(ctx, id.NetworkSecurityGroup.ResourceGroup, id.NetworkSecurityGroup.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id.NetworkSecurityGroup, err)
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		return nil
+	}
+
+	rules := unmanagedNetworkSecurityRules(nsg.SecurityRules, d.Get("security_rule").(*pluginsdk.Set).List(), nil)
+	nsg.SecurityGroupPropertiesFormat.SecurityRules = &rules
+
+	future, err := client.CreateOrUpdate(ctx, id.NetworkSecurityGroup.ResourceGroup, id.NetworkSecurityGroup.Name, nsg)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityRulesCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	rules := d.Get("security_rule").(*pluginsdk.Set).List()
+	if err := validateNetworkSecurityRulePriorities(rules); err != nil {
+		return err
+	}
+
+	// the Network Security Group may be created in the same apply, in which case there's nothing to conflict with
+	if !d.NewValueKnown("network_security_group_id") || !d.HasChange("security_rule") {
+		return nil
+	}
+
+	nsgId, err := parse.NetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Network.SecurityGroupClient
+	nsg, err := client.Get(ctx, nsgId.ResourceGroup, nsgId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(nsg.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *nsgId, err)
+	}
+	if nsg.SecurityGroupPropertiesFormat == nil {
+		return nil
+	}
+
+	oldRaw, _ := d.GetChange("security_rule")
+	return validateNetworkSecurityRulesDoNotConflict(nsg.SecurityRules, oldRaw.(*pluginsdk.Set).List(), rules)
+}
@@ -0,0 +1,328 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type NetworkSecurityRulesResource struct {
+}
+
+func TestAccNetworkSecurityRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rules", "test")
+	r := NetworkSecurityRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rules", "test")
+	r := NetworkSecurityRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_rule.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_rule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityRules_withInlineRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rules", "test")
+	r := NetworkSecurityRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withInlineRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_rule.#").HasValue("2"),
+				// the Network Security Group should ignore the rules managed by the other resource
+				check.That("azurerm_network_security_group.test").Key("security_rule.#").HasValue("1"),
+			),
+		},
+		{
+			// re-applying shouldn't remove the rules managed by the other resource
+			Config:   r.withInlineRules(data),
+			PlanOnly: true,
+		},
+		{
+			// importing with a list of rules shouldn't adopt the rules managed by the other resource
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateId:     fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/networkSecurityGroups/acctestnsg-%d|team-a|team-a-https,team-a-egress", os.Getenv("ARM_SUBSCRIPTION_ID"), data.RandomInteger, data.RandomInteger),
+		},
+	})
+}
+
+func TestAccNetworkSecurityRules_priorityConflict(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rules", "test")
+	r := NetworkSecurityRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.priorityConflict(data),
+			ExpectError: regexp.MustCompile("is already used by the Security Rule"),
+		},
+	})
+}
+
+func (t NetworkSecurityRulesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkSecurityRulesID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.SecurityGroupClient.Get(ctx, id.NetworkSecurityGroup.ResourceGroup, id.NetworkSecurityGroup.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id.NetworkSecurityGroup, err)
+	}
+
+	names := make(map[string]bool)
+	if props := resp.SecurityGroupPropertiesFormat; props != nil && props.SecurityRules != nil {
+		for _, rule := range *props.SecurityRules {
+			if rule.Name != nil {
+				names[*rule.Name] = true
+			}
+		}
+	}
+
+	for k, v := range state.Attributes {
+		if regexp.MustCompile(`^security_rule\.\d+\.name$`).MatchString(k) && !names[v] {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (NetworkSecurityRulesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkSecurityRulesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_rules" "test" {
+  name                      = "team-a"
+  network_security_group_id = azurerm_network_security_group.test.id
+
+  security_rule {
+    name                       = "team-a-https"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "team-a-egress"
+    priority                   = 200
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "VirtualNetwork"
+    destination_address_prefix = "Internet"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityRulesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_security_rules" "test" {
+  name                      = "team-a"
+  network_security_group_id = azurerm_network_security_group.test.id
+
+  security_rule {
+    name                       = "team-a-https"
+    description                = "Allow HTTPS from the Load Balancer"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "AzureLoadBalancer"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "team-a-ssh"
+    priority                   = 210
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_ranges    = ["22", "3389"]
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "team-a-egress"
+    priority                   = 200
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "VirtualNetwork"
+    destination_address_prefix = "Internet"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityRulesResource) withInlineRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  retain_unmanaged_security_rules = true
+
+  security_rule {
+    name                       = "platform-deny-all"
+    priority                   = 4000
+    direction                  = "Inbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_security_rules" "test" {
+  name                      = "team-a"
+  network_security_group_id = azurerm_network_security_group.test.id
+
+  security_rule {
+    name                       = "team-a-https"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "team-a-egress"
+    priority                   = 200
+    direction                  = "Outbound"
+    access                     = "Allow"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "VirtualNetwork"
+    destination_address_prefix = "Internet"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityRulesResource) priorityConflict(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_rules" "other" {
+  name                      = "team-b"
+  network_security_group_id = azurerm_network_security_group.test.id
+
+  security_rule {
+    name                       = "team-b-https"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "8443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, r.basic(data))
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApplicationGatewayBackendAddressPoolId struct {
+	SubscriptionId         string
+	ResourceGroup          string
+	ApplicationGatewayName string
+	BackendAddressPoolName string
+}
+
+func NewApplicationGatewayBackendAddressPoolID(subscriptionId, resourceGroup, applicationGatewayName, backendAddressPoolName string) ApplicationGatewayBackendAddressPoolId {
+	return ApplicationGatewayBackendAddressPoolId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroup:          resourceGroup,
+		ApplicationGatewayName: applicationGatewayName,
+		BackendAddressPoolName: backendAddressPoolName,
+	}
+}
+
+func (id ApplicationGatewayBackendAddressPoolId) String() string {
+	segments := []string{
+		fmt.Sprintf("Backend Address Pool Name %q", id.BackendAddressPoolName),
+		fmt.Sprintf("Application Gateway Name %q", id.ApplicationGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Gateway Backend Address Pool", segmentsStr)
+}
+
+func (id ApplicationGatewayBackendAddressPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s/backendAddressPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName, id.BackendAddressPoolName)
+}
+
+// ApplicationGatewayBackendAddressPoolID parses a ApplicationGatewayBackendAddressPool ID into an ApplicationGatewayBackendAddressPoolId struct
+func ApplicationGatewayBackendAddressPoolID(input string) (*ApplicationGatewayBackendAddressPoolId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationGatewayBackendAddressPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
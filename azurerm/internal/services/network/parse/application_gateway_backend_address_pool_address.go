@@ -0,0 +1,47 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ApplicationGatewayBackendAddressPoolAddressId struct {
+	BackendAddressPool ApplicationGatewayBackendAddressPoolId
+	Address            string
+}
+
+func NewApplicationGatewayBackendAddressPoolAddressID(backendAddressPool ApplicationGatewayBackendAddressPoolId, address string) ApplicationGatewayBackendAddressPoolAddressId {
+	return ApplicationGatewayBackendAddressPoolAddressId{
+		BackendAddressPool: backendAddressPool,
+		Address:            address,
+	}
+}
+
+func (id ApplicationGatewayBackendAddressPoolAddressId) String() string {
+	return fmt.Sprintf("Address %q within %s", id.Address, id.BackendAddressPool)
+}
+
+func (id ApplicationGatewayBackendAddressPoolAddressId) ID() string {
+	return fmt.Sprintf("%s|%s", id.BackendAddressPool.ID(), id.Address)
+}
+
+func ApplicationGatewayBackendAddressPoolAddressID(input string) (*ApplicationGatewayBackendAddressPoolAddressId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected an ID in the format `{backendAddressPoolID}|{address} but got %q", input)
+	}
+
+	backendAddressPoolId, err := ApplicationGatewayBackendAddressPoolID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Backend Address Pool ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("the Address segment of %q was empty", input)
+	}
+
+	return &ApplicationGatewayBackendAddressPoolAddressId{
+		BackendAddressPool: *backendAddressPoolId,
+		Address:            segments[1],
+	}, nil
+}
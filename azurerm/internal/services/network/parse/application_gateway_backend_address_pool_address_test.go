@@ -0,0 +1,100 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestApplicationGatewayBackendAddressPoolAddressID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *ApplicationGatewayBackendAddressPoolAddressId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Backend Address Pool ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Address",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1|",
+			Error: true,
+		},
+		{
+			Name:  "Application Gateway ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1|10.0.0.4",
+			Error: true,
+		},
+		{
+			Name:  "IP Address",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1|10.0.0.4",
+			Expect: &ApplicationGatewayBackendAddressPoolAddressId{
+				BackendAddressPool: ApplicationGatewayBackendAddressPoolId{
+					SubscriptionId:         "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:          "group1",
+					ApplicationGatewayName: "gateway1",
+					BackendAddressPoolName: "pool1",
+				},
+				Address: "10.0.0.4",
+			},
+		},
+		{
+			Name:  "FQDN",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1/backendAddressPools/pool1|www.example.com",
+			Expect: &ApplicationGatewayBackendAddressPoolAddressId{
+				BackendAddressPool: ApplicationGatewayBackendAddressPoolId{
+					SubscriptionId:         "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:          "group1",
+					ApplicationGatewayName: "gateway1",
+					BackendAddressPoolName: "pool1",
+				},
+				Address: "www.example.com",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ApplicationGatewayBackendAddressPoolAddressID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.BackendAddressPool != v.Expect.BackendAddressPool {
+			t.Fatalf("Expected %+v but got %+v for Backend Address Pool", v.Expect.BackendAddressPool, actual.BackendAddressPool)
+		}
+
+		if actual.Address != v.Expect.Address {
+			t.Fatalf("Expected %q but got %q for Address", v.Expect.Address, actual.Address)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NetworkSecurityRulesId struct {
+	NetworkSecurityGroup NetworkSecurityGroupId
+	Name                 string
+}
+
+func NewNetworkSecurityRulesID(networkSecurityGroup NetworkSecurityGroupId, name string) NetworkSecurityRulesId {
+	return NetworkSecurityRulesId{
+		NetworkSecurityGroup: networkSecurityGroup,
+		Name:                 name,
+	}
+}
+
+func (id NetworkSecurityRulesId) String() string {
+	return fmt.Sprintf("Security Rules %q within %s", id.Name, id.NetworkSecurityGroup)
+}
+
+func (id NetworkSecurityRulesId) ID() string {
+	return fmt.Sprintf("%s|%s", id.NetworkSecurityGroup.ID(), id.Name)
+}
+
+func NetworkSecurityRulesID(input string) (*NetworkSecurityRulesId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Expected an ID in the format `{networkSecurityGroupID}|{name} but got %q", input)
+	}
+
+	networkSecurityGroupId, err := NetworkSecurityGroupID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Network Security Group ID %q: %+v", segments[0], err)
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("the Name segment of %q was empty", input)
+	}
+
+	return &NetworkSecurityRulesId{
+		NetworkSecurityGroup: *networkSecurityGroupId,
+		Name:                 segments[1],
+	}, nil
+}
@@ -0,0 +1,86 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestNetworkSecurityRulesID(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  string
+		Error  bool
+		Expect *NetworkSecurityRulesId
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "One Segment",
+			Input: "hello",
+			Error: true,
+		},
+		{
+			Name:  "Two Segments Invalid ID's",
+			Input: "hello|world",
+			Error: true,
+		},
+		{
+			Name:  "Network Security Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1|",
+			Error: true,
+		},
+		{
+			Name:  "Security Rule ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1/securityRules/rule1|team-a",
+			Error: true,
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/nsg1|team-a",
+			Expect: &NetworkSecurityRulesId{
+				NetworkSecurityGroup: NetworkSecurityGroupId{
+					SubscriptionId: "00000000-0000-0000-0000-000000000000",
+					ResourceGroup:  "group1",
+					Name:           "nsg1",
+				},
+				Name: "team-a",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := NetworkSecurityRulesID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.NetworkSecurityGroup != v.Expect.NetworkSecurityGroup {
+			t.Fatalf("Expected %+v but got %+v for Network Security Group", v.Expect.NetworkSecurityGroup, actual.NetworkSecurityGroup)
+		}
+
+		if actual.Name != v.Expect.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expect.Name, actual.Name)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
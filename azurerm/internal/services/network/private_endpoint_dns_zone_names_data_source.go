@@ -0,0 +1,127 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func dataSourcePrivateEndpointDnsZoneNames() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateEndpointDnsZoneNamesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"subresource_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.PrivateLinkSubResourceName,
+			},
+
+			"resource_type": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"private_connection_resource_id"},
+			},
+
+			"private_connection_resource_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  azure.ValidateResourceID,
+				ConflictsWith: []string{"resource_type"},
+			},
+
+			"environment": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"private_dns_zone_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"mapping": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"resource_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"subresource_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"private_dns_zone_names": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateEndpointDnsZoneNamesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	env := meta.(*clients.Client).Account.Environment
+
+	resourceType := d.Get("resource_type").(string)
+	if v := d.Get("private_connection_resource_id").(string); v != "" {
+		resourceType = privateLinkResourceType(v)
+	}
+	subresourceName := d.Get("subresource_name").(string)
+
+	mappings := privateEndpointDnsZoneMappingsForEnvironment(env, resourceType, subresourceName)
+
+	// when a Subresource is specified it must resolve to a single set of Private DNS Zones
+	privateDnsZoneNames := uniquePrivateDnsZoneNames(env, mappings)
+	if subresourceName != "" {
+		names, err := privateDnsZoneNamesForSubresource(env, resourceType, subresourceName)
+		if err != nil {
+			return err
+		}
+		privateDnsZoneNames = names
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("environment", env.Name)
+	d.Set("private_dns_zone_names", privateDnsZoneNames)
+
+	if err := d.Set("mapping", flattenPrivateEndpointDnsZoneMappings(env, mappings)); err != nil {
+		return fmt.Errorf("setting `mapping`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenPrivateEndpointDnsZoneMappings(env autorestAzure.Environment, input []privateEndpointDnsZoneMapping) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range input {
+		output = append(output, map[string]interface{}{
+			"resource_type":          v.ResourceType,
+			"subresource_name":       v.SubresourceName,
+			"private_dns_zone_names": v.ZoneNames[env.Name],
+		})
+	}
+	return output
+}
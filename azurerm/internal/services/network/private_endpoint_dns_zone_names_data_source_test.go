@@ -0,0 +1,66 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type PrivateEndpointDnsZoneNamesDataSource struct {
+}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.all(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("environment").HasValue("AzurePublicCloud"),
+				check.That(data.ResourceName).Key("mapping.#").Exists(),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePrivateEndpointDnsZoneNames_subresource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_endpoint_dns_zone_names", "test")
+	r := PrivateEndpointDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.subresource(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.0").HasValue("privatelink.documents.azure.com"),
+				check.That(data.ResourceName).Key("mapping.0.resource_type").HasValue("Microsoft.DocumentDB/databaseAccounts"),
+			),
+		},
+	})
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) all() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {}
+`
+}
+
+func (PrivateEndpointDnsZoneNamesDataSource) subresource() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_endpoint_dns_zone_names" "test" {
+  resource_type    = "Microsoft.DocumentDB/databaseAccounts"
+  subresource_name = "Sql"
+}
+`
+}
@@ -0,0 +1,428 @@
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+)
+
+// privateEndpointDnsZoneMapping is the Private DNS Zone(s) which should be used for a Private Endpoint connected
+// to the Subresource of a given Resource Type
+type privateEndpointDnsZoneMapping struct {
+	ResourceType    string
+	SubresourceName string
+
+	// ZoneNames are the names of the Private DNS Zones, keyed by the name of the Azure Environment
+	ZoneNames map[string][]string
+}
+
+// privateDnsZoneNamesPerEnvironment returns the Private DNS Zone names for a Subresource which uses a single zone,
+// an empty name means the Subresource isn't available in that Environment
+func privateDnsZoneNamesPerEnvironment(public, china, usGovernment string) map[string][]string {
+	output := make(map[string][]string)
+	if public != "" {
+		output[azure.PublicCloud.Name] = []string{public}
+	}
+	if china != "" {
+		output[azure.ChinaCloud.Name] = []string{china}
+	}
+	if usGovernment != "" {
+		output[azure.USGovernmentCloud.Name] = []string{usGovernment}
+	}
+	return output
+}
+
+// privateEndpointDnsZoneMappings is taken from https://docs.microsoft.com/azure/private-link/private-endpoint-dns
+// Services whose zone names contain the region (e.g. Kubernetes Service, Batch and Backup) are intentionally omitted.
+var privateEndpointDnsZoneMappings = []privateEndpointDnsZoneMapping{
+	{
+		ResourceType:    "Microsoft.AppConfiguration/configurationStores",
+		SubresourceName: "configurationStores",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azconfig.io", "privatelink.azconfig.azure.cn", "privatelink.azconfig.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Automation/automationAccounts",
+		SubresourceName: "DSCAndHybridWorker",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azure-automation.net", "privatelink.azure-automation.cn", "privatelink.azure-automation.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Automation/automationAccounts",
+		SubresourceName: "Webhook",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azure-automation.net", "privatelink.azure-automation.cn", "privatelink.azure-automation.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Cache/Redis",
+		SubresourceName: "redisCache",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.redis.cache.windows.net", "privatelink.redis.cache.chinacloudapi.cn", "privatelink.redis.cache.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.CognitiveServices/accounts",
+		SubresourceName: "account",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.cognitiveservices.azure.com", "privatelink.cognitiveservices.azure.cn", "privatelink.cognitiveservices.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Compute/diskAccesses",
+		SubresourceName: "disks",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.blob.core.windows.net", "privatelink.blob.core.chinacloudapi.cn", "privatelink.blob.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.ContainerRegistry/registries",
+		SubresourceName: "registry",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azurecr.io", "privatelink.azurecr.cn", "privatelink.azurecr.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DBforMariaDB/servers",
+		SubresourceName: "mariadbServer",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.mariadb.database.azure.com", "privatelink.mariadb.database.chinacloudapi.cn", "privatelink.mariadb.database.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.DBforMySQL/servers",
+		SubresourceName: "mysqlServer",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.mysql.database.azure.com", "privatelink.mysql.database.chinacloudapi.cn", "privatelink.mysql.database.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.DBforPostgreSQL/servers",
+		SubresourceName: "postgresqlServer",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.postgres.database.azure.com", "privatelink.postgres.database.chinacloudapi.cn", "privatelink.postgres.database.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.DataFactory/factories",
+		SubresourceName: "dataFactory",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.datafactory.azure.net", "privatelink.datafactory.azure.cn", "privatelink.datafactory.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DataFactory/factories",
+		SubresourceName: "portal",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.adf.azure.com", "privatelink.adf.azure.cn", "privatelink.adf.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Databricks/workspaces",
+		SubresourceName: "browser_authentication",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azuredatabricks.net", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.Databricks/workspaces",
+		SubresourceName: "databricks_ui_api",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azuredatabricks.net", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.Devices/IotHubs",
+		SubresourceName: "iotHub",
+		ZoneNames: map[string][]string{
+			azure.PublicCloud.Name:       {"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+			azure.ChinaCloud.Name:        {"privatelink.azure-devices.cn", "privatelink.servicebus.chinacloudapi.cn"},
+			azure.USGovernmentCloud.Name: {"privatelink.azure-devices.us", "privatelink.servicebus.usgovcloudapi.net"},
+		},
+	},
+	{
+		ResourceType:    "Microsoft.DigitalTwins/digitalTwinsInstances",
+		SubresourceName: "API",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.digitaltwins.azure.net", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		SubresourceName: "Cassandra",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.cassandra.cosmos.azure.com", "privatelink.cassandra.cosmos.azure.cn", "privatelink.cassandra.cosmos.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		SubresourceName: "Gremlin",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.gremlin.cosmos.azure.com", "privatelink.gremlin.cosmos.azure.cn", "privatelink.gremlin.cosmos.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		SubresourceName: "MongoDB",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.mongo.cosmos.azure.com", "privatelink.mongo.cosmos.azure.cn", "privatelink.mongo.cosmos.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		SubresourceName: "Sql",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.documents.azure.com", "privatelink.documents.azure.cn", "privatelink.documents.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+		SubresourceName: "Table",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.table.cosmos.azure.com", "privatelink.table.cosmos.azure.cn", "privatelink.table.cosmos.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.EventGrid/domains",
+		SubresourceName: "domain",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.eventgrid.azure.net", "privatelink.eventgrid.azure.cn", "privatelink.eventgrid.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.EventGrid/topics",
+		SubresourceName: "topic",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.eventgrid.azure.net", "privatelink.eventgrid.azure.cn", "privatelink.eventgrid.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.EventHub/namespaces",
+		SubresourceName: "namespace",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.servicebus.windows.net", "privatelink.servicebus.chinacloudapi.cn", "privatelink.servicebus.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Insights/privateLinkScopes",
+		SubresourceName: "azuremonitor",
+		ZoneNames: map[string][]string{
+			azure.PublicCloud.Name: {
+				"privatelink.monitor.azure.com",
+				"privatelink.oms.opinsights.azure.com",
+				"privatelink.ods.opinsights.azure.com",
+				"privatelink.agentsvc.azure-automation.net",
+				"privatelink.blob.core.windows.net",
+			},
+			azure.ChinaCloud.Name: {
+				"privatelink.monitor.azure.cn",
+				"privatelink.oms.opinsights.azure.cn",
+				"privatelink.ods.opinsights.azure.cn",
+				"privatelink.agentsvc.azure-automation.cn",
+				"privatelink.blob.core.chinacloudapi.cn",
+			},
+			azure.USGovernmentCloud.Name: {
+				"privatelink.monitor.azure.us",
+				"privatelink.oms.opinsights.azure.us",
+				"privatelink.ods.opinsights.azure.us",
+				"privatelink.agentsvc.azure-automation.us",
+				"privatelink.blob.core.usgovcloudapi.net",
+			},
+		},
+	},
+	{
+		ResourceType:    "Microsoft.KeyVault/managedHSMs",
+		SubresourceName: "managedhsm",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.managedhsm.azure.net", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.KeyVault/vaults",
+		SubresourceName: "vault",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.vaultcore.azure.net", "privatelink.vaultcore.azure.cn", "privatelink.vaultcore.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.MachineLearningServices/workspaces",
+		SubresourceName: "amlworkspace",
+		ZoneNames: map[string][]string{
+			azure.PublicCloud.Name:       {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+			azure.ChinaCloud.Name:        {"privatelink.api.ml.azure.cn", "privatelink.notebooks.chinacloudapi.cn"},
+			azure.USGovernmentCloud.Name: {"privatelink.api.ml.azure.us", "privatelink.notebooks.usgovcloudapi.net"},
+		},
+	},
+	{
+		ResourceType:    "Microsoft.Purview/accounts",
+		SubresourceName: "account",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.purview.azure.com", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.Purview/accounts",
+		SubresourceName: "portal",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.purviewstudio.azure.com", "", ""),
+	},
+	{
+		ResourceType:    "Microsoft.RecoveryServices/vaults",
+		SubresourceName: "AzureSiteRecovery",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.siterecovery.windowsazure.com", "privatelink.siterecovery.windowsazure.cn", "privatelink.siterecovery.windowsazure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Relay/namespaces",
+		SubresourceName: "namespace",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.servicebus.windows.net", "privatelink.servicebus.chinacloudapi.cn", "privatelink.servicebus.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Search/searchServices",
+		SubresourceName: "searchService",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.search.windows.net", "privatelink.search.azure.cn", "privatelink.search.windows.us"),
+	},
+	{
+		ResourceType:    "Microsoft.ServiceBus/namespaces",
+		SubresourceName: "namespace",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.servicebus.windows.net", "privatelink.servicebus.chinacloudapi.cn", "privatelink.servicebus.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.SignalRService/SignalR",
+		SubresourceName: "signalr",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.service.signalr.net", "privatelink.signalr.azure.cn", "privatelink.signalr.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Sql/servers",
+		SubresourceName: "sqlServer",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.database.windows.net", "privatelink.database.chinacloudapi.cn", "privatelink.database.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "blob",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.blob.core.windows.net", "privatelink.blob.core.chinacloudapi.cn", "privatelink.blob.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "blob_secondary",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.blob.core.windows.net", "privatelink.blob.core.chinacloudapi.cn", "privatelink.blob.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "dfs",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.dfs.core.windows.net", "privatelink.dfs.core.chinacloudapi.cn", "privatelink.dfs.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "dfs_secondary",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.dfs.core.windows.net", "privatelink.dfs.core.chinacloudapi.cn", "privatelink.dfs.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "file",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.file.core.windows.net", "privatelink.file.core.chinacloudapi.cn", "privatelink.file.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "queue",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.queue.core.windows.net", "privatelink.queue.core.chinacloudapi.cn", "privatelink.queue.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "queue_secondary",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.queue.core.windows.net", "privatelink.queue.core.chinacloudapi.cn", "privatelink.queue.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "table",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.table.core.windows.net", "privatelink.table.core.chinacloudapi.cn", "privatelink.table.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "table_secondary",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.table.core.windows.net", "privatelink.table.core.chinacloudapi.cn", "privatelink.table.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "web",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.web.core.windows.net", "privatelink.web.core.chinacloudapi.cn", "privatelink.web.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Storage/storageAccounts",
+		SubresourceName: "web_secondary",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.web.core.windows.net", "privatelink.web.core.chinacloudapi.cn", "privatelink.web.core.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.StorageSync/storageSyncServices",
+		SubresourceName: "afs",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.afs.azure.net", "privatelink.afs.azure.cn", "privatelink.afs.azure.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Synapse/privateLinkHubs",
+		SubresourceName: "Web",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azuresynapse.net", "privatelink.azuresynapse.azure.cn", "privatelink.azuresynapse.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Synapse/workspaces",
+		SubresourceName: "Dev",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.dev.azuresynapse.net", "privatelink.dev.azuresynapse.azure.cn", "privatelink.dev.azuresynapse.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Synapse/workspaces",
+		SubresourceName: "Sql",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.sql.azuresynapse.net", "privatelink.sql.azuresynapse.azure.cn", "privatelink.sql.azuresynapse.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Synapse/workspaces",
+		SubresourceName: "SqlOnDemand",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.sql.azuresynapse.net", "privatelink.sql.azuresynapse.azure.cn", "privatelink.sql.azuresynapse.usgovcloudapi.net"),
+	},
+	{
+		ResourceType:    "Microsoft.Web/sites",
+		SubresourceName: "sites",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azurewebsites.net", "privatelink.chinacloudsites.cn", "privatelink.azurewebsites.us"),
+	},
+	{
+		ResourceType:    "Microsoft.Web/staticSites",
+		SubresourceName: "staticSites",
+		ZoneNames:       privateDnsZoneNamesPerEnvironment("privatelink.azurestaticapps.net", "", ""),
+	},
+}
+
+// privateEndpointDnsZoneMappingsForEnvironment returns the mappings which are available in the Azure Environment,
+// optionally filtered to a Resource Type and/or Subresource name (both compared case-insensitively)
+func privateEndpointDnsZoneMappingsForEnvironment(env azure.Environment, resourceType, subresourceName string) []privateEndpointDnsZoneMapping {
+	output := make([]privateEndpointDnsZoneMapping, 0)
+	for _, mapping := range privateEndpointDnsZoneMappings {
+		if _, ok := mapping.ZoneNames[env.Name]; !ok {
+			continue
+		}
+		if resourceType != "" && !strings.EqualFold(mapping.ResourceType, resourceType) {
+			continue
+		}
+		if subresourceName != "" && !strings.EqualFold(mapping.SubresourceName, subresourceName) {
+			continue
+		}
+
+		output = append(output, mapping)
+	}
+	return output
+}
+
+// privateDnsZoneNamesForSubresource returns the Private DNS Zone names which should be used for a Private Endpoint
+// connected to the Subresource. The Resource Type can be omitted, providing the Subresource name is unambiguous.
+func privateDnsZoneNamesForSubresource(env azure.Environment, resourceType, subresourceName string) ([]string, error) {
+	mappings := privateEndpointDnsZoneMappingsForEnvironment(env, resourceType, subresourceName)
+	if len(mappings) == 0 {
+		if resourceType == "" {
+			return nil, fmt.Errorf("no Private DNS Zone is known for the Subresource %q in the %q environment", subresourceName, env.Name)
+		}
+
+		return nil, fmt.Errorf("no Private DNS Zone is known for the Subresource %q of %q in the %q environment", subresourceName, resourceType, env.Name)
+	}
+
+	zoneNames := mappings[0].ZoneNames[env.Name]
+	for _, mapping := range mappings[1:] {
+		if strings.Join(mapping.ZoneNames[env.Name], ",") != strings.Join(zoneNames, ",") {
+			return nil, fmt.Errorf("the Subresource %q is used by multiple Resource Types with different Private DNS Zones - a Resource Type must be specified", subresourceName)
+		}
+	}
+
+	return append([]string{}, zoneNames...), nil
+}
+
+// uniquePrivateDnsZoneNames returns the distinct Private DNS Zone names used by the mappings, sorted by name
+func uniquePrivateDnsZoneNames(env azure.Environment, mappings []privateEndpointDnsZoneMapping) []string {
+	seen := make(map[string]struct{})
+	output := make([]string, 0)
+	for _, mapping := range mappings {
+		for _, name := range mapping.ZoneNames[env.Name] {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			output = append(output, name)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// privateLinkResourceType returns the Resource Type (e.g. `Microsoft.Storage/storageAccounts`) of the Resource ID
+// that the Private Endpoint is connected to, or an empty string if it can't be determined (e.g. for an Alias)
+func privateLinkResourceType(input string) string {
+	segments := strings.Split(input, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") && i+2 < len(segments) {
+			return fmt.Sprintf("%s/%s", segments[i+1], segments[i+2])
+		}
+	}
+	return ""
+}
+
+// privateEndpointVirtualNetworkLinkName returns the name of the Virtual Network Link used to link an automatically managed
+// Private DNS Zone to the Virtual Network. This is the name of the Virtual Network suffixed with a hash of its (case-insensitive)
+// ID, since Virtual Networks in other Resource Groups or Subscriptions can share the same name - and is at most 80 characters.
+func privateEndpointVirtualNetworkLinkName(id parse.VirtualNetworkId) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(id.ID())))
+
+	name := id.Name
+	if len(name) > 63 {
+		name = name[:63]
+	}
+
+	return fmt.Sprintf("%s-%s", name, hex.EncodeToString(hash[:])[:16])
+}
@@ -0,0 +1,211 @@
+package network
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+)
+
+func TestPrivateDnsZoneNamesForSubresource(t *testing.T) {
+	testData := []struct {
+		Name            string
+		Environment     azure.Environment
+		ResourceType    string
+		SubresourceName string
+		Expected        []string
+		Error           bool
+	}{
+		{
+			Name:            "Storage Blob",
+			Environment:     azure.PublicCloud,
+			ResourceType:    "Microsoft.Storage/storageAccounts",
+			SubresourceName: "blob",
+			Expected:        []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name:            "Storage Blob in China",
+			Environment:     azure.ChinaCloud,
+			ResourceType:    "Microsoft.Storage/storageAccounts",
+			SubresourceName: "blob",
+			Expected:        []string{"privatelink.blob.core.chinacloudapi.cn"},
+		},
+		{
+			Name:            "Key Vault in US Government",
+			Environment:     azure.USGovernmentCloud,
+			ResourceType:    "Microsoft.KeyVault/vaults",
+			SubresourceName: "vault",
+			Expected:        []string{"privatelink.vaultcore.usgovcloudapi.net"},
+		},
+		{
+			Name:            "Different Casing",
+			Environment:     azure.PublicCloud,
+			ResourceType:    "microsoft.sql/SERVERS",
+			SubresourceName: "SQLSERVER",
+			Expected:        []string{"privatelink.database.windows.net"},
+		},
+		{
+			Name:            "Unambiguous Without Resource Type",
+			Environment:     azure.PublicCloud,
+			SubresourceName: "registry",
+			Expected:        []string{"privatelink.azurecr.io"},
+		},
+		{
+			Name:            "Same Zone For Multiple Resource Types",
+			Environment:     azure.PublicCloud,
+			SubresourceName: "namespace",
+			Expected:        []string{"privatelink.servicebus.windows.net"},
+		},
+		{
+			Name:            "Ambiguous Without Resource Type",
+			Environment:     azure.PublicCloud,
+			SubresourceName: "Sql",
+			Error:           true,
+		},
+		{
+			Name:            "Ambiguous Resolved By Resource Type",
+			Environment:     azure.PublicCloud,
+			ResourceType:    "Microsoft.DocumentDB/databaseAccounts",
+			SubresourceName: "Sql",
+			Expected:        []string{"privatelink.documents.azure.com"},
+		},
+		{
+			Name:            "Multiple Zones",
+			Environment:     azure.PublicCloud,
+			ResourceType:    "Microsoft.MachineLearningServices/workspaces",
+			SubresourceName: "amlworkspace",
+			Expected:        []string{"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+		},
+		{
+			Name:            "Not Available In Environment",
+			Environment:     azure.ChinaCloud,
+			ResourceType:    "Microsoft.Web/staticSites",
+			SubresourceName: "staticSites",
+			Error:           true,
+		},
+		{
+			Name:            "Unknown Environment",
+			Environment:     azure.GermanCloud,
+			ResourceType:    "Microsoft.Storage/storageAccounts",
+			SubresourceName: "blob",
+			Error:           true,
+		},
+		{
+			Name:            "Unknown Subresource",
+			Environment:     azure.PublicCloud,
+			ResourceType:    "Microsoft.Storage/storageAccounts",
+			SubresourceName: "vault",
+			Error:           true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := privateDnsZoneNamesForSubresource(v.Environment, v.ResourceType, v.SubresourceName)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointDnsZoneMappings(t *testing.T) {
+	seen := make(map[string]struct{})
+	for _, mapping := range privateEndpointDnsZoneMappings {
+		key := strings.ToLower(mapping.ResourceType + "/" + mapping.SubresourceName)
+		if _, ok := seen[key]; ok {
+			t.Fatalf("duplicate mapping for %q / %q", mapping.ResourceType, mapping.SubresourceName)
+		}
+		seen[key] = struct{}{}
+
+		if len(mapping.ZoneNames) == 0 {
+			t.Fatalf("no Private DNS Zones are defined for %q / %q", mapping.ResourceType, mapping.SubresourceName)
+		}
+
+		for env, names := range mapping.ZoneNames {
+			for _, name := range names {
+				if !strings.HasPrefix(name, "privatelink.") {
+					t.Fatalf("expected the Private DNS Zone %q for %q / %q in %q to start with `privatelink.`", name, mapping.ResourceType, mapping.SubresourceName, env)
+				}
+			}
+		}
+	}
+}
+
+func TestPrivateLinkResourceType(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: "Microsoft.Storage/storageAccounts",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Web/sites/site1/slots/slot1",
+			Expected: "Microsoft.Web/sites",
+		},
+		{
+			Input:    "service1.00000000-0000-0000-0000-000000000000.westeurope.azure.privatelinkservice",
+			Expected: "",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := privateLinkResourceType(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateEndpointVirtualNetworkLinkName(t *testing.T) {
+	vnet := parse.NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "group1", "network1")
+	sameNameOtherGroup := parse.NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "group2", "network1")
+	sameNameOtherSubscription := parse.NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "group1", "network1")
+	differentCasing := parse.NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "GROUP1", "network1")
+	longName := parse.NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "group1", strings.Repeat("n", 64))
+
+	name := privateEndpointVirtualNetworkLinkName(vnet)
+	if !strings.HasPrefix(name, "network1-") || len(name) != len("network1-")+16 {
+		t.Fatalf("expected the name to be the Virtual Network name suffixed with a hash but got %q", name)
+	}
+
+	if name != privateEndpointVirtualNetworkLinkName(vnet) {
+		t.Fatalf("expected the name to be deterministic")
+	}
+
+	if name != privateEndpointVirtualNetworkLinkName(differentCasing) {
+		t.Fatalf("expected the name to be case-insensitive")
+	}
+
+	for _, other := range []parse.VirtualNetworkId{sameNameOtherGroup, sameNameOtherSubscription} {
+		if otherName := privateEndpointVirtualNetworkLinkName(other); otherName == name {
+			t.Fatalf("expected %q to have a different name to %q but both were %q", other.ID(), vnet.ID(), name)
+		}
+	}
+
+	if actual := privateEndpointVirtualNetworkLinkName(longName); len(actual) != 80 {
+		t.Fatalf("expected the name to be truncated to 80 characters but got %d (%q)", len(actual), actual)
+	}
+}
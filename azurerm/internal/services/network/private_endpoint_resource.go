@@ -8,16 +8,19 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	mariaDBParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mariadb/parse"
 	mysqlParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
 	postgresqlParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres/parse"
+	privateDnsClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/client"
 	privateDnsParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/parse"
 	privateDnsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -80,13 +83,39 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 							ValidateFunc: validate.PrivateLinkName,
 						},
 						"private_dns_zone_ids": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							Computed:     true,
+							ExactlyOneOf: []string{"private_dns_zone_group.0.private_dns_zone_ids", "private_dns_zone_group.0.automatic_private_dns_zone"},
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
 								ValidateFunc: privateDnsValidate.PrivateDnsZoneID,
 							},
 						},
+						"automatic_private_dns_zone": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"private_dns_zone_group.0.private_dns_zone_ids", "private_dns_zone_group.0.automatic_private_dns_zone"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"resource_group_name": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: azure.ValidateResourceGroupName,
+									},
+
+									"virtual_network_ids": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validate.VirtualNetworkID,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -164,6 +193,24 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			// the Private DNS Zones and Virtual Network Links created by `automatic_private_dns_zone`, which are
+			// removed when they're no longer used by this (or another) Private Endpoint
+			"automatic_private_dns_zone_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"automatic_private_dns_zone_virtual_network_link_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"private_dns_zone_configs": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -276,6 +323,17 @@ func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(id.ID())
 
+	// the ID is set first so that any Private DNS Zones / Virtual Network Links which get created are tracked in the
+	// state (and as such removed when the Private Endpoint is deleted) even if a later step fails
+	automaticResources := privateEndpointAutomaticPrivateDnsResources{}
+	privateDnsZoneGroup, err = ensureAutomaticPrivateDnsZonesForPrivateEndpoint(ctx, meta.(*clients.Client), privateDnsZoneGroup, privateServiceConnections, &automaticResources)
+	if setErr := setPrivateEndpointAutomaticPrivateDnsResources(d, automaticResources); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		return fmt.Errorf("configuring the automatic Private DNS Zones for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	// 1 Private Endpoint can have 1 Private DNS Zone Group
 	// since this is a new resource, there shouldn't be an existing one - so there's no need to delete it
 	if len(privateDnsZoneGroup) > 0 {
@@ -336,6 +394,15 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 
 	// 1 Private Endpoint can have 1 Private DNS Zone Group - so to update we need to Delete & Recreate
 	if d.HasChange("private_dns_zone_group") {
+		automaticResources := expandPrivateEndpointAutomaticPrivateDnsResources(d)
+		privateDnsZoneGroup, err = ensureAutomaticPrivateDnsZonesForPrivateEndpoint(ctx, meta.(*clients.Client), privateDnsZoneGroup, privateServiceConnections, &automaticResources)
+		if setErr := setPrivateEndpointAutomaticPrivateDnsResources(d, automaticResources); setErr != nil {
+			return setErr
+		}
+		if err != nil {
+			return fmt.Errorf("configuring the automatic Private DNS Zones for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
 		existingDnsZoneGroups, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, dnsClient, *id)
 		if err != nil {
 			return err
@@ -377,6 +444,16 @@ func resourcePrivateEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 			}
 			log.Printf("[DEBUG] Created the Existing Private DNS Zone Group associated with Private Endpoint %q / Resource Group %q.", id.Name, id.ResourceGroup)
 		}
+
+		// remove any of the automatically created Private DNS Zones / Virtual Network Links which are no longer used
+		usedZoneIds, usedVirtualNetworkIds := privateEndpointAutomaticPrivateDnsZoneUsage(privateDnsZoneGroup)
+		automaticResources, err = deleteUnusedPrivateEndpointAutomaticPrivateDnsResources(ctx, meta.(*clients.Client).PrivateDns, automaticResources, usedZoneIds, usedVirtualNetworkIds)
+		if setErr := setPrivateEndpointAutomaticPrivateDnsResources(d, automaticResources); setErr != nil {
+			return setErr
+		}
+		if err != nil {
+			return fmt.Errorf("removing the unused automatic Private DNS Zones for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
 	}
 
 	return resourcePrivateEndpointRead(d, meta)
@@ -437,6 +514,12 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("subnet_id", subnetId)
 	}
 
+	// the `automatic_private_dns_zone` block isn't returned by the API, so this is retained from the config/state
+	automaticPrivateDnsZone := make([]interface{}, 0)
+	if v := d.Get("private_dns_zone_group").([]interface{}); len(v) > 0 && v[0] != nil {
+		automaticPrivateDnsZone = v[0].(map[string]interface{})["automatic_private_dns_zone"].([]interface{})
+	}
+
 	privateDnsZoneConfigs := make([]interface{}, 0)
 	privateDnsZoneGroups := make([]interface{}, 0)
 	if privateDnsZoneIds != nil {
@@ -451,6 +534,7 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 				continue
 			}
 
+			flattened.DnsZoneGroup["automatic_private_dns_zone"] = automaticPrivateDnsZone
 			privateDnsZoneConfigs = append(privateDnsZoneConfigs, flattened.DnsZoneConfig...)
 			privateDnsZoneGroups = append(privateDnsZoneGroups, flattened.DnsZoneGroup)
 		}
@@ -498,6 +582,11 @@ func resourcePrivateEndpointDelete(d *pluginsdk.ResourceData, meta interface{})
 	}
 	log.Printf("[DEBUG] Deleted the Private Endpoint %q / Resource Group %q.", id.Name, id.ResourceGroup)
 
+	automaticResources := expandPrivateEndpointAutomaticPrivateDnsResources(d)
+	if _, err := deleteUnusedPrivateEndpointAutomaticPrivateDnsResources(ctx, meta.(*clients.Client).PrivateDns, automaticResources, []string{}, []string{}); err != nil {
+		return fmt.Errorf("removing the automatic Private DNS Zones for Private Endpoint %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
 	return nil
 }
 
@@ -710,6 +799,365 @@ func createPrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *ne
 	return nil
 }
 
+// ensureAutomaticPrivateDnsZonesForPrivateEndpoint creates (when they don't exist) the Private DNS Zones used by the Subresources
+// of the Private Endpoint and links them to the specified Virtual Networks, returning the Private DNS Zone Group with the
+// `private_dns_zone_ids` populated. The Private DNS Zone Group is returned as-is when `automatic_private_dns_zone` isn't set.
+// Any Private DNS Zones or Virtual Network Links which are created are recorded in `created`.
+func ensureAutomaticPrivateDnsZonesForPrivateEndpoint(ctx context.Context, client *clients.Client, privateDnsZoneGroup []interface{}, privateServiceConnections []interface{}, created *privateEndpointAutomaticPrivateDnsResources) ([]interface{}, error) {
+	if len(privateDnsZoneGroup) == 0 || privateDnsZoneGroup[0] == nil {
+		return privateDnsZoneGroup, nil
+	}
+	group := privateDnsZoneGroup[0].(map[string]interface{})
+
+	automaticRaw := group["automatic_private_dns_zone"].([]interface{})
+	if len(automaticRaw) == 0 || automaticRaw[0] == nil {
+		return privateDnsZoneGroup, nil
+	}
+	automatic := automaticRaw[0].(map[string]interface{})
+	resourceGroup := automatic["resource_group_name"].(string)
+	virtualNetworkIds := utils.ExpandStringSlice(automatic["virtual_network_ids"].([]interface{}))
+
+	zoneNames := make([]string, 0)
+	for _, raw := range privateServiceConnections {
+		connection := raw.(map[string]interface{})
+		resourceType := privateLinkResourceType(connection["private_connection_resource_id"].(string))
+		if resourceType == "" {
+			return nil, fmt.Errorf("the Private DNS Zones can only be determined automatically when `private_connection_resource_id` is specified")
+		}
+
+		subresourceNames := connection["subresource_names"].([]interface{})
+		if len(subresourceNames) == 0 {
+			return nil, fmt.Errorf("the Private DNS Zones can only be determined automatically when `subresource_names` is specified")
+		}
+
+		for _, subresourceName := range subresourceNames {
+			names, err := privateDnsZoneNamesForSubresource(client.Account.Environment, resourceType, subresourceName.(string))
+			if err != nil {
+				return nil, err
+			}
+			zoneNames = append(zoneNames, names...)
+		}
+	}
+
+	privateDnsZoneIds := make([]interface{}, 0)
+	seen := make(map[string]struct{})
+	for _, zoneName := range zoneNames {
+		if _, ok := seen[zoneName]; ok {
+			continue
+		}
+		seen[zoneName] = struct{}{}
+
+		zoneId := privateDnsParse.NewPrivateDnsZoneID(client.Account.SubscriptionId, resourceGroup, zoneName)
+		if err := ensurePrivateDnsZoneForPrivateEndpoint(ctx, client.PrivateDns, zoneId, *virtualNetworkIds, created); err != nil {
+			return nil, err
+		}
+
+		privateDnsZoneIds = append(privateDnsZoneIds, zoneId.ID())
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range group {
+		output[k] = v
+	}
+	output["private_dns_zone_ids"] = privateDnsZoneIds
+
+	return []interface{}{output}, nil
+}
+
+// ensurePrivateDnsZoneForPrivateEndpoint creates the Private DNS Zone if it doesn't exist and links it to any of the
+// Virtual Networks which aren't already linked, recording the resources which were created in `created`.
+func ensurePrivateDnsZoneForPrivateEndpoint(ctx context.Context, client *privateDnsClient.Client, id privateDnsParse.PrivateDnsZoneId, virtualNetworkIds []string, created *privateEndpointAutomaticPrivateDnsResources) error {
+	// other Private Endpoints in the same apply may be using the same Private DNS Zone
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.PrivateZonesClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Creating %s..", id)
+		parameters := privatedns.PrivateZone{
+			Location: utils.String("global"),
+		}
+		future, err := client.PrivateZonesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters, "", "*")
+		if err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+		created.addZoneId(id.ID())
+		if err := future.WaitForCompletionRef(ctx, client.PrivateZonesClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+		log.Printf("[DEBUG] Created %s.", id)
+	}
+
+	if len(virtualNetworkIds) == 0 {
+		return nil
+	}
+
+	linkedVirtualNetworkIds := make([]string, 0)
+	links, err := client.VirtualNetworkLinksClient.ListComplete(ctx, id.ResourceGroup, id.Name, nil)
+	if err != nil {
+		return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+	}
+	for links.NotDone() {
+		link := links.Value()
+		if props := link.VirtualNetworkLinkProperties; props != nil && props.VirtualNetwork != nil && props.VirtualNetwork.ID != nil {
+			linkedVirtualNetworkIds = append(linkedVirtualNetworkIds, *props.VirtualNetwork.ID)
+		}
+
+		if err := links.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Virtual Network Links for %s: %+v", id, err)
+		}
+	}
+
+	for _, virtualNetworkId := range virtualNetworkIds {
+		alreadyLinked := false
+		for _, linked := range linkedVirtualNetworkIds {
+			if strings.EqualFold(linked, virtualNetworkId) {
+				alreadyLinked = true
+				break
+			}
+		}
+		if alreadyLinked {
+			continue
+		}
+
+		vnetId, err := parse.VirtualNetworkID(virtualNetworkId)
+		if err != nil {
+			return err
+		}
+
+		// the name of the Virtual Network alone isn't unique, since Virtual Networks in other Resource Groups or
+		// Subscriptions can share the same name
+		linkId := privateDnsParse.NewVirtualNetworkLinkID(id.SubscriptionId, id.ResourceGroup, id.Name, privateEndpointVirtualNetworkLinkName(*vnetId))
+		log.Printf("[DEBUG] Creating %s..", linkId)
+		parameters := privatedns.VirtualNetworkLink{
+			Location: utils.String("global"),
+			VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
+				VirtualNetwork: &privatedns.SubResource{
+					ID: utils.String(vnetId.ID()),
+				},
+				RegistrationEnabled: utils.Bool(false),
+			},
+		}
+		future, err := client.VirtualNetworkLinksClient.CreateOrUpdate(ctx, linkId.ResourceGroup, linkId.PrivateDnsZoneName, linkId.Name, parameters, "", "*")
+		if err != nil {
+			return fmt.Errorf("creating %s: %+v", linkId, err)
+		}
+		created.addVirtualNetworkLinkId(linkId.ID())
+		if err := future.WaitForCompletionRef(ctx, client.VirtualNetworkLinksClient.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", linkId, err)
+		}
+		log.Printf("[DEBUG] Created %s.", linkId)
+	}
+
+	return nil
+}
+
+// privateEndpointAutomaticPrivateDnsResources are the Private DNS Zones and Virtual Network Links which were created
+// by `automatic_private_dns_zone` - and as such are owned by the Private Endpoint
+type privateEndpointAutomaticPrivateDnsResources struct {
+	zoneIds               []string
+	virtualNetworkLinkIds []string
+}
+
+func (r *privateEndpointAutomaticPrivateDnsResources) addZoneId(id string) {
+	if !utils.SliceContainsValue(r.zoneIds, id) {
+		r.zoneIds = append(r.zoneIds, id)
+	}
+}
+
+func (r *privateEndpointAutomaticPrivateDnsResources) addVirtualNetworkLinkId(id string) {
+	if !utils.SliceContainsValue(r.virtualNetworkLinkIds, id) {
+		r.virtualNetworkLinkIds = append(r.virtualNetworkLinkIds, id)
+	}
+}
+
+func expandPrivateEndpointAutomaticPrivateDnsResources(d *pluginsdk.ResourceData) privateEndpointAutomaticPrivateDnsResources {
+	return privateEndpointAutomaticPrivateDnsResources{
+		zoneIds:               *utils.ExpandStringSlice(d.Get("automatic_private_dns_zone_ids").([]interface{})),
+		virtualNetworkLinkIds: *utils.ExpandStringSlice(d.Get("automatic_private_dns_zone_virtual_network_link_ids").([]interface{})),
+	}
+}
+
+func setPrivateEndpointAutomaticPrivateDnsResources(d *pluginsdk.ResourceData, input privateEndpointAutomaticPrivateDnsResources) error {
+	if err := d.Set("automatic_private_dns_zone_ids", utils.FlattenStringSlice(&input.zoneIds)); err != nil {
+		return fmt.Errorf("setting `automatic_private_dns_zone_ids`: %+v", err)
+	}
+	if err := d.Set("automatic_private_dns_zone_virtual_network_link_ids", utils.FlattenStringSlice(&input.virtualNetworkLinkIds)); err != nil {
+		return fmt.Errorf("setting `automatic_private_dns_zone_virtual_network_link_ids`: %+v", err)
+	}
+	return nil
+}
+
+// privateEndpointAutomaticPrivateDnsZoneUsage returns the IDs of the Private DNS Zones and Virtual Networks which are
+// used by the `automatic_private_dns_zone` block within the (expanded) Private DNS Zone Group
+func privateEndpointAutomaticPrivateDnsZoneUsage(privateDnsZoneGroup []interface{}) ([]string, []string) {
+	zoneIds := make([]string, 0)
+	virtualNetworkIds := make([]string, 0)
+	if len(privateDnsZoneGroup) == 0 || privateDnsZoneGroup[0] == nil {
+		return zoneIds, virtualNetworkIds
+	}
+
+	group := privateDnsZoneGroup[0].(map[string]interface{})
+	automaticRaw := group["automatic_private_dns_zone"].([]interface{})
+	if len(automaticRaw) == 0 || automaticRaw[0] == nil {
+		return zoneIds, virtualNetworkIds
+	}
+
+	zoneIds = *utils.ExpandStringSlice(group["private_dns_zone_ids"].([]interface{}))
+	virtualNetworkIds = *utils.ExpandStringSlice(automaticRaw[0].(map[string]interface{})["virtual_network_ids"].([]interface{}))
+	return zoneIds, virtualNetworkIds
+}
+
+// deleteUnusedPrivateEndpointAutomaticPrivateDnsResources deletes the automatically created Private DNS Zones and Virtual
+// Network Links which aren't used by the Private Endpoint, returning those which are still owned by it. Since these can be
+// shared, a Private DNS Zone which contains records for other Private Endpoints (or which is linked to other Virtual
+// Networks) is retained, together with the Virtual Network Links to it, and no longer tracked by this Private Endpoint.
+func deleteUnusedPrivateEndpointAutomaticPrivateDnsResources(ctx context.Context, client *privateDnsClient.Client, input privateEndpointAutomaticPrivateDnsResources, usedZoneIds []string, usedVirtualNetworkIds []string) (privateEndpointAutomaticPrivateDnsResources, error) {
+	output := privateEndpointAutomaticPrivateDnsResources{}
+
+	usedLinkNames := make(map[string]bool)
+	for _, v := range usedVirtualNetworkIds {
+		vnetId, err := parse.VirtualNetworkID(v)
+		if err != nil {
+			return input, err
+		}
+		usedLinkNames[privateEndpointVirtualNetworkLinkName(*vnetId)] = true
+	}
+
+	zoneIds := make([]string, 0)
+	linksByZoneId := make(map[string][]privateDnsParse.VirtualNetworkLinkId)
+	for _, v := range input.zoneIds {
+		zoneId, err := privateDnsParse.PrivateDnsZoneID(v)
+		if err != nil {
+			return input, err
+		}
+		if _, ok := linksByZoneId[zoneId.ID()]; !ok {
+			zoneIds = append(zoneIds, zoneId.ID())
+			linksByZoneId[zoneId.ID()] = make([]privateDnsParse.VirtualNetworkLinkId, 0)
+		}
+	}
+	for _, v := range input.virtualNetworkLinkIds {
+		linkId, err := privateDnsParse.VirtualNetworkLinkID(v)
+		if err != nil {
+			return input, err
+		}
+		zoneId := privateDnsParse.NewPrivateDnsZoneID(linkId.SubscriptionId, linkId.ResourceGroup, linkId.PrivateDnsZoneName).ID()
+		if _, ok := linksByZoneId[zoneId]; !ok {
+			zoneIds = append(zoneIds, zoneId)
+		}
+		linksByZoneId[zoneId] = append(linksByZoneId[zoneId], *linkId)
+	}
+
+	for _, v := range zoneIds {
+		zoneId, err := privateDnsParse.PrivateDnsZoneID(v)
+		if err != nil {
+			return input, err
+		}
+
+		zoneIsOwned := utils.SliceContainsValue(input.zoneIds, v)
+		zoneIsUsed := utils.SliceContainsValue(usedZoneIds, v)
+		if err := deleteUnusedPrivateEndpointAutomaticPrivateDnsZone(ctx, client, *zoneId, zoneIsOwned, zoneIsUsed, linksByZoneId[v], usedLinkNames, &output); err != nil {
+			return input, err
+		}
+	}
+
+	return output, nil
+}
+
+// deleteUnusedPrivateEndpointAutomaticPrivateDnsZone removes the unused Virtual Network Links to the Private DNS Zone, and
+// the Private DNS Zone itself when it was created for this Private Endpoint and is no longer used, recording the
+// resources which are retained in `retained`.
+func deleteUnusedPrivateEndpointAutomaticPrivateDnsZone(ctx context.Context, client *privateDnsClient.Client, zoneId privateDnsParse.PrivateDnsZoneId, zoneIsOwned bool, zoneIsUsed bool, links []privateDnsParse.VirtualNetworkLinkId, usedLinkNames map[string]bool, retained *privateEndpointAutomaticPrivateDnsResources) error {
+	// other Private Endpoints in the same apply may be creating records in, or linking to, the same Private DNS Zone
+	locks.ByID(zoneId.ID())
+	defer locks.UnlockByID(zoneId.ID())
+
+	// the Private DNS Zone is still used by this Private Endpoint, so only the unused Virtual Network Links are removed
+	if zoneIsUsed {
+		if zoneIsOwned {
+			retained.addZoneId(zoneId.ID())
+		}
+		for _, linkId := range links {
+			if usedLinkNames[linkId.Name] {
+				retained.addVirtualNetworkLinkId(linkId.ID())
+				continue
+			}
+			if err := deletePrivateDnsVirtualNetworkLinkForPrivateEndpoint(ctx, client, linkId); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	zone, err := client.PrivateZonesClient.Get(ctx, zoneId.ResourceGroup, zoneId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", zoneId, err)
+	}
+
+	// the SOA record is always present, any other records belong to other Private Endpoints
+	if props := zone.PrivateZoneProperties; props != nil && props.NumberOfRecordSets != nil && *props.NumberOfRecordSets > 1 {
+		log.Printf("[DEBUG] %s contains records for other Private Endpoints - retaining it and the Virtual Network Links to it", zoneId)
+		return nil
+	}
+
+	for _, linkId := range links {
+		if err := deletePrivateDnsVirtualNetworkLinkForPrivateEndpoint(ctx, client, linkId); err != nil {
+			return err
+		}
+	}
+
+	if !zoneIsOwned {
+		return nil
+	}
+
+	zone, err = client.PrivateZonesClient.Get(ctx, zoneId.ResourceGroup, zoneId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", zoneId, err)
+	}
+	if props := zone.PrivateZoneProperties; props != nil && props.NumberOfVirtualNetworkLinks != nil && *props.NumberOfVirtualNetworkLinks > 0 {
+		log.Printf("[DEBUG] %s is linked to other Virtual Networks - retaining it", zoneId)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %s..", zoneId)
+	future, err := client.PrivateZonesClient.Delete(ctx, zoneId.ResourceGroup, zoneId.Name, "")
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", zoneId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.PrivateZonesClient.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", zoneId, err)
+	}
+	log.Printf("[DEBUG] Deleted %s.", zoneId)
+
+	return nil
+}
+
+func deletePrivateDnsVirtualNetworkLinkForPrivateEndpoint(ctx context.Context, client *privateDnsClient.Client, id privateDnsParse.VirtualNetworkLinkId) error {
+	log.Printf("[DEBUG] Deleting %s..", id)
+	future, err := client.VirtualNetworkLinksClient.Delete(ctx, id.ResourceGroup, id.PrivateDnsZoneName, id.Name, "")
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.VirtualNetworkLinksClient.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+	}
+	log.Printf("[DEBUG] Deleted %s.", id)
+	return nil
+}
+
 func deletePrivateDnsZoneGroupForPrivateEndpoint(ctx context.Context, client *network.PrivateDNSZoneGroupsClient, id parse.PrivateEndpointId) error {
 	// lookup and delete the (should be, Single) Private DNS Zone Group associated with this Private Endpoint
 	privateDnsZoneIds, err := retrievePrivateDnsZoneGroupsForPrivateEndpoint(ctx, client, id)
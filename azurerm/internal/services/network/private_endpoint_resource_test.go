@@ -204,6 +204,25 @@ func TestAccPrivateEndpoint_privateDnsZoneRemove(t *testing.T) {
 	})
 }
 
+func TestAccPrivateEndpoint_automaticPrivateDnsZone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
+	r := PrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.automaticPrivateDnsZone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_dns_zone_group.0.private_dns_zone_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_configs.0.name").HasValue("privatelink.postgres.database.azure.com"),
+				check.That(data.ResourceName).Key("automatic_private_dns_zone_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("automatic_private_dns_zone_virtual_network_link_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("private_dns_zone_configs", "private_dns_zone_group", "automatic_private_dns_zone_ids", "automatic_private_dns_zone_virtual_network_link_ids"),
+	})
+}
+
 func TestAccPrivateEndpoint_privateConnectionAlias(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_private_endpoint", "test")
 	r := PrivateEndpointResource{}
@@ -722,6 +741,76 @@ resource "azurerm_private_endpoint" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateEndpointResource) automaticPrivateDnsZone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-privatelink-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_space       = ["10.5.0.0/16"]
+}
+
+resource "azurerm_subnet" "endpoint" {
+  name                 = "acctestsnetendpoint-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_postgresql_server" "test" {
+  name                = "acctest-pe-server-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "GP_Gen5_4"
+
+  storage_mb                   = 5120
+  backup_retention_days        = 7
+  geo_redundant_backup_enabled = false
+  auto_grow_enabled            = true
+
+  administrator_login          = "psqladminun"
+  administrator_login_password = "H@Sh1CoR3!"
+  version                      = "9.5"
+  ssl_enforcement_enabled      = true
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-privatelink-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.endpoint.id
+
+  private_dns_zone_group {
+    name = "acctest-dzg-%d"
+
+    automatic_private_dns_zone {
+      resource_group_name = azurerm_resource_group.test.name
+      virtual_network_ids = [azurerm_virtual_network.test.id]
+    }
+  }
+
+  private_service_connection {
+    name                           = "acctest-privatelink-psc-%d"
+    private_connection_resource_id = azurerm_postgresql_server.test.id
+    subresource_names              = ["postgresqlServer"]
+    is_manual_connection           = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
 func (r PrivateEndpointResource) privateConnectionAlias(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
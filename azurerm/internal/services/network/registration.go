@@ -31,6 +31,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
 		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
 		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
+		"azurerm_private_endpoint_dns_zone_names":           dataSourcePrivateEndpointDnsZoneNames(),
 		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
 		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
 		"azurerm_public_ip":                                 dataSourcePublicIP(),
@@ -44,6 +45,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
 		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
+		"azurerm_virtual_network_free_address_ranges":       dataSourceVirtualNetworkFreeAddressRanges(),
 		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
 		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
 		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
@@ -53,24 +55,26 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                      resourceApplicationGateway(),
-		"azurerm_application_security_group":               resourceApplicationSecurityGroup(),
-		"azurerm_bastion_host":                             resourceBastionHost(),
-		"azurerm_express_route_circuit_connection":         resourceExpressRouteCircuitConnection(),
-		"azurerm_express_route_circuit_authorization":      resourceExpressRouteCircuitAuthorization(),
-		"azurerm_express_route_circuit_peering":            resourceExpressRouteCircuitPeering(),
-		"azurerm_express_route_circuit":                    resourceExpressRouteCircuit(),
-		"azurerm_express_route_connection":                 resourceExpressRouteConnection(),
-		"azurerm_express_route_gateway":                    resourceExpressRouteGateway(),
-		"azurerm_express_route_port":                       resourceArmExpressRoutePort(),
-		"azurerm_ip_group":                                 resourceIpGroup(),
-		"azurerm_local_network_gateway":                    resourceLocalNetworkGateway(),
-		"azurerm_nat_gateway":                              resourceNatGateway(),
-		"azurerm_nat_gateway_public_ip_association":        resourceNATGatewayPublicIpAssociation(),
-		"azurerm_nat_gateway_public_ip_prefix_association": resourceNATGatewayPublicIpPrefixAssociation(),
-		"azurerm_network_connection_monitor":               resourceNetworkConnectionMonitor(),
-		"azurerm_network_ddos_protection_plan":             resourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                        resourceNetworkInterface(),
+		"azurerm_application_gateway":                              resourceApplicationGateway(),
+		"azurerm_application_gateway_backend_address_pool_address": resourceApplicationGatewayBackendAddressPoolAddress(),
+		"azurerm_application_gateway_http_listener":                resourceApplicationGatewayHTTPListener(),
+		"azurerm_application_security_group":                       resourceApplicationSecurityGroup(),
+		"azurerm_bastion_host":                                     resourceBastionHost(),
+		"azurerm_express_route_circuit_connection":                 resourceExpressRouteCircuitConnection(),
+		"azurerm_express_route_circuit_authorization":              resourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_peering":                    resourceExpressRouteCircuitPeering(),
+		"azurerm_express_route_circuit":                            resourceExpressRouteCircuit(),
+		"azurerm_express_route_connection":                         resourceExpressRouteConnection(),
+		"azurerm_express_route_gateway":                            resourceExpressRouteGateway(),
+		"azurerm_express_route_port":                               resourceArmExpressRoutePort(),
+		"azurerm_ip_group":                                         resourceIpGroup(),
+		"azurerm_local_network_gateway":                            resourceLocalNetworkGateway(),
+		"azurerm_nat_gateway":                                      resourceNatGateway(),
+		"azurerm_nat_gateway_public_ip_association":                resourceNATGatewayPublicIpAssociation(),
+		"azurerm_nat_gateway_public_ip_prefix_association":         resourceNATGatewayPublicIpPrefixAssociation(),
+		"azurerm_network_connection_monitor":                       resourceNetworkConnectionMonitor(),
+		"azurerm_network_ddos_protection_plan":                     resourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                                resourceNetworkInterface(),
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
@@ -88,6 +92,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_public_ip_prefix":                          resourcePublicIpPrefix(),
 		"azurerm_network_security_group":                    resourceNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                     resourceNetworkSecurityRule(),
+		"azurerm_network_security_rules":                    resourceNetworkSecurityRules(),
 		"azurerm_network_watcher_flow_log":                  resourceNetworkWatcherFlowLog(),
 		"azurerm_network_watcher":                           resourceNetworkWatcher(),
 		"azurerm_route_filter":                              resourceRouteFilter(),
@@ -0,0 +1,177 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+)
+
+// ipv4AddressRange is an inclusive range of IPv4 Addresses, held as integers to simplify the arithmetic
+type ipv4AddressRange struct {
+	start uint64
+	end   uint64
+}
+
+// virtualNetworkAddressSpaces returns the Address Space(s) assigned to the Virtual Network
+func virtualNetworkAddressSpaces(vnet network.VirtualNetwork) []string {
+	output := make([]string, 0)
+	if props := vnet.VirtualNetworkPropertiesFormat; props != nil && props.AddressSpace != nil && props.AddressSpace.AddressPrefixes != nil {
+		output = append(output, *props.AddressSpace.AddressPrefixes...)
+	}
+	return output
+}
+
+// virtualNetworkSubnetAddressPrefixes returns the Address Prefixes in use by the Subnets within the Virtual Network
+func virtualNetworkSubnetAddressPrefixes(vnet network.VirtualNetwork) []string {
+	output := make([]string, 0)
+	if props := vnet.VirtualNetworkPropertiesFormat; props != nil && props.Subnets != nil {
+		for _, subnet := range *props.Subnets {
+			if subnet.SubnetPropertiesFormat == nil {
+				continue
+			}
+
+			if subnet.AddressPrefix != nil && *subnet.AddressPrefix != "" {
+				output = append(output, *subnet.AddressPrefix)
+			}
+			if subnet.AddressPrefixes != nil {
+				output = append(output, *subnet.AddressPrefixes...)
+			}
+		}
+	}
+	return output
+}
+
+// nextAvailableAddressPrefix returns the first CIDR of the given prefix length within the Address Spaces
+// which doesn't overlap any of the used Address Prefixes. Only IPv4 ranges are considered.
+func nextAvailableAddressPrefix(addressSpaces []string, usedAddressPrefixes []string, prefixLength int) (string, error) {
+	spaces, err := parseIPv4AddressRanges(addressSpaces)
+	if err != nil {
+		return "", err
+	}
+
+	used, err := parseIPv4AddressRanges(usedAddressPrefixes)
+	if err != nil {
+		return "", err
+	}
+
+	size := uint64(1) << uint(32-prefixLength)
+	for _, space := range spaces {
+		// the Address Space is smaller than the requested prefix
+		if space.end-space.start+1 < size {
+			continue
+		}
+
+		candidate := alignIPv4Address(space.start, size)
+		for candidate+size-1 <= space.end {
+			overlapping := false
+			for _, r := range used {
+				if r.start <= candidate+size-1 && r.end >= candidate {
+					candidate = alignIPv4Address(r.end+1, size)
+					overlapping = true
+					break
+				}
+			}
+
+			if !overlapping {
+				return formatIPv4CIDR(candidate, prefixLength), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no free /%d Address Prefix is available within the Address Space %q", prefixLength, strings.Join(addressSpaces, ", "))
+}
+
+// freeAddressPrefixes returns the smallest set of CIDRs covering the parts of the Address Spaces which
+// aren't used by any of the Address Prefixes. Only IPv4 ranges are considered.
+func freeAddressPrefixes(addressSpaces []string, usedAddressPrefixes []string) ([]string, error) {
+	spaces, err := parseIPv4AddressRanges(addressSpaces)
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := parseIPv4AddressRanges(usedAddressPrefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]string, 0)
+	for _, space := range spaces {
+		current := space.start
+		for _, r := range used {
+			if r.end < current || r.start > space.end {
+				continue
+			}
+
+			if r.start > current {
+				output = append(output, ipv4AddressRangeToCIDRs(current, r.start-1)...)
+			}
+			current = r.end + 1
+		}
+
+		if current <= space.end {
+			output = append(output, ipv4AddressRangeToCIDRs(current, space.end)...)
+		}
+	}
+
+	return output, nil
+}
+
+// parseIPv4AddressRanges parses the CIDRs into ranges sorted by their start address, skipping any IPv6 CIDRs
+func parseIPv4AddressRanges(input []string) ([]ipv4AddressRange, error) {
+	output := make([]ipv4AddressRange, 0)
+	for _, v := range input {
+		_, ipNet, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a CIDR: %+v", v, err)
+		}
+
+		ip := ipNet.IP.To4()
+		if ip == nil {
+			continue
+		}
+
+		ones, bits := ipNet.Mask.Size()
+		start := uint64(ip[0])<<24 | uint64(ip[1])<<16 | uint64(ip[2])<<8 | uint64(ip[3])
+		output = append(output, ipv4AddressRange{
+			start: start,
+			end:   start + (uint64(1) << uint(bits-ones)) - 1,
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].start < output[j].start
+	})
+
+	return output, nil
+}
+
+// ipv4AddressRangeToCIDRs splits the inclusive range into the largest aligned CIDRs possible
+func ipv4AddressRangeToCIDRs(start, end uint64) []string {
+	output := make([]string, 0)
+	for start <= end {
+		prefixLength := 32
+		for prefixLength > 0 {
+			size := uint64(1) << uint(32-prefixLength+1)
+			if start%size != 0 || start+size-1 > end {
+				break
+			}
+			prefixLength--
+		}
+
+		output = append(output, formatIPv4CIDR(start, prefixLength))
+		start += uint64(1) << uint(32-prefixLength)
+	}
+	return output
+}
+
+func alignIPv4Address(address, size uint64) uint64 {
+	return (address + size - 1) / size * size
+}
+
+func formatIPv4CIDR(address uint64, prefixLength int) string {
+	ip := net.IPv4(byte(address>>24), byte(address>>16), byte(address>>8), byte(address))
+	return fmt.Sprintf("%s/%d", ip.String(), prefixLength)
+}
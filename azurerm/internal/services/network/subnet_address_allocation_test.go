@@ -0,0 +1,159 @@
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNextAvailableAddressPrefix(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AddressSpaces []string
+		Used          []string
+		PrefixLength  int
+		Expected      string
+		Error         bool
+	}{
+		{
+			Name:          "Empty Virtual Network",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			PrefixLength:  24,
+			Expected:      "10.0.0.0/24",
+		},
+		{
+			Name:          "First Range Used",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.0.1.0/24",
+		},
+		{
+			Name:          "Gap Between Subnets",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.2.0/24", "10.0.0.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.0.1.0/24",
+		},
+		{
+			Name:          "Gap Too Small",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/25", "10.0.1.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.0.2.0/24",
+		},
+		{
+			Name:          "Smaller Prefix Fills Gap",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/25", "10.0.1.0/24"},
+			PrefixLength:  26,
+			Expected:      "10.0.0.128/26",
+		},
+		{
+			Name:          "Larger Prefix Is Aligned",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/28"},
+			PrefixLength:  22,
+			Expected:      "10.0.4.0/22",
+		},
+		{
+			Name:          "Second Address Space",
+			AddressSpaces: []string{"10.0.0.0/24", "10.1.0.0/16"},
+			Used:          []string{"10.0.0.0/24"},
+			PrefixLength:  24,
+			Expected:      "10.1.0.0/24",
+		},
+		{
+			Name:          "Address Space Too Small",
+			AddressSpaces: []string{"10.0.0.0/26", "192.168.0.0/24"},
+			PrefixLength:  24,
+			Expected:      "192.168.0.0/24",
+		},
+		{
+			Name:          "IPv6 Ignored",
+			AddressSpaces: []string{"ace:cab:deca::/48", "10.0.0.0/16"},
+			Used:          []string{"ace:cab:deca:deed::/64"},
+			PrefixLength:  24,
+			Expected:      "10.0.0.0/24",
+		},
+		{
+			Name:          "Full",
+			AddressSpaces: []string{"10.0.0.0/23"},
+			Used:          []string{"10.0.0.0/24", "10.0.1.0/24"},
+			PrefixLength:  29,
+			Error:         true,
+		},
+		{
+			Name:          "Invalid CIDR",
+			AddressSpaces: []string{"10.0.0.0"},
+			PrefixLength:  24,
+			Error:         true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := nextAvailableAddressPrefix(v.AddressSpaces, v.Used, v.PrefixLength)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestFreeAddressPrefixes(t *testing.T) {
+	testData := []struct {
+		Name          string
+		AddressSpaces []string
+		Used          []string
+		Expected      []string
+	}{
+		{
+			Name:          "Empty Virtual Network",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Expected:      []string{"10.0.0.0/16"},
+		},
+		{
+			Name:          "Full",
+			AddressSpaces: []string{"10.0.0.0/24"},
+			Used:          []string{"10.0.0.0/25", "10.0.0.128/25"},
+			Expected:      []string{},
+		},
+		{
+			Name:          "First Range Used",
+			AddressSpaces: []string{"10.0.0.0/16"},
+			Used:          []string{"10.0.0.0/24"},
+			Expected:      []string{"10.0.1.0/24", "10.0.2.0/23", "10.0.4.0/22", "10.0.8.0/21", "10.0.16.0/20", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			Name:          "Gaps",
+			AddressSpaces: []string{"10.0.0.0/22", "192.168.0.0/24"},
+			Used:          []string{"10.0.1.0/24", "10.0.0.0/26", "192.168.0.0/24"},
+			Expected:      []string{"10.0.0.64/26", "10.0.0.128/25", "10.0.2.0/23"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := freeAddressPrefixes(v.AddressSpaces, v.Used)
+		if err != nil {
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -60,7 +61,7 @@ func resourceSubnet() *pluginsdk.Resource {
 				Computed: true,
 				// TODO Remove this in the next major version release
 				Deprecated:   "Use the `address_prefixes` property instead.",
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
 			},
 
 			"address_prefixes": {
@@ -72,7 +73,17 @@ func resourceSubnet() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
-				ExactlyOneOf: []string{"address_prefix", "address_prefixes"},
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
+			},
+
+			// the Address Prefix is allocated once when the Subnet is created and then persisted into `address_prefixes`,
+			// so changes to the rest of the Virtual Network never cause it to move
+			"address_prefix_length": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(8, 29),
+				ExactlyOneOf: []string{"address_prefix", "address_prefixes", "address_prefix_length"},
 			},
 
 			"service_endpoints": {
@@ -212,6 +223,13 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		addressPrefix := value.(string)
 		properties.AddressPrefix = &addressPrefix
 	}
+	if value, ok := d.GetOk("address_prefix_length"); ok {
+		addressPrefix, err := allocateSubnetAddressPrefix(ctx, meta.(*clients.Client).Network.VnetClient, id, value.(int))
+		if err != nil {
+			return err
+		}
+		properties.AddressPrefix = &addressPrefix
+	}
 	if properties.AddressPrefixes != nil && len(*properties.AddressPrefixes) == 1 {
 		properties.AddressPrefix = &(*properties.AddressPrefixes)[0]
 		properties.AddressPrefixes = nil
@@ -563,3 +581,21 @@ func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy
 	}
 	return output
 }
+
+// allocateSubnetAddressPrefix finds the next free Address Prefix of the given length within the Virtual Network -
+// the caller must hold the lock on the Virtual Network so that concurrent allocations don't collide
+func allocateSubnetAddressPrefix(ctx context.Context, client *network.VirtualNetworksClient, id parse.SubnetId, prefixLength int) (string, error) {
+	vnetId := parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+	vnet, err := client.Get(ctx, vnetId.ResourceGroup, vnetId.Name, "")
+	if err != nil {
+		return "", fmt.Errorf("retrieving %s: %+v", vnetId, err)
+	}
+
+	addressPrefix, err := nextAvailableAddressPrefix(virtualNetworkAddressSpaces(vnet), virtualNetworkSubnetAddressPrefixes(vnet), prefixLength)
+	if err != nil {
+		return "", fmt.Errorf("allocating an Address Prefix for %s: %+v", id, err)
+	}
+
+	log.Printf("[DEBUG] Allocated the Address Prefix %q for %s", addressPrefix, id)
+	return addressPrefix, nil
+}
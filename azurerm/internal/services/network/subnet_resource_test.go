@@ -301,6 +301,33 @@ func TestAccSubnet_updateAddressPrefix(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_addressPrefixLength(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressPrefixLength(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// the first /24 is used by the other Subnet, so the next /26 after it is allocated
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.1.0/26"),
+			),
+		},
+		data.ImportStep("address_prefix_length"),
+		{
+			// the allocated Address Prefix shouldn't change once another Subnet frees up the space before it
+			Config: r.addressPrefixLengthRemovedOther(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.0").HasValue("10.0.1.0/26"),
+			),
+		},
+		data.ImportStep("address_prefix_length"),
+	})
+}
+
 func (t SubnetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SubnetID(state.ID)
 	if err != nil {
@@ -425,6 +452,41 @@ resource "azurerm_subnet" "test" {
 `, r.template(data))
 }
 
+func (r SubnetResource) addressPrefixLength(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "other" {
+  name                 = "other"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                  = "internal"
+  resource_group_name   = azurerm_resource_group.test.name
+  virtual_network_name  = azurerm_virtual_network.test.name
+  address_prefix_length = 26
+
+  depends_on = [azurerm_subnet.other]
+}
+`, r.template(data))
+}
+
+func (r SubnetResource) addressPrefixLengthRemovedOther(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                  = "internal"
+  resource_group_name   = azurerm_resource_group.test.name
+  virtual_network_name  = azurerm_virtual_network.test.name
+  address_prefix_length = 26
+}
+`, r.template(data))
+}
+
 func (r SubnetResource) delegation(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
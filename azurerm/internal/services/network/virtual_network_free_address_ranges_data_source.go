@@ -0,0 +1,106 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceVirtualNetworkFreeAddressRanges() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualNetworkFreeAddressRangesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_network_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"prefix_length": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(8, 29),
+			},
+
+			"address_space": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"free_address_ranges": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"next_address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVirtualNetworkFreeAddressRangesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VnetClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewVirtualNetworkID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string))
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	addressSpaces := virtualNetworkAddressSpaces(resp)
+	usedAddressPrefixes := virtualNetworkSubnetAddressPrefixes(resp)
+
+	if err := d.Set("address_space", addressSpaces); err != nil {
+		return fmt.Errorf("setting `address_space`: %+v", err)
+	}
+
+	freeRanges, err := freeAddressPrefixes(addressSpaces, usedAddressPrefixes)
+	if err != nil {
+		return fmt.Errorf("calculating the free address ranges for %s: %+v", id, err)
+	}
+	if err := d.Set("free_address_ranges", freeRanges); err != nil {
+		return fmt.Errorf("setting `free_address_ranges`: %+v", err)
+	}
+
+	nextAddressPrefix := ""
+	if v, ok := d.GetOk("prefix_length"); ok {
+		// an exhausted Virtual Network is reported as an empty value rather than an error, so it can be checked for
+		if prefix, err := nextAvailableAddressPrefix(addressSpaces, usedAddressPrefixes, v.(int)); err == nil {
+			nextAddressPrefix = prefix
+		}
+	}
+	d.Set("next_address_prefix", nextAddressPrefix)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type VirtualNetworkFreeAddressRangesDataSource struct {
+}
+
+func TestAccDataSourceVirtualNetworkFreeAddressRanges_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_network_free_address_ranges", "test")
+	r := VirtualNetworkFreeAddressRangesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("address_space.0").HasValue("10.0.0.0/22"),
+				check.That(data.ResourceName).Key("free_address_ranges.#").HasValue("3"),
+				check.That(data.ResourceName).Key("free_address_ranges.0").HasValue("10.0.0.64/26"),
+				check.That(data.ResourceName).Key("free_address_ranges.1").HasValue("10.0.0.128/25"),
+				check.That(data.ResourceName).Key("free_address_ranges.2").HasValue("10.0.2.0/23"),
+				check.That(data.ResourceName).Key("next_address_prefix").HasValue("10.0.2.0/24"),
+			),
+		},
+	})
+}
+
+func (VirtualNetworkFreeAddressRangesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%d"
+  address_space       = ["10.0.0.0/22"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "first" {
+  name                 = "first"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/26"]
+}
+
+resource "azurerm_subnet" "second" {
+  name                 = "second"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+data "azurerm_virtual_network_free_address_ranges" "test" {
+  virtual_network_name = azurerm_virtual_network.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+  prefix_length        = 24
+
+  depends_on = [azurerm_subnet.first, azurerm_subnet.second]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
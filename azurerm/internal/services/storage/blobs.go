@@ -3,6 +3,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -150,15 +151,53 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+	fileSize := info.Size()
+
+	// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+	contentMD5, err := readerContentMD5(io.NewSectionReader(file, 0, fileSize))
+	if err != nil {
+		return fmt.Errorf("computing MD5 of source file %q: %s", sbu.Source, err)
+	}
+	contentMD5, err = convertHexToBase64Encoding(contentMD5)
+	if err != nil {
+		return err
+	}
+	if sbu.ContentMD5 != "" && sbu.ContentMD5 != contentMD5 {
+		return fmt.Errorf("the MD5 of the source file %q (%q) does not match the specified `content_md5` (%q)", sbu.Source, contentMD5, sbu.ContentMD5)
+	}
+
+	if fileSize <= maxBlockSize {
+		input := blobs.PutBlockBlobInput{
+			ContentType: utils.String(sbu.ContentType),
+			ContentMD5:  utils.String(contentMD5),
+			MetaData:    sbu.MetaData,
+		}
+		if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
+			return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+		}
+
+		return nil
+	}
+
+	blockIds, err := sbu.blockUploadFromSource(ctx, file, fileSize)
+	if err != nil {
+		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentMD5:  utils.String(contentMD5),
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
 	}
-	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
-	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("Error PutBlockBlobFromFile: %s", err)
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error PutBlockList: %s", err)
 	}
 
 	return nil
@@ -221,6 +260,90 @@ func (sbu BlobUpload) uploadPageBlob(ctx context.Context) error {
 
 // TODO: move below here into Giovanni
 
+const maxBlockSize int64 = 4 * 1024 * 1024
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+// blockUploadFromSource uploads the file in fixed-size blocks using `Parallelism` workers per CPU,
+// verifying the MD5 of each block against the MD5 returned by the service and returning the list
+// of Block IDs (in order) which should be committed
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) ([]blobs.BlockID, error) {
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+
+	blockList := make([]storageBlobBlock, 0)
+	blockIds := make([]blobs.BlockID, 0)
+	for offset := int64(0); offset < fileSize; offset += maxBlockSize {
+		length := maxBlockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		// Block IDs must be Base64 encoded and of equal length within a blob
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%016d", offset/maxBlockSize)))
+		blockList = append(blockList, storageBlobBlock{
+			id:      id,
+			section: io.NewSectionReader(file, offset, length),
+		})
+		blockIds = append(blockIds, blobs.BlockID{Value: id})
+	}
+
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blockList))
+
+	for _, block := range blockList {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blocks, errors, wg)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	return blockIds, nil
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, blocks chan storageBlobBlock, errors chan error, wg *sync.WaitGroup) {
+	for block := range blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+			errors <- fmt.Errorf("Error reading block %q of source file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		checksum := md5.Sum(chunk)
+		expectedMD5 := base64.StdEncoding.EncodeToString(checksum[:])
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		result, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err != nil {
+			errors <- fmt.Errorf("Error writing block %q for file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		if result.ContentMD5 != "" && result.ContentMD5 != expectedMD5 {
+			errors <- fmt.Errorf("the MD5 of block %q for file %q returned by the service (%q) does not match the expected MD5 (%q)", block.id, sbu.Source, result.ContentMD5, expectedMD5)
+		}
+
+		wg.Done()
+	}
+}
+
 type storageBlobPage struct {
 	offset  int64
 	section *io.SectionReader
@@ -363,6 +486,26 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+// fileContentMD5 returns the Hex encoded MD5 sum of the file at the specified path
+func fileContentMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return readerContentMD5(file)
+}
+
+func readerContentMD5(input io.Reader) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, input); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -3,12 +3,17 @@ package client
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	containerStorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2020-03-01/storagesync"
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/localusers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/storageaccounts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/tablebatch"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -28,13 +33,16 @@ type Client struct {
 	FileSystemsClient           *filesystems.Client
 	ADLSGen2PathsClient         *paths.Client
 	ManagementPoliciesClient    *storage.ManagementPoliciesClient
+	BlobContainersClient        *containerStorage.BlobContainersClient
 	BlobServicesClient          *storage.BlobServicesClient
 	BlobInventoryPoliciesClient *storage.BlobInventoryPoliciesClient
 	CloudEndpointsClient        *storagesync.CloudEndpointsClient
 	EncryptionScopesClient      *storage.EncryptionScopesClient
 	Environment                 az.Environment
 	FileServicesClient          *storage.FileServicesClient
+	LocalUsersClient            *localusers.LocalUsersClient
 	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
+	StorageAccountsClient       *storageaccounts.StorageAccountsClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
@@ -56,6 +64,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
+	blobContainersClient := containerStorage.NewBlobContainersClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobContainersClient.Client, options.ResourceManagerAuthorizer)
+
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -71,9 +82,15 @@ func NewClient(options *common.ClientOptions) *Client {
 	fileServicesClient := storage.NewFileServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&fileServicesClient.Client, options.ResourceManagerAuthorizer)
 
+	localUsersClient := localusers.NewLocalUsersClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&localUsersClient.Client, options.ResourceManagerAuthorizer)
+
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	storageAccountsClient := storageaccounts.NewStorageAccountsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&storageAccountsClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -87,13 +104,16 @@ func NewClient(options *common.ClientOptions) *Client {
 		FileSystemsClient:           &fileSystemsClient,
 		ADLSGen2PathsClient:         &adlsGen2PathsClient,
 		ManagementPoliciesClient:    &managementPoliciesClient,
+		BlobContainersClient:        &blobContainersClient,
 		BlobServicesClient:          &blobServicesClient,
 		BlobInventoryPoliciesClient: &blobInventoryPoliciesClient,
 		CloudEndpointsClient:        &cloudEndpointsClient,
 		EncryptionScopesClient:      &encryptionScopesClient,
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
+		LocalUsersClient:            &localUsersClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
+		StorageAccountsClient:       &storageAccountsClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
@@ -272,6 +292,29 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 	return &entitiesClient, nil
 }
 
+func (client Client) TableBatchClient(ctx context.Context, account accountDetails) (*tablebatch.TableBatchClient, error) {
+	// NOTE: Table Entity Group Transactions do not support AzureAD Authentication
+
+	accountKey, err := account.AccountKey(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account Key: %s", err)
+	}
+
+	storageAuth, err := autorest.NewSharedKeyAuthorizer(account.name, *accountKey, autorest.SharedKeyLiteForTable)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer: %+v", err)
+	}
+
+	// the Primary Table Endpoint is used when available, since this differs for Azurite and the Storage Emulator
+	endpoint := fmt.Sprintf("https://%s.table.%s", account.name, client.Environment.StorageEndpointSuffix)
+	if props := account.Properties; props != nil && props.PrimaryEndpoints != nil && props.PrimaryEndpoints.Table != nil && *props.PrimaryEndpoints.Table != "" {
+		endpoint = strings.TrimSuffix(*props.PrimaryEndpoints.Table, "/")
+	}
+	batchClient := tablebatch.NewTableBatchClientWithBaseURI(endpoint)
+	batchClient.Client.Authorizer = storageAuth
+	return &batchClient, nil
+}
+
 func (client Client) TablesClient(ctx context.Context, account accountDetails) (shim.StorageTableWrapper, error) {
 	// NOTE: Tables do not support AzureAD Authentication
 
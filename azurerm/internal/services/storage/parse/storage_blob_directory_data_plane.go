@@ -0,0 +1,101 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = StorageBlobDirectoryDataPlaneId{}
+
+type StorageBlobDirectoryDataPlaneId struct {
+	AccountName   string
+	DomainSuffix  string
+	ContainerName string
+	Prefix        string
+}
+
+// ID returns the URL of the Container, including the Prefix when one is specified
+func (id StorageBlobDirectoryDataPlaneId) ID() string {
+	if id.Prefix == "" {
+		return fmt.Sprintf("https://%s.blob.%s/%s", id.AccountName, id.DomainSuffix, id.ContainerName)
+	}
+
+	return fmt.Sprintf("https://%s.blob.%s/%s/%s", id.AccountName, id.DomainSuffix, id.ContainerName, id.Prefix)
+}
+
+// BlobName returns the name of the Blob used for the file at the specified (forward-slash separated) relative path
+func (id StorageBlobDirectoryDataPlaneId) BlobName(relativePath string) string {
+	if id.Prefix == "" {
+		return relativePath
+	}
+
+	return path.Join(id.Prefix, relativePath)
+}
+
+// RelativePath returns the (forward-slash separated) relative path of the file for the specified Blob,
+// returning false when the Blob is not within the Prefix
+func (id StorageBlobDirectoryDataPlaneId) RelativePath(blobName string) (string, bool) {
+	if id.Prefix == "" {
+		return blobName, blobName != ""
+	}
+
+	relativePath := strings.TrimPrefix(blobName, id.Prefix+"/")
+	if relativePath == blobName || relativePath == "" {
+		return "", false
+	}
+
+	return relativePath, true
+}
+
+func NewStorageBlobDirectoryDataPlaneId(accountName, domainSuffix, containerName, prefix string) StorageBlobDirectoryDataPlaneId {
+	return StorageBlobDirectoryDataPlaneId{
+		AccountName:   accountName,
+		DomainSuffix:  domainSuffix,
+		ContainerName: containerName,
+		Prefix:        prefix,
+	}
+}
+
+func StorageBlobDirectoryDataPlaneID(input string) (*StorageBlobDirectoryDataPlaneId, error) {
+	if input == "" {
+		return nil, fmt.Errorf("`id` was empty")
+	}
+
+	uri, err := url.Parse(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as a URI: %+v", input, err)
+	}
+
+	hostSegments := strings.Split(uri.Host, ".")
+	if len(hostSegments) < 3 || hostSegments[0] == "" || hostSegments[1] != "blob" {
+		return nil, fmt.Errorf("expected the host of %q to be in the format `{account}.blob.{domainSuffix}`", input)
+	}
+	accountName := hostSegments[0]
+	domainSuffix := strings.TrimPrefix(uri.Host, fmt.Sprintf("%s.blob.", accountName))
+
+	// the path is in the format `/{container}` or `/{container}/{prefix}`
+	segments := strings.SplitN(strings.TrimPrefix(uri.Path, "/"), "/", 2)
+	containerName := segments[0]
+	if containerName == "" {
+		return nil, fmt.Errorf("expected %q to contain a Container Name", input)
+	}
+
+	prefix := ""
+	if len(segments) == 2 {
+		prefix = segments[1]
+		if prefix == "" || strings.HasSuffix(prefix, "/") || strings.Contains(prefix, "//") {
+			return nil, fmt.Errorf("expected the Prefix within %q to be non-empty and not contain empty segments", input)
+		}
+	}
+
+	return &StorageBlobDirectoryDataPlaneId{
+		AccountName:   accountName,
+		DomainSuffix:  domainSuffix,
+		ContainerName: containerName,
+		Prefix:        prefix,
+	}, nil
+}
@@ -0,0 +1,227 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageBlobDirectoryDataPlaneIDFormatter(t *testing.T) {
+	testData := []struct {
+		Prefix   string
+		Expected string
+	}{
+		{
+			Prefix:   "",
+			Expected: "https://account1.blob.core.windows.net/container1",
+		},
+		{
+			Prefix:   "website",
+			Expected: "https://account1.blob.core.windows.net/container1/website",
+		},
+		{
+			Prefix:   "website/assets",
+			Expected: "https://account1.blob.core.windows.net/container1/website/assets",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Prefix)
+
+		actual := NewStorageBlobDirectoryDataPlaneId("account1", "core.windows.net", "container1", v.Prefix).ID()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestStorageBlobDirectoryDataPlaneID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *StorageBlobDirectoryDataPlaneId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// resource manager id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+		{
+			// not a blob endpoint
+			Input: "https://account1.table.core.windows.net/container1",
+			Error: true,
+		},
+		{
+			// missing container
+			Input: "https://account1.blob.core.windows.net/",
+			Error: true,
+		},
+		{
+			// trailing slash
+			Input: "https://account1.blob.core.windows.net/container1/",
+			Error: true,
+		},
+		{
+			// empty segment within the prefix
+			Input: "https://account1.blob.core.windows.net/container1/website//assets",
+			Error: true,
+		},
+		{
+			// no prefix
+			Input: "https://account1.blob.core.windows.net/container1",
+			Expected: &StorageBlobDirectoryDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+			},
+		},
+		{
+			// prefix
+			Input: "https://account1.blob.core.windows.net/container1/website",
+			Expected: &StorageBlobDirectoryDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+				Prefix:        "website",
+			},
+		},
+		{
+			// nested prefix in another environment
+			Input: "https://account1.blob.core.chinacloudapi.cn/container1/website/assets",
+			Expected: &StorageBlobDirectoryDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.chinacloudapi.cn",
+				ContainerName: "container1",
+				Prefix:        "website/assets",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageBlobDirectoryDataPlaneID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.AccountName != v.Expected.AccountName {
+			t.Fatalf("Expected %q but got %q for AccountName", v.Expected.AccountName, actual.AccountName)
+		}
+		if actual.DomainSuffix != v.Expected.DomainSuffix {
+			t.Fatalf("Expected %q but got %q for DomainSuffix", v.Expected.DomainSuffix, actual.DomainSuffix)
+		}
+		if actual.ContainerName != v.Expected.ContainerName {
+			t.Fatalf("Expected %q but got %q for ContainerName", v.Expected.ContainerName, actual.ContainerName)
+		}
+		if actual.Prefix != v.Expected.Prefix {
+			t.Fatalf("Expected %q but got %q for Prefix", v.Expected.Prefix, actual.Prefix)
+		}
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
+
+func TestStorageBlobDirectoryDataPlaneIDBlobName(t *testing.T) {
+	testData := []struct {
+		Prefix       string
+		RelativePath string
+		Expected     string
+	}{
+		{
+			Prefix:       "",
+			RelativePath: "index.html",
+			Expected:     "index.html",
+		},
+		{
+			Prefix:       "",
+			RelativePath: "css/site.css",
+			Expected:     "css/site.css",
+		},
+		{
+			Prefix:       "website",
+			RelativePath: "index.html",
+			Expected:     "website/index.html",
+		},
+		{
+			Prefix:       "website/assets",
+			RelativePath: "css/site.css",
+			Expected:     "website/assets/css/site.css",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Prefix, v.RelativePath)
+
+		id := NewStorageBlobDirectoryDataPlaneId("account1", "core.windows.net", "container1", v.Prefix)
+		actual := id.BlobName(v.RelativePath)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+
+		relativePath, ok := id.RelativePath(actual)
+		if !ok || relativePath != v.RelativePath {
+			t.Fatalf("Expected the relative path of %q to be %q but got %q", actual, v.RelativePath, relativePath)
+		}
+	}
+}
+
+func TestStorageBlobDirectoryDataPlaneIDRelativePath(t *testing.T) {
+	testData := []struct {
+		Prefix   string
+		BlobName string
+		Expected string
+		Matches  bool
+	}{
+		{
+			Prefix:   "",
+			BlobName: "",
+			Matches:  false,
+		},
+		{
+			Prefix:   "website",
+			BlobName: "website",
+			Matches:  false,
+		},
+		{
+			Prefix:   "website",
+			BlobName: "websites/index.html",
+			Matches:  false,
+		},
+		{
+			Prefix:   "website",
+			BlobName: "other/index.html",
+			Matches:  false,
+		},
+		{
+			Prefix:   "website",
+			BlobName: "website/css/site.css",
+			Expected: "css/site.css",
+			Matches:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.Prefix, v.BlobName)
+
+		id := NewStorageBlobDirectoryDataPlaneId("account1", "core.windows.net", "container1", v.Prefix)
+		actual, ok := id.RelativePath(v.BlobName)
+		if ok != v.Matches {
+			t.Fatalf("Expected %t but got %t", v.Matches, ok)
+		}
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -31,6 +31,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_share":                      dataSourceStorageShare(),
 		"azurerm_storage_sync":                       dataSourceStorageSync(),
 		"azurerm_storage_sync_group":                 dataSourceStorageSyncGroup(),
+		"azurerm_storage_table_entities":             dataSourceStorageTableEntities(),
 		"azurerm_storage_table_entity":               dataSourceStorageTableEntity(),
 	}
 }
@@ -40,8 +41,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_storage_account":                      resourceStorageAccount(),
 		"azurerm_storage_account_customer_managed_key": resourceStorageAccountCustomerManagedKey(),
+		"azurerm_storage_account_local_user":           resourceStorageAccountLocalUser(),
 		"azurerm_storage_account_network_rules":        resourceStorageAccountNetworkRules(),
 		"azurerm_storage_blob":                         resourceStorageBlob(),
+		"azurerm_storage_blob_directory":               resourceStorageBlobDirectory(),
 		"azurerm_storage_blob_inventory_policy":        resourceStorageBlobInventoryPolicy(),
 		"azurerm_storage_container":                    resourceStorageContainer(),
 		"azurerm_storage_encryption_scope":             resourceStorageEncryptionScope(),
@@ -54,6 +57,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_share_file":                   resourceStorageShareFile(),
 		"azurerm_storage_share_directory":              resourceStorageShareDirectory(),
 		"azurerm_storage_table":                        resourceStorageTable(),
+		"azurerm_storage_table_entities":               resourceStorageTableEntities(),
 		"azurerm_storage_table_entity":                 resourceStorageTableEntity(),
 		"azurerm_storage_sync":                         resourceStorageSync(),
 		"azurerm_storage_sync_cloud_endpoint":          resourceStorageSyncCloudEndpoint(),
@@ -0,0 +1,262 @@
+package localusers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = LocalUserId{}
+
+func TestLocalUserIDFormatter(t *testing.T) {
+	actual := NewLocalUserID("{subscriptionId}", "{resourceGroupName}", "{storageAccountName}", "{username}").ID()
+	expected := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/{username}"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLocalUserID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *LocalUserId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing StorageAccountName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/",
+			Error: true,
+		},
+
+		{
+			// missing value for StorageAccountName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/{username}",
+			Expected: &LocalUserId{
+				SubscriptionId:     "{subscriptionId}",
+				ResourceGroup:      "{resourceGroupName}",
+				StorageAccountName: "{storageAccountName}",
+				Name:               "{username}",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/{SUBSCRIPTIONID}/RESOURCEGROUPS/{RESOURCEGROUPNAME}/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/{STORAGEACCOUNTNAME}/LOCALUSERS/{USERNAME}",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := LocalUserID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.StorageAccountName != v.Expected.StorageAccountName {
+			t.Fatalf("Expected %q but got %q for StorageAccountName", v.Expected.StorageAccountName, actual.StorageAccountName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestLocalUserIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *LocalUserId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing StorageAccountName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/",
+			Error: true,
+		},
+
+		{
+			// missing value for StorageAccountName
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/{username}",
+			Expected: &LocalUserId{
+				SubscriptionId:     "{subscriptionId}",
+				ResourceGroup:      "{resourceGroupName}",
+				StorageAccountName: "{storageAccountName}",
+				Name:               "{username}",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/storageAccounts/{storageAccountName}/localUsers/{username}",
+			Expected: &LocalUserId{
+				SubscriptionId:     "{subscriptionId}",
+				ResourceGroup:      "{resourceGroupName}",
+				StorageAccountName: "{storageAccountName}",
+				Name:               "{username}",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/STORAGEACCOUNTS/{storageAccountName}/LOCALUSERS/{username}",
+			Expected: &LocalUserId{
+				SubscriptionId:     "{subscriptionId}",
+				ResourceGroup:      "{resourceGroupName}",
+				StorageAccountName: "{storageAccountName}",
+				Name:               "{username}",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Storage/StOrAgEaCcOuNtS/{storageAccountName}/LoCaLuSeRs/{username}",
+			Expected: &LocalUserId{
+				SubscriptionId:     "{subscriptionId}",
+				ResourceGroup:      "{resourceGroupName}",
+				StorageAccountName: "{storageAccountName}",
+				Name:               "{username}",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := LocalUserIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.StorageAccountName != v.Expected.StorageAccountName {
+			t.Fatalf("Expected %q but got %q for StorageAccountName", v.Expected.StorageAccountName, actual.StorageAccountName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
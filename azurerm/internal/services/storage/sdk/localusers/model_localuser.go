@@ -0,0 +1,8 @@
+package localusers
+
+type LocalUser struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *LocalUserProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
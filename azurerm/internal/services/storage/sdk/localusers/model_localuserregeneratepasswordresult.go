@@ -0,0 +1,5 @@
+package localusers
+
+type LocalUserRegeneratePasswordResult struct {
+	SshPassword *string `json:"sshPassword,omitempty"`
+}
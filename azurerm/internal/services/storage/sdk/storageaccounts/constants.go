@@ -0,0 +1,110 @@
+package storageaccounts
+
+type AccessTier string
+
+const (
+	AccessTierCool AccessTier = "Cool"
+	AccessTierHot  AccessTier = "Hot"
+)
+
+type AccountImmutabilityPolicyState string
+
+const (
+	AccountImmutabilityPolicyStateDisabled AccountImmutabilityPolicyState = "Disabled"
+	AccountImmutabilityPolicyStateLocked   AccountImmutabilityPolicyState = "Locked"
+	AccountImmutabilityPolicyStateUnlocked AccountImmutabilityPolicyState = "Unlocked"
+)
+
+type Action string
+
+const (
+	ActionAllow Action = "Allow"
+)
+
+type Bypass string
+
+const (
+	BypassAzureServices Bypass = "AzureServices"
+	BypassLogging       Bypass = "Logging"
+	BypassMetrics       Bypass = "Metrics"
+	BypassNone          Bypass = "None"
+)
+
+type DefaultAction string
+
+const (
+	DefaultActionAllow DefaultAction = "Allow"
+	DefaultActionDeny  DefaultAction = "Deny"
+)
+
+type DirectoryServiceOptions string
+
+const (
+	DirectoryServiceOptionsAADDS DirectoryServiceOptions = "AADDS"
+	DirectoryServiceOptionsAD    DirectoryServiceOptions = "AD"
+	DirectoryServiceOptionsNone  DirectoryServiceOptions = "None"
+)
+
+type IdentityType string
+
+const (
+	IdentityTypeNone                       IdentityType = "None"
+	IdentityTypeSystemAssigned             IdentityType = "SystemAssigned"
+	IdentityTypeSystemAssignedUserAssigned IdentityType = "SystemAssigned,UserAssigned"
+	IdentityTypeUserAssigned               IdentityType = "UserAssigned"
+)
+
+type Kind string
+
+const (
+	KindBlobStorage      Kind = "BlobStorage"
+	KindBlockBlobStorage Kind = "BlockBlobStorage"
+	KindFileStorage      Kind = "FileStorage"
+	KindStorage          Kind = "Storage"
+	KindStorageVTwo      Kind = "StorageV2"
+)
+
+type LargeFileSharesState string
+
+const (
+	LargeFileSharesStateDisabled LargeFileSharesState = "Disabled"
+	LargeFileSharesStateEnabled  LargeFileSharesState = "Enabled"
+)
+
+type MinimumTlsVersion string
+
+const (
+	MinimumTlsVersionTLSOneOne  MinimumTlsVersion = "TLS1_1"
+	MinimumTlsVersionTLSOneTwo  MinimumTlsVersion = "TLS1_2"
+	MinimumTlsVersionTLSOneZero MinimumTlsVersion = "TLS1_0"
+)
+
+type RoutingChoice string
+
+const (
+	RoutingChoiceInternetRouting  RoutingChoice = "InternetRouting"
+	RoutingChoiceMicrosoftRouting RoutingChoice = "MicrosoftRouting"
+)
+
+type SkuName string
+
+const (
+	SkuNamePremiumLRS     SkuName = "Premium_LRS"
+	SkuNamePremiumZRS     SkuName = "Premium_ZRS"
+	SkuNameStandardGRS    SkuName = "Standard_GRS"
+	SkuNameStandardGZRS   SkuName = "Standard_GZRS"
+	SkuNameStandardLRS    SkuName = "Standard_LRS"
+	SkuNameStandardRAGRS  SkuName = "Standard_RAGRS"
+	SkuNameStandardRAGZRS SkuName = "Standard_RAGZRS"
+	SkuNameStandardZRS    SkuName = "Standard_ZRS"
+)
+
+type State string
+
+const (
+	StateDeprovisioning       State = "Deprovisioning"
+	StateFailed               State = "Failed"
+	StateNetworkSourceDeleted State = "NetworkSourceDeleted"
+	StateProvisioning         State = "Provisioning"
+	StateSucceeded            State = "Succeeded"
+)
@@ -0,0 +1,108 @@
+package storageaccounts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageAccountId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewStorageAccountID(subscriptionId, resourceGroup, name string) StorageAccountId {
+	return StorageAccountId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id StorageAccountId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "StorageAccount", segmentsStr)
+}
+
+func (id StorageAccountId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// StorageAccountID parses a StorageAccount ID into a StorageAccountId struct
+func StorageAccountID(input string) (*StorageAccountId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// StorageAccountIDInsensitively parses a StorageAccount ID into a StorageAccountId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the StorageAccountID method should be used instead for validation etc.
+func StorageAccountIDInsensitively(input string) (*StorageAccountId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'storageAccounts' segment
+	storageAccountsKey := "storageAccounts"
+	for key := range id.Path {
+		if strings.EqualFold(key, storageAccountsKey) {
+			storageAccountsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(storageAccountsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,7 @@
+package storageaccounts
+
+type AccountImmutabilityPolicyProperties struct {
+	AllowProtectedAppendWrites            *bool                           `json:"allowProtectedAppendWrites,omitempty"`
+	ImmutabilityPeriodSinceCreationInDays *int64                          `json:"immutabilityPeriodSinceCreationInDays,omitempty"`
+	State                                 *AccountImmutabilityPolicyState `json:"state,omitempty"`
+}
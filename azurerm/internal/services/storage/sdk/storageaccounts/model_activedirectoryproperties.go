@@ -0,0 +1,10 @@
+package storageaccounts
+
+type ActiveDirectoryProperties struct {
+	AzureStorageSid   string `json:"azureStorageSid"`
+	DomainGuid        string `json:"domainGuid"`
+	DomainName        string `json:"domainName"`
+	DomainSid         string `json:"domainSid"`
+	ForestName        string `json:"forestName"`
+	NetBiosDomainName string `json:"netBiosDomainName"`
+}
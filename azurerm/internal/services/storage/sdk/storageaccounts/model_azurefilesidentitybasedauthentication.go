@@ -0,0 +1,6 @@
+package storageaccounts
+
+type AzureFilesIdentityBasedAuthentication struct {
+	ActiveDirectoryProperties *ActiveDirectoryProperties `json:"activeDirectoryProperties,omitempty"`
+	DirectoryServiceOptions   DirectoryServiceOptions    `json:"directoryServiceOptions"`
+}
@@ -0,0 +1,6 @@
+package storageaccounts
+
+type CustomDomain struct {
+	Name             string `json:"name"`
+	UseSubDomainName *bool  `json:"useSubDomainName,omitempty"`
+}
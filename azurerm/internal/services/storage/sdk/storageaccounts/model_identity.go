@@ -0,0 +1,8 @@
+package storageaccounts
+
+type Identity struct {
+	PrincipalId            *string                          `json:"principalId,omitempty"`
+	TenantId               *string                          `json:"tenantId,omitempty"`
+	Type                   IdentityType                     `json:"type"`
+	UserAssignedIdentities *map[string]UserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storageaccounts
+
+type IPRule struct {
+	Action *Action `json:"action,omitempty"`
+	Value  string  `json:"value"`
+}
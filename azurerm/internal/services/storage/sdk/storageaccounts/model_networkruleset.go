@@ -0,0 +1,9 @@
+package storageaccounts
+
+type NetworkRuleSet struct {
+	Bypass              *Bypass               `json:"bypass,omitempty"`
+	DefaultAction       DefaultAction         `json:"defaultAction"`
+	IpRules             *[]IPRule             `json:"ipRules,omitempty"`
+	ResourceAccessRules *[]ResourceAccessRule `json:"resourceAccessRules,omitempty"`
+	VirtualNetworkRules *[]VirtualNetworkRule `json:"virtualNetworkRules,omitempty"`
+}
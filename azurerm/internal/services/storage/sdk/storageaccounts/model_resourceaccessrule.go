@@ -0,0 +1,6 @@
+package storageaccounts
+
+type ResourceAccessRule struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+	TenantId   *string `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,7 @@
+package storageaccounts
+
+type RoutingPreference struct {
+	PublishInternetEndpoints  *bool          `json:"publishInternetEndpoints,omitempty"`
+	PublishMicrosoftEndpoints *bool          `json:"publishMicrosoftEndpoints,omitempty"`
+	RoutingChoice             *RoutingChoice `json:"routingChoice,omitempty"`
+}
@@ -0,0 +1,5 @@
+package storageaccounts
+
+type Sku struct {
+	Name SkuName `json:"name"`
+}
@@ -0,0 +1,10 @@
+package storageaccounts
+
+type StorageAccountCreateParameters struct {
+	Identity   *Identity                                 `json:"identity,omitempty"`
+	Kind       Kind                                      `json:"kind"`
+	Location   string                                    `json:"location"`
+	Properties *StorageAccountPropertiesCreateParameters `json:"properties,omitempty"`
+	Sku        Sku                                       `json:"sku"`
+	Tags       *map[string]string                        `json:"tags,omitempty"`
+}
@@ -0,0 +1,17 @@
+package storageaccounts
+
+type StorageAccountPropertiesCreateParameters struct {
+	AccessTier                            *AccessTier                            `json:"accessTier,omitempty"`
+	AllowBlobPublicAccess                 *bool                                  `json:"allowBlobPublicAccess,omitempty"`
+	AllowSharedKeyAccess                  *bool                                  `json:"allowSharedKeyAccess,omitempty"`
+	AzureFilesIdentityBasedAuthentication *AzureFilesIdentityBasedAuthentication `json:"azureFilesIdentityBasedAuthentication,omitempty"`
+	CustomDomain                          *CustomDomain                          `json:"customDomain,omitempty"`
+	ImmutableStorageWithVersioning        *ImmutableStorageAccount               `json:"immutableStorageWithVersioning,omitempty"`
+	IsHnsEnabled                          *bool                                  `json:"isHnsEnabled,omitempty"`
+	IsNfsV3Enabled                        *bool                                  `json:"isNfsV3Enabled,omitempty"`
+	LargeFileSharesState                  *LargeFileSharesState                  `json:"largeFileSharesState,omitempty"`
+	MinimumTlsVersion                     *MinimumTlsVersion                     `json:"minimumTlsVersion,omitempty"`
+	NetworkAcls                           *NetworkRuleSet                        `json:"networkAcls,omitempty"`
+	RoutingPreference                     *RoutingPreference                     `json:"routingPreference,omitempty"`
+	SupportsHttpsTrafficOnly              *bool                                  `json:"supportsHttpsTrafficOnly,omitempty"`
+}
@@ -0,0 +1,7 @@
+package storageaccounts
+
+type StorageAccountPropertiesUpdateParameters struct {
+	ImmutableStorageWithVersioning *ImmutableStorageAccount `json:"immutableStorageWithVersioning,omitempty"`
+	IsLocalUserEnabled             *bool                    `json:"isLocalUserEnabled,omitempty"`
+	IsSftpEnabled                  *bool                    `json:"isSftpEnabled,omitempty"`
+}
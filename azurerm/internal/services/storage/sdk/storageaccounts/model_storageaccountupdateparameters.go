@@ -0,0 +1,5 @@
+package storageaccounts
+
+type StorageAccountUpdateParameters struct {
+	Properties *StorageAccountPropertiesUpdateParameters `json:"properties,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storageaccounts
+
+type UserAssignedIdentity struct {
+	ClientId    *string `json:"clientId,omitempty"`
+	PrincipalId *string `json:"principalId,omitempty"`
+}
@@ -0,0 +1,7 @@
+package storageaccounts
+
+type VirtualNetworkRule struct {
+	Action *Action `json:"action,omitempty"`
+	Id     string  `json:"id"`
+	State  *State  `json:"state,omitempty"`
+}
@@ -0,0 +1,17 @@
+package tablebatch
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TableBatchClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+// NewTableBatchClientWithBaseURI returns a TableBatchClient for the Table Service at the specified endpoint,
+// for example `https://account1.table.core.windows.net` or `http://127.0.0.1:10002/devstoreaccount1`
+func NewTableBatchClientWithBaseURI(endpoint string) TableBatchClient {
+	return TableBatchClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,20 @@
+package tablebatch
+
+// MaxOperationsPerBatch is the maximum number of operations which can be submitted in a single Entity Group Transaction
+const MaxOperationsPerBatch = 100
+
+type OperationType string
+
+const (
+	OperationTypeDelete          OperationType = "Delete"
+	OperationTypeInsertOrMerge   OperationType = "InsertOrMerge"
+	OperationTypeInsertOrReplace OperationType = "InsertOrReplace"
+)
+
+func PossibleValuesForOperationType() []string {
+	return []string{
+		string(OperationTypeDelete),
+		string(OperationTypeInsertOrMerge),
+		string(OperationTypeInsertOrReplace),
+	}
+}
@@ -0,0 +1,275 @@
+package tablebatch
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-uuid"
+)
+
+type SubmitResponse struct {
+	HttpResponse *http.Response
+	Results      []OperationResult
+}
+
+// Submit submits the specified operations as a single Entity Group Transaction, which is applied atomically.
+// All of the operations must target the same Partition Key, and at most 100 operations can be submitted at once.
+func (c TableBatchClient) Submit(ctx context.Context, tableName string, operations []Operation) (result SubmitResponse, err error) {
+	if err = validateOperations(tableName, operations); err != nil {
+		err = autorest.NewErrorWithError(err, "tablebatch.TableBatchClient", "Submit", nil, "Failure validating input")
+		return
+	}
+
+	req, err := c.preparerForSubmit(ctx, tableName, operations)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tablebatch.TableBatchClient", "Submit", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, autorest.DoRetryForStatusCodes(c.Client.RetryAttempts, c.Client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tablebatch.TableBatchClient", "Submit", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSubmit(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "tablebatch.TableBatchClient", "Submit", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+func validateOperations(tableName string, operations []Operation) error {
+	if tableName == "" {
+		return fmt.Errorf("`tableName` cannot be an empty string")
+	}
+	if len(operations) == 0 {
+		return fmt.Errorf("at least one operation must be specified")
+	}
+	if len(operations) > MaxOperationsPerBatch {
+		return fmt.Errorf("at most %d operations can be submitted in a batch but got %d", MaxOperationsPerBatch, len(operations))
+	}
+
+	partitionKey := operations[0].PartitionKey
+	rowKeys := make(map[string]struct{}, len(operations))
+	for _, operation := range operations {
+		if operation.PartitionKey != partitionKey {
+			return fmt.Errorf("all operations in a batch must use the same Partition Key but got %q and %q", partitionKey, operation.PartitionKey)
+		}
+		if _, exists := rowKeys[operation.RowKey]; exists {
+			return fmt.Errorf("the Row Key %q can only be used once in a batch", operation.RowKey)
+		}
+		rowKeys[operation.RowKey] = struct{}{}
+	}
+
+	return nil
+}
+
+// preparerForSubmit prepares the Submit request.
+func (c TableBatchClient) preparerForSubmit(ctx context.Context, tableName string, operations []Operation) (*http.Request, error) {
+	batchBoundary, err := newBoundary("batch")
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.buildBatchBody(batchBoundary, tableName, operations)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version":          defaultApiVersion,
+		"DataServiceVersion":    "3.0;",
+		"MaxDataServiceVersion": "3.0;NetFx",
+		"Accept-Charset":        "UTF-8",
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType(fmt.Sprintf("multipart/mixed; boundary=%s", batchBoundary)),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath("$batch"),
+		autorest.WithHeaders(headers),
+		autorest.WithBytes(&body))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c TableBatchClient) buildBatchBody(batchBoundary, tableName string, operations []Operation) ([]byte, error) {
+	changeSetBoundary, err := newBoundary("changeset")
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changeSetBoundary)
+
+	for i, operation := range operations {
+		method, err := operation.httpMethod()
+		if err != nil {
+			return nil, err
+		}
+
+		fmt.Fprintf(buf, "--%s\r\n", changeSetBoundary)
+		buf.WriteString("Content-Type: application/http\r\n")
+		buf.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+		fmt.Fprintf(buf, "%s %s HTTP/1.1\r\n", method, c.entityUri(tableName, operation.PartitionKey, operation.RowKey))
+		fmt.Fprintf(buf, "Content-ID: %d\r\n", i+1)
+		buf.WriteString("Accept: application/json;odata=nometadata\r\n")
+		buf.WriteString("DataServiceVersion: 3.0;\r\n")
+
+		if operation.Type == OperationTypeDelete {
+			buf.WriteString("If-Match: *\r\n\r\n")
+			continue
+		}
+
+		entity := make(map[string]interface{}, len(operation.Properties)+2)
+		for k, v := range operation.Properties {
+			entity[k] = v
+		}
+		entity["PartitionKey"] = operation.PartitionKey
+		entity["RowKey"] = operation.RowKey
+
+		payload, err := json.Marshal(entity)
+		if err != nil {
+			return nil, fmt.Errorf("serializing Entity (Partition Key %q / Row Key %q): %+v", operation.PartitionKey, operation.RowKey, err)
+		}
+
+		buf.WriteString("Content-Type: application/json\r\n")
+		fmt.Fprintf(buf, "Content-Length: %d\r\n\r\n", len(payload))
+		buf.Write(payload)
+		buf.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(buf, "--%s--\r\n", changeSetBoundary)
+	fmt.Fprintf(buf, "--%s--\r\n", batchBoundary)
+
+	return buf.Bytes(), nil
+}
+
+func (c TableBatchClient) entityUri(tableName, partitionKey, rowKey string) string {
+	escape := func(input string) string {
+		// single quotes within a key are escaped by doubling them
+		return strings.ReplaceAll(url.PathEscape(input), "%27", "''")
+	}
+
+	return fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", strings.TrimSuffix(c.baseUri, "/"), tableName, escape(partitionKey), escape(rowKey))
+}
+
+func (o Operation) httpMethod() (string, error) {
+	switch o.Type {
+	case OperationTypeDelete:
+		return http.MethodDelete, nil
+	case OperationTypeInsertOrMerge:
+		return "MERGE", nil
+	case OperationTypeInsertOrReplace:
+		return http.MethodPut, nil
+	}
+
+	return "", fmt.Errorf("unsupported Operation Type %q", string(o.Type))
+}
+
+// responderForSubmit handles the response to the Submit request. The method always
+// closes the http.Response Body.
+func (c TableBatchClient) responderForSubmit(resp *http.Response) (result SubmitResponse, err error) {
+	result.HttpResponse = resp
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return result, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(body))
+	}
+
+	results, err := parseBatchResponse(resp.Header.Get("Content-Type"), resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("parsing batch response: %+v", err)
+	}
+	result.Results = results
+
+	// when any operation within a changeset fails, the service returns only the failed operation
+	for _, v := range results {
+		if v.StatusCode >= http.StatusBadRequest {
+			return result, fmt.Errorf("the batch was rejected with status %d: %s", v.StatusCode, v.Body)
+		}
+	}
+
+	return result, nil
+}
+
+func parseBatchResponse(contentType string, body io.Reader) ([]OperationResult, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Content-Type %q: %+v", contentType, err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		// this is a single HTTP response, which is returned for each operation within a changeset
+		content, err := ioutil.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response: %+v", err)
+		}
+		if !bytes.Contains(content, []byte("\r\n\r\n")) {
+			// the blank line terminating the headers of a response without a body is consumed by the multipart boundary
+			content = append(content, []byte("\r\n\r\n")...)
+		}
+
+		response, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(content)), nil)
+		if err != nil {
+			return nil, fmt.Errorf("reading response: %+v", err)
+		}
+		defer response.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response body: %+v", err)
+		}
+
+		return []OperationResult{
+			{
+				StatusCode: response.StatusCode,
+				Body:       string(responseBody),
+			},
+		}, nil
+	}
+
+	results := make([]OperationResult, 0)
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading multipart response: %+v", err)
+		}
+
+		partResults, err := parseBatchResponse(part.Header.Get("Content-Type"), part)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, partResults...)
+	}
+
+	return results, nil
+}
+
+func newBoundary(prefix string) (string, error) {
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("generating boundary: %+v", err)
+	}
+
+	return fmt.Sprintf("%s_%s", prefix, id), nil
+}
@@ -0,0 +1,197 @@
+package tablebatch
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/$batch" {
+			t.Errorf("expected a POST to /$batch but got %s %s", r.Method, r.URL.Path)
+		}
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/mixed; boundary=batch_") {
+			t.Errorf("unexpected Content-Type %q", r.Header.Get("Content-Type"))
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		for _, expected := range []string{
+			"PUT http://" + r.Host + "/table1(PartitionKey='p1',RowKey='o''brien') HTTP/1.1",
+			`"Name":"value"`,
+			"DELETE http://" + r.Host + "/table1(PartitionKey='p1',RowKey='r2') HTTP/1.1",
+			"If-Match: *",
+		} {
+			if !strings.Contains(string(body), expected) {
+				t.Errorf("expected the request body to contain %q but got:\n%s", expected, string(body))
+			}
+		}
+
+		w.Header().Set("Content-Type", "multipart/mixed; boundary=batchresponse_1")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, strings.ReplaceAll(`--batchresponse_1
+Content-Type: multipart/mixed; boundary=changesetresponse_1
+
+--changesetresponse_1
+Content-Type: application/http
+Content-Transfer-Encoding: binary
+
+HTTP/1.1 204 No Content
+Content-ID: 1
+
+--changesetresponse_1
+Content-Type: application/http
+Content-Transfer-Encoding: binary
+
+HTTP/1.1 204 No Content
+Content-ID: 2
+
+--changesetresponse_1--
+--batchresponse_1--
+`, "\n", "\r\n"))
+	}))
+	defer server.Close()
+
+	client := NewTableBatchClientWithBaseURI(server.URL)
+	result, err := client.Submit(context.TODO(), "table1", []Operation{
+		{
+			Type:         OperationTypeInsertOrReplace,
+			PartitionKey: "p1",
+			RowKey:       "o'brien",
+			Properties: map[string]interface{}{
+				"Name": "value",
+			},
+		},
+		{
+			Type:         OperationTypeDelete,
+			PartitionKey: "p1",
+			RowKey:       "r2",
+		},
+	})
+	if err != nil {
+		t.Fatalf("submitting batch: %+v", err)
+	}
+
+	if len(result.Results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(result.Results))
+	}
+	for _, v := range result.Results {
+		if v.StatusCode != http.StatusNoContent {
+			t.Fatalf("expected status %d but got %d", http.StatusNoContent, v.StatusCode)
+		}
+	}
+}
+
+func TestSubmitFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "multipart/mixed; boundary=batchresponse_1")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, strings.ReplaceAll(`--batchresponse_1
+Content-Type: multipart/mixed; boundary=changesetresponse_1
+
+--changesetresponse_1
+Content-Type: application/http
+Content-Transfer-Encoding: binary
+
+HTTP/1.1 400 Bad Request
+Content-ID: 1
+Content-Type: application/json
+
+{"odata.error":{"code":"InvalidInput","message":{"lang":"en-US","value":"1:Bad Request - Error in query syntax."}}}
+--changesetresponse_1--
+--batchresponse_1--
+`, "\n", "\r\n"))
+	}))
+	defer server.Close()
+
+	client := NewTableBatchClientWithBaseURI(server.URL)
+	_, err := client.Submit(context.TODO(), "table1", []Operation{
+		{
+			Type:         OperationTypeInsertOrMerge,
+			PartitionKey: "p1",
+			RowKey:       "r1",
+		},
+	})
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "InvalidInput") {
+		t.Fatalf("expected the error to contain the failed operation but got: %+v", err)
+	}
+}
+
+// TestSubmitAzurite runs against a local Azurite instance (e.g. `azurite-table`) when `AZURITE_TABLE_ENDPOINT`
+// is set, for example to `http://127.0.0.1:10002/devstoreaccount1`
+func TestSubmitAzurite(t *testing.T) {
+	endpoint := os.Getenv("AZURITE_TABLE_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("`AZURITE_TABLE_ENDPOINT` isn't set - skipping")
+	}
+
+	// these are the well-known development credentials used by Azurite
+	authorizer, err := autorest.NewSharedKeyAuthorizer("devstoreaccount1", "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw==", autorest.SharedKeyLiteForTable)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	ctx := context.TODO()
+	tableName := fmt.Sprintf("batch%d", os.Getpid())
+	sender := autorest.NewClientWithUserAgent(userAgent())
+	sender.Authorizer = authorizer
+
+	req, err := autorest.Prepare(&http.Request{},
+		autorest.AsPost(),
+		autorest.AsJSON(),
+		autorest.WithBaseURL(endpoint),
+		autorest.WithPath("Tables"),
+		autorest.WithHeader("x-ms-version", defaultApiVersion),
+		autorest.WithJSON(map[string]string{"TableName": tableName}))
+	if err != nil {
+		t.Fatalf("preparing create table request: %+v", err)
+	}
+	resp, err := sender.Send(req.WithContext(ctx))
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		t.Fatalf("creating table %q: %+v", tableName, err)
+	}
+
+	client := NewTableBatchClientWithBaseURI(endpoint)
+	client.Client.Authorizer = authorizer
+
+	operations := make([]Operation, 0)
+	for i := 0; i < 150; i++ {
+		operations = append(operations, Operation{
+			Type:         OperationTypeInsertOrReplace,
+			PartitionKey: fmt.Sprintf("p%d", i%2),
+			RowKey:       fmt.Sprintf("r%d", i),
+			Properties: map[string]interface{}{
+				"Value": fmt.Sprintf("%d", i),
+			},
+		})
+	}
+
+	for _, batch := range PartitionOperations(operations) {
+		if _, err := client.Submit(ctx, tableName, batch); err != nil {
+			t.Fatalf("submitting batch: %+v", err)
+		}
+	}
+
+	deletes := []Operation{
+		{Type: OperationTypeDelete, PartitionKey: "p0", RowKey: "r0"},
+		{Type: OperationTypeDelete, PartitionKey: "p0", RowKey: "r2"},
+	}
+	if _, err := client.Submit(ctx, tableName, deletes); err != nil {
+		t.Fatalf("submitting delete batch: %+v", err)
+	}
+
+	// deleting an Entity which no longer exists fails the whole batch
+	if _, err := client.Submit(ctx, tableName, deletes); err == nil {
+		t.Fatalf("expected deleting Entities which don't exist to fail")
+	}
+}
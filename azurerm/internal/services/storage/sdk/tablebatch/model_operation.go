@@ -0,0 +1,10 @@
+package tablebatch
+
+type Operation struct {
+	Type         OperationType
+	PartitionKey string
+	RowKey       string
+
+	// Properties are the properties of the Entity, which are ignored for Delete operations
+	Properties map[string]interface{}
+}
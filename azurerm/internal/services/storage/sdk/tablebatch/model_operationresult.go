@@ -0,0 +1,6 @@
+package tablebatch
+
+type OperationResult struct {
+	StatusCode int
+	Body       string
+}
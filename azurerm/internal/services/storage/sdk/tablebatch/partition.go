@@ -0,0 +1,32 @@
+package tablebatch
+
+import "sort"
+
+// PartitionOperations splits the specified operations into batches which can be submitted as Entity Group
+// Transactions - where each batch contains at most 100 operations which all target the same Partition Key.
+// Batches are returned ordered by Partition Key, retaining the order of the operations within each Partition Key.
+func PartitionOperations(operations []Operation) [][]Operation {
+	partitions := make(map[string][]Operation)
+	partitionKeys := make([]string, 0)
+	for _, operation := range operations {
+		if _, ok := partitions[operation.PartitionKey]; !ok {
+			partitionKeys = append(partitionKeys, operation.PartitionKey)
+		}
+		partitions[operation.PartitionKey] = append(partitions[operation.PartitionKey], operation)
+	}
+	sort.Strings(partitionKeys)
+
+	batches := make([][]Operation, 0)
+	for _, partitionKey := range partitionKeys {
+		items := partitions[partitionKey]
+		for start := 0; start < len(items); start += MaxOperationsPerBatch {
+			end := start + MaxOperationsPerBatch
+			if end > len(items) {
+				end = len(items)
+			}
+			batches = append(batches, items[start:end])
+		}
+	}
+
+	return batches
+}
@@ -0,0 +1,94 @@
+package tablebatch
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPartitionOperations(t *testing.T) {
+	operations := make([]Operation, 0)
+	for i := 0; i < 250; i++ {
+		operations = append(operations, Operation{
+			Type:         OperationTypeInsertOrReplace,
+			PartitionKey: "b",
+			RowKey:       fmt.Sprintf("row%d", i),
+		})
+	}
+	operations = append(operations, Operation{
+		Type:         OperationTypeDelete,
+		PartitionKey: "a",
+		RowKey:       "row0",
+	})
+
+	batches := PartitionOperations(operations)
+	expectedSizes := []int{1, 100, 100, 50}
+	if len(batches) != len(expectedSizes) {
+		t.Fatalf("expected %d batches but got %d", len(expectedSizes), len(batches))
+	}
+
+	for i, batch := range batches {
+		if len(batch) != expectedSizes[i] {
+			t.Fatalf("expected batch %d to contain %d operations but got %d", i, expectedSizes[i], len(batch))
+		}
+
+		if err := validateOperations("table1", batch); err != nil {
+			t.Fatalf("expected batch %d to be valid but got: %+v", i, err)
+		}
+	}
+
+	if batches[0][0].PartitionKey != "a" {
+		t.Fatalf("expected the first batch to be for Partition Key %q but got %q", "a", batches[0][0].PartitionKey)
+	}
+	if batches[1][0].RowKey != "row0" || batches[3][49].RowKey != "row249" {
+		t.Fatalf("expected the order of operations within a partition to be retained")
+	}
+}
+
+func TestValidateOperations(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Operations []Operation
+		Valid      bool
+	}{
+		{
+			Name:       "Empty",
+			Operations: []Operation{},
+			Valid:      false,
+		},
+		{
+			Name: "Single Operation",
+			Operations: []Operation{
+				{Type: OperationTypeInsertOrMerge, PartitionKey: "a", RowKey: "1"},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Mixed Partition Keys",
+			Operations: []Operation{
+				{Type: OperationTypeInsertOrMerge, PartitionKey: "a", RowKey: "1"},
+				{Type: OperationTypeInsertOrMerge, PartitionKey: "b", RowKey: "2"},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Duplicate Row Keys",
+			Operations: []Operation{
+				{Type: OperationTypeInsertOrMerge, PartitionKey: "a", RowKey: "1"},
+				{Type: OperationTypeDelete, PartitionKey: "a", RowKey: "1"},
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := validateOperations("table1", v.Operations)
+		if v.Valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Name, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected %q to be invalid", v.Name)
+		}
+	}
+}
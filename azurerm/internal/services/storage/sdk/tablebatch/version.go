@@ -0,0 +1,9 @@
+package tablebatch
+
+import "fmt"
+
+const defaultApiVersion = "2019-12-12"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/tablebatch/%s", defaultApiVersion)
+}
@@ -88,6 +88,15 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, _, accountNam
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) ListBlobs(ctx context.Context, _, accountName, containerName string, input containers.ListBlobsInput) (*containers.ListBlobsResult, error) {
+	result, err := w.client.ListBlobs(ctx, accountName, containerName, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, _, accountName, containerName string, level containers.AccessLevel) error {
 	_, err := w.client.SetAccessControl(ctx, accountName, containerName, level)
 	return err
@@ -0,0 +1,476 @@
+package storage
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/localusers"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceStorageAccountLocalUser() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountLocalUserCreate,
+		Read:   resourceStorageAccountLocalUserRead,
+		Update: resourceStorageAccountLocalUserUpdate,
+		Delete: resourceStorageAccountLocalUserDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := localusers.LocalUserID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountLocalUserName,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"ssh_key_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// the password is generated by the service, so toggling this requires a new Local User
+			"ssh_password_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"home_directory": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"ssh_authorized_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"permission_scope": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blob",
+								"file",
+							}, false),
+						},
+
+						"resource_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"permissions": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"read": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"write": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"delete": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"list": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"create": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"password": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sid": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageAccountLocalUserCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := localusers.NewLocalUserID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_account_local_user", id.ID())
+	}
+
+	passwordEnabled := d.Get("ssh_password_enabled").(bool)
+	input := localusers.LocalUser{
+		Properties: &localusers.LocalUserProperties{
+			HasSshKey:         utils.Bool(d.Get("ssh_key_enabled").(bool)),
+			HasSshPassword:    utils.Bool(passwordEnabled),
+			PermissionScopes:  expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{})),
+			SshAuthorizedKeys: expandStorageAccountLocalUserSshAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{})),
+		},
+	}
+	if v := d.Get("home_directory").(string); v != "" {
+		input.Properties.HomeDirectory = utils.String(v)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if passwordEnabled {
+		resp, err := client.RegeneratePassword(ctx, id)
+		if err != nil {
+			return fmt.Errorf("generating password for %s: %+v", id, err)
+		}
+		if model := resp.Model; model != nil && model.SshPassword != nil {
+			d.Set("password", *model.SshPassword)
+		}
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	input := localusers.LocalUser{
+		Properties: existing.Model.Properties,
+	}
+
+	// the read-only properties are rejected by the API
+	input.Properties.Sid = nil
+	input.Properties.HasSharedKey = nil
+
+	if d.HasChange("ssh_key_enabled") {
+		input.Properties.HasSshKey = utils.Bool(d.Get("ssh_key_enabled").(bool))
+	}
+
+	if d.HasChange("home_directory") {
+		input.Properties.HomeDirectory = utils.String(d.Get("home_directory").(string))
+	}
+
+	if d.HasChange("permission_scope") {
+		input.Properties.PermissionScopes = expandStorageAccountLocalUserPermissionScopes(d.Get("permission_scope").([]interface{}))
+	}
+
+	// the SSH Authorized Keys aren't returned by the API, so these always need to be sent
+	input.Properties.SshAuthorizedKeys = expandStorageAccountLocalUserSshAuthorizedKeys(d.Get("ssh_authorized_key").([]interface{}))
+
+	if _, err := client.CreateOrUpdate(ctx, *id, input); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageAccountLocalUserRead(d, meta)
+}
+
+func resourceStorageAccountLocalUserRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("storage_account_id", parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			sshKeyEnabled := false
+			if props.HasSshKey != nil {
+				sshKeyEnabled = *props.HasSshKey
+			}
+			d.Set("ssh_key_enabled", sshKeyEnabled)
+
+			sshPasswordEnabled := false
+			if props.HasSshPassword != nil {
+				sshPasswordEnabled = *props.HasSshPassword
+			}
+			d.Set("ssh_password_enabled", sshPasswordEnabled)
+
+			homeDirectory := ""
+			if props.HomeDirectory != nil {
+				homeDirectory = *props.HomeDirectory
+			}
+			d.Set("home_directory", homeDirectory)
+
+			sid := ""
+			if props.Sid != nil {
+				sid = *props.Sid
+			}
+			d.Set("sid", sid)
+
+			if err := d.Set("permission_scope", flattenStorageAccountLocalUserPermissionScopes(props.PermissionScopes)); err != nil {
+				return fmt.Errorf("setting `permission_scope`: %+v", err)
+			}
+
+			// the SSH Authorized Keys are only returned when they're retrieved explicitly, so we only update them when present
+			if props.SshAuthorizedKeys != nil {
+				if err := d.Set("ssh_authorized_key", flattenStorageAccountLocalUserSshAuthorizedKeys(props.SshAuthorizedKeys)); err != nil {
+					return fmt.Errorf("setting `ssh_authorized_key`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageAccountLocalUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.LocalUsersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := localusers.LocalUserID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageAccountLocalUserSshAuthorizedKeys(input []interface{}) *[]localusers.SshPublicKey {
+	results := make([]localusers.SshPublicKey, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		key := localusers.SshPublicKey{
+			Key: utils.String(v["key"].(string)),
+		}
+		if description := v["description"].(string); description != "" {
+			key.Description = utils.String(description)
+		}
+
+		results = append(results, key)
+	}
+
+	return &results
+}
+
+func flattenStorageAccountLocalUserSshAuthorizedKeys(input *[]localusers.SshPublicKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		key := ""
+		if item.Key != nil {
+			key = *item.Key
+		}
+
+		description := ""
+		if item.Description != nil {
+			description = *item.Description
+		}
+
+		results = append(results, map[string]interface{}{
+			"key":         key,
+			"description": description,
+		})
+	}
+
+	return results
+}
+
+func expandStorageAccountLocalUserPermissionScopes(input []interface{}) *[]localusers.PermissionScope {
+	results := make([]localusers.PermissionScope, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		results = append(results, localusers.PermissionScope{
+			Permissions:  expandStorageAccountLocalUserPermissions(v["permissions"].([]interface{})),
+			ResourceName: v["resource_name"].(string),
+			Service:      v["service"].(string),
+		})
+	}
+
+	return &results
+}
+
+func flattenStorageAccountLocalUserPermissionScopes(input *[]localusers.PermissionScope) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"permissions":   flattenStorageAccountLocalUserPermissions(item.Permissions),
+			"resource_name": item.ResourceName,
+			"service":       item.Service,
+		})
+	}
+
+	return results
+}
+
+// expandStorageAccountLocalUserPermissions builds the permissions string, which the API expects in the order `rwdlc`
+func expandStorageAccountLocalUserPermissions(input []interface{}) string {
+	if len(input) == 0 || input[0] == nil {
+		return ""
+	}
+	v := input[0].(map[string]interface{})
+
+	permissions := ""
+	if v["read"].(bool) {
+		permissions += "r"
+	}
+	if v["write"].(bool) {
+		permissions += "w"
+	}
+	if v["delete"].(bool) {
+		permissions += "d"
+	}
+	if v["list"].(bool) {
+		permissions += "l"
+	}
+	if v["create"].(bool) {
+		permissions += "c"
+	}
+
+	return permissions
+}
+
+func flattenStorageAccountLocalUserPermissions(input string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"read":   strings.Contains(input, "r"),
+			"write":  strings.Contains(input, "w"),
+			"delete": strings.Contains(input, "d"),
+			"list":   strings.Contains(input, "l"),
+			"create": strings.Contains(input, "c"),
+		},
+	}
+}
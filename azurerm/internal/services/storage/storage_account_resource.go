@@ -21,6 +21,7 @@ import (
 	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/migration"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/storageaccounts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -233,6 +234,42 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"immutability_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"allow_protected_append_writes": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"state": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storageaccounts.AccountImmutabilityPolicyStateDisabled),
+								string(storageaccounts.AccountImmutabilityPolicyStateLocked),
+								string(storageaccounts.AccountImmutabilityPolicyStateUnlocked),
+							}, false),
+						},
+
+						"period_since_creation_in_days": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 146000),
+						},
+					},
+				},
+			},
+
 			"allow_blob_public_access": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -416,6 +453,22 @@ func resourceStorageAccount() *pluginsdk.Resource {
 								},
 							},
 						},
+
+						"restore_policy": {
+							Type:         pluginsdk.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							RequiredWith: []string{"blob_properties.0.delete_retention_policy"},
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"days": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -857,8 +910,27 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
+
+				if d.Get("sftp_enabled").(bool) && !d.Get("is_hns_enabled").(bool) {
+					return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true`")
+				}
+
+				if restorePolicy := d.Get("blob_properties.0.restore_policy").([]interface{}); len(restorePolicy) > 0 && restorePolicy[0] != nil {
+					if !d.Get("blob_properties.0.versioning_enabled").(bool) || !d.Get("blob_properties.0.change_feed_enabled").(bool) {
+						return fmt.Errorf("`blob_properties.0.restore_policy` requires that both `versioning_enabled` and `change_feed_enabled` are `true`")
+					}
+
+					restoreDays := restorePolicy[0].(map[string]interface{})["days"].(int)
+					if deleteDays := d.Get("blob_properties.0.delete_retention_policy.0.days").(int); restoreDays >= deleteDays {
+						return fmt.Errorf("`blob_properties.0.restore_policy.0.days` (%d) must be less than `blob_properties.0.delete_retention_policy.0.days` (%d)", restoreDays, deleteDays)
+					}
+				}
 				return nil
 			}),
+			// the account level immutability policy can be updated, but can't be removed once it's been enabled
+			pluginsdk.ForceNewIfChange("immutability_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) != len(new.([]interface{}))
+			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
 
@@ -1023,13 +1095,24 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
-	if err != nil {
-		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
-	}
+	if immutabilityPolicy := expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{})); immutabilityPolicy != nil {
+		// the account level immutability policy can only be enabled when the account is created, and is only available
+		// in a newer API version than the one used for the rest of the account - so the account is created using that
+		accountId := storageaccounts.NewStorageAccountID(meta.(*clients.Client).Account.SubscriptionId, resourceGroupName, storageAccountName)
+		input := expandStorageAccountCreateParameters(parameters)
+		input.Properties.ImmutableStorageWithVersioning = immutabilityPolicy
+		if err := meta.(*clients.Client).Storage.StorageAccountsClient.CreateThenPoll(ctx, accountId, input); err != nil {
+			return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
+		}
+	} else {
+		future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
+		if err != nil {
+			return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
+		}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, err)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Azure Storage Account %q to be created: %+v", storageAccountName, err)
+		}
 	}
 
 	account, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
@@ -1071,6 +1154,20 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// SFTP is only available in a newer API version, so is enabled once the account exists
+	if d.Get("sftp_enabled").(bool) {
+		accountId := storageaccounts.NewStorageAccountID(meta.(*clients.Client).Account.SubscriptionId, resourceGroupName, storageAccountName)
+		input := storageaccounts.StorageAccountUpdateParameters{
+			Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+				IsSftpEnabled: utils.Bool(true),
+			},
+		}
+
+		if _, err := meta.(*clients.Client).Storage.StorageAccountsClient.Update(ctx, accountId, input); err != nil {
+			return fmt.Errorf("updating `sftp_enabled` for %s: %+v", accountId, err)
+		}
+	}
+
 	if val, ok := d.GetOk("queue_properties"); ok {
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
@@ -1439,6 +1536,23 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChanges("sftp_enabled", "immutability_policy") {
+		accountId := storageaccounts.NewStorageAccountID(id.SubscriptionID, resourceGroupName, storageAccountName)
+		input := storageaccounts.StorageAccountUpdateParameters{
+			Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+				IsSftpEnabled: utils.Bool(d.Get("sftp_enabled").(bool)),
+			},
+		}
+
+		if d.HasChange("immutability_policy") {
+			input.Properties.ImmutableStorageWithVersioning = expandStorageAccountImmutabilityPolicy(d.Get("immutability_policy").([]interface{}))
+		}
+
+		if _, err := meta.(*clients.Client).Storage.StorageAccountsClient.Update(ctx, accountId, input); err != nil {
+			return fmt.Errorf("updating `sftp_enabled` and `immutability_policy` for %s: %+v", accountId, err)
+		}
+	}
+
 	if d.HasChange("queue_properties") {
 		storageClient := meta.(*clients.Client).Storage
 		account, err := storageClient.FindAccount(ctx, storageAccountName)
@@ -1668,6 +1782,31 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
+	// these are retrieved using a newer API version, which may not be available in every cloud - so this is only
+	// required to succeed when either of them is configured
+	accountId := storageaccounts.NewStorageAccountID(id.SubscriptionID, resGroup, name)
+	accountProps, err := meta.(*clients.Client).Storage.StorageAccountsClient.GetProperties(ctx, accountId)
+	if err != nil {
+		if d.Get("sftp_enabled").(bool) || len(d.Get("immutability_policy").([]interface{})) > 0 {
+			return fmt.Errorf("retrieving `sftp_enabled` and `immutability_policy` for %s: %+v", accountId, err)
+		}
+
+		log.Printf("[DEBUG] unable to retrieve `sftp_enabled` and `immutability_policy` for %s - skipping: %+v", accountId, err)
+	}
+
+	sftpEnabled := false
+	immutabilityPolicy := make([]interface{}, 0)
+	if model := accountProps.Model; err == nil && model != nil && model.Properties != nil {
+		if model.Properties.IsSftpEnabled != nil {
+			sftpEnabled = *model.Properties.IsSftpEnabled
+		}
+		immutabilityPolicy = flattenStorageAccountImmutabilityPolicy(model.Properties.ImmutableStorageWithVersioning)
+	}
+	d.Set("sftp_enabled", sftpEnabled)
+	if err := d.Set("immutability_policy", immutabilityPolicy); err != nil {
+		return fmt.Errorf("setting `immutability_policy`: %+v", err)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	account, err := storageClient.FindAccount(ctx, name)
 	if err != nil {
@@ -2029,9 +2168,26 @@ func expandBlobProperties(input []interface{}) *storage.BlobServiceProperties {
 		props.DefaultServiceVersion = utils.String(version)
 	}
 
+	props.RestorePolicy = expandBlobPropertiesRestorePolicy(v["restore_policy"].([]interface{}))
+
 	return &props
 }
 
+func expandBlobPropertiesRestorePolicy(input []interface{}) *storage.RestorePolicyProperties {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.RestorePolicyProperties{
+			Enabled: utils.Bool(false),
+		}
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &storage.RestorePolicyProperties{
+		Enabled: utils.Bool(true),
+		Days:    utils.Int32(int32(policy["days"].(int))),
+	}
+}
+
 func expandBlobPropertiesDeleteRetentionPolicy(input []interface{}, isupdate bool) *storage.DeleteRetentionPolicy {
 	result := storage.DeleteRetentionPolicy{
 		Enabled: utils.Bool(false),
@@ -2471,6 +2627,18 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 		LastAccessTimeTrackingPolicy = *v.Enable
 	}
 
+	flattenedRestorePolicy := make([]interface{}, 0)
+	if restorePolicy := input.BlobServicePropertiesProperties.RestorePolicy; restorePolicy != nil && restorePolicy.Enabled != nil && *restorePolicy.Enabled {
+		days := 0
+		if restorePolicy.Days != nil {
+			days = int(*restorePolicy.Days)
+		}
+
+		flattenedRestorePolicy = append(flattenedRestorePolicy, map[string]interface{}{
+			"days": days,
+		})
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"cors_rule":                         flattenedCorsRules,
@@ -2480,6 +2648,7 @@ func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
 			"default_service_version":           defaultServiceVersion,
 			"last_access_time_enabled":          LastAccessTimeTrackingPolicy,
 			"container_delete_retention_policy": flattenedContainerDeletePolicy,
+			"restore_policy":                    flattenedRestorePolicy,
 		},
 	}
 }
@@ -2550,6 +2719,196 @@ func flattenBlobPropertiesDeleteRetentionPolicy(input *storage.DeleteRetentionPo
 	return deleteRetentionPolicy
 }
 
+// expandStorageAccountCreateParameters converts the create parameters for the API version used for the rest of the
+// Storage Account into those for the API version which supports the account level immutability policy.
+func expandStorageAccountCreateParameters(input storage.AccountCreateParameters) storageaccounts.StorageAccountCreateParameters {
+	t := tags.ToTypedObject(input.Tags)
+	output := storageaccounts.StorageAccountCreateParameters{
+		Kind:       storageaccounts.Kind(input.Kind),
+		Location:   *input.Location,
+		Properties: &storageaccounts.StorageAccountPropertiesCreateParameters{},
+		Tags:       &t,
+	}
+
+	if input.Sku != nil {
+		output.Sku = storageaccounts.Sku{
+			Name: storageaccounts.SkuName(input.Sku.Name),
+		}
+	}
+
+	if input.Identity != nil {
+		identity := &storageaccounts.Identity{
+			Type: storageaccounts.IdentityType(input.Identity.Type),
+		}
+		if input.Identity.UserAssignedIdentities != nil {
+			userAssignedIdentities := make(map[string]storageaccounts.UserAssignedIdentity)
+			for k := range input.Identity.UserAssignedIdentities {
+				userAssignedIdentities[k] = storageaccounts.UserAssignedIdentity{}
+			}
+			identity.UserAssignedIdentities = &userAssignedIdentities
+		}
+		output.Identity = identity
+	}
+
+	props := input.AccountPropertiesCreateParameters
+	if props == nil {
+		return output
+	}
+
+	output.Properties.AllowBlobPublicAccess = props.AllowBlobPublicAccess
+	output.Properties.AllowSharedKeyAccess = props.AllowSharedKeyAccess
+	output.Properties.IsHnsEnabled = props.IsHnsEnabled
+	output.Properties.IsNfsV3Enabled = props.EnableNfsV3
+	output.Properties.SupportsHttpsTrafficOnly = props.EnableHTTPSTrafficOnly
+
+	if props.AccessTier != "" {
+		accessTier := storageaccounts.AccessTier(props.AccessTier)
+		output.Properties.AccessTier = &accessTier
+	}
+
+	if props.LargeFileSharesState != "" {
+		largeFileSharesState := storageaccounts.LargeFileSharesState(props.LargeFileSharesState)
+		output.Properties.LargeFileSharesState = &largeFileSharesState
+	}
+
+	if props.MinimumTLSVersion != "" {
+		minimumTlsVersion := storageaccounts.MinimumTlsVersion(props.MinimumTLSVersion)
+		output.Properties.MinimumTlsVersion = &minimumTlsVersion
+	}
+
+	if v := props.AzureFilesIdentityBasedAuthentication; v != nil {
+		authentication := &storageaccounts.AzureFilesIdentityBasedAuthentication{
+			DirectoryServiceOptions: storageaccounts.DirectoryServiceOptions(v.DirectoryServiceOptions),
+		}
+		if ad := v.ActiveDirectoryProperties; ad != nil {
+			authentication.ActiveDirectoryProperties = &storageaccounts.ActiveDirectoryProperties{
+				AzureStorageSid:   utils.NormalizeNilableString(ad.AzureStorageSid),
+				DomainGuid:        utils.NormalizeNilableString(ad.DomainGUID),
+				DomainName:        utils.NormalizeNilableString(ad.DomainName),
+				DomainSid:         utils.NormalizeNilableString(ad.DomainSid),
+				ForestName:        utils.NormalizeNilableString(ad.ForestName),
+				NetBiosDomainName: utils.NormalizeNilableString(ad.NetBiosDomainName),
+			}
+		}
+		output.Properties.AzureFilesIdentityBasedAuthentication = authentication
+	}
+
+	if v := props.CustomDomain; v != nil {
+		output.Properties.CustomDomain = &storageaccounts.CustomDomain{
+			Name:             utils.NormalizeNilableString(v.Name),
+			UseSubDomainName: v.UseSubDomainName,
+		}
+	}
+
+	if v := props.NetworkRuleSet; v != nil {
+		networkRules := &storageaccounts.NetworkRuleSet{
+			DefaultAction: storageaccounts.DefaultAction(v.DefaultAction),
+		}
+
+		if v.Bypass != "" {
+			bypass := storageaccounts.Bypass(v.Bypass)
+			networkRules.Bypass = &bypass
+		}
+
+		if v.IPRules != nil {
+			ipRules := make([]storageaccounts.IPRule, 0)
+			for _, rule := range *v.IPRules {
+				action := storageaccounts.Action(rule.Action)
+				ipRules = append(ipRules, storageaccounts.IPRule{
+					Action: &action,
+					Value:  utils.NormalizeNilableString(rule.IPAddressOrRange),
+				})
+			}
+			networkRules.IpRules = &ipRules
+		}
+
+		if v.VirtualNetworkRules != nil {
+			virtualNetworkRules := make([]storageaccounts.VirtualNetworkRule, 0)
+			for _, rule := range *v.VirtualNetworkRules {
+				action := storageaccounts.Action(rule.Action)
+				virtualNetworkRules = append(virtualNetworkRules, storageaccounts.VirtualNetworkRule{
+					Action: &action,
+					Id:     utils.NormalizeNilableString(rule.VirtualNetworkResourceID),
+				})
+			}
+			networkRules.VirtualNetworkRules = &virtualNetworkRules
+		}
+
+		if v.ResourceAccessRules != nil {
+			resourceAccessRules := make([]storageaccounts.ResourceAccessRule, 0)
+			for _, rule := range *v.ResourceAccessRules {
+				resourceAccessRules = append(resourceAccessRules, storageaccounts.ResourceAccessRule{
+					ResourceId: rule.ResourceID,
+					TenantId:   rule.TenantID,
+				})
+			}
+			networkRules.ResourceAccessRules = &resourceAccessRules
+		}
+
+		output.Properties.NetworkAcls = networkRules
+	}
+
+	if v := props.RoutingPreference; v != nil {
+		routingChoice := storageaccounts.RoutingChoice(v.RoutingChoice)
+		output.Properties.RoutingPreference = &storageaccounts.RoutingPreference{
+			PublishInternetEndpoints:  v.PublishInternetEndpoints,
+			PublishMicrosoftEndpoints: v.PublishMicrosoftEndpoints,
+			RoutingChoice:             &routingChoice,
+		}
+	}
+
+	return output
+}
+
+func expandStorageAccountImmutabilityPolicy(input []interface{}) *storageaccounts.ImmutableStorageAccount {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	state := storageaccounts.AccountImmutabilityPolicyState(policy["state"].(string))
+
+	return &storageaccounts.ImmutableStorageAccount{
+		Enabled: utils.Bool(true),
+		ImmutabilityPolicy: &storageaccounts.AccountImmutabilityPolicyProperties{
+			AllowProtectedAppendWrites:            utils.Bool(policy["allow_protected_append_writes"].(bool)),
+			ImmutabilityPeriodSinceCreationInDays: utils.Int64(int64(policy["period_since_creation_in_days"].(int))),
+			State:                                 &state,
+		},
+	}
+}
+
+func flattenStorageAccountImmutabilityPolicy(input *storageaccounts.ImmutableStorageAccount) []interface{} {
+	if input == nil || input.Enabled == nil || !*input.Enabled || input.ImmutabilityPolicy == nil {
+		return []interface{}{}
+	}
+
+	policy := input.ImmutabilityPolicy
+
+	allowProtectedAppendWrites := false
+	if policy.AllowProtectedAppendWrites != nil {
+		allowProtectedAppendWrites = *policy.AllowProtectedAppendWrites
+	}
+
+	periodSinceCreationInDays := 0
+	if policy.ImmutabilityPeriodSinceCreationInDays != nil {
+		periodSinceCreationInDays = int(*policy.ImmutabilityPeriodSinceCreationInDays)
+	}
+
+	state := ""
+	if policy.State != nil {
+		state = string(*policy.State)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allow_protected_append_writes": allowProtectedAppendWrites,
+			"period_since_creation_in_days": periodSinceCreationInDays,
+			"state":                         state,
+		},
+	}
+}
+
 func flattenQueueProperties(input *queues.StorageServiceProperties) []interface{} {
 	if input == nil {
 		return []interface{}{}
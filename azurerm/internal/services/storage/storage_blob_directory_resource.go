@@ -0,0 +1,506 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+const defaultBlobContentType = "application/octet-stream"
+
+func resourceStorageBlobDirectory() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageBlobDirectoryCreate,
+		Read:   resourceStorageBlobDirectoryRead,
+		Update: resourceStorageBlobDirectoryUpdate,
+		Delete: resourceStorageBlobDirectoryDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.StorageBlobDirectoryDataPlaneID(id)
+			return err
+		}, resourceStorageBlobDirectoryImport),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"storage_container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageContainerName,
+			},
+
+			"source": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"prefix": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageBlobDirectoryPrefix,
+			},
+
+			"content_types": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"default_content_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      defaultBlobContentType,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"metadata": MetaDataComputedSchema(),
+
+			// a map of the (relative) path of each file to the Hex encoded MD5 of it's contents,
+			// used to determine which files need to be (re-)uploaded or removed
+			"files": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			source := d.Get("source").(string)
+			if source == "" {
+				return nil
+			}
+
+			files, err := storageBlobDirectoryLocalFiles(source)
+			if err != nil {
+				// the directory may not exist until apply-time (e.g. when generated by another resource)
+				return d.SetNewComputed("files")
+			}
+
+			existing := d.Get("files").(map[string]interface{})
+			if storageBlobDirectoryFilesDiffer(existing, files) {
+				return d.SetNew("files", files)
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceStorageBlobDirectoryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	prefix := d.Get("prefix").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Blobs Client: %s", err)
+	}
+
+	source := d.Get("source").(string)
+	files, err := storageBlobDirectoryLocalFiles(source)
+	if err != nil {
+		return fmt.Errorf("Error reading the contents of the directory %q: %s", source, err)
+	}
+
+	id := parse.NewStorageBlobDirectoryDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, containerName, prefix)
+
+	// the ID is set prior to uploading the files, so that any Blobs which were uploaded before
+	// a failure are tracked (and then removed when this resource is re-created or destroyed)
+	d.SetId(id.ID())
+
+	log.Printf("[DEBUG] Uploading %d files from %q to Container %q within Storage Account %q..", len(files), source, containerName, accountName)
+	uploaded := make(map[string]interface{})
+	for relativePath, contentMD5 := range files {
+		if err := storageBlobDirectoryUploadFile(ctx, d, blobsClient, id, relativePath, contentMD5.(string)); err != nil {
+			d.Set("files", uploaded)
+			return err
+		}
+		uploaded[relativePath] = contentMD5
+	}
+	log.Printf("[DEBUG] Uploaded %d files from %q to Container %q within Storage Account %q.", len(files), source, containerName, accountName)
+
+	d.Set("files", files)
+
+	return resourceStorageBlobDirectoryRead(d, meta)
+}
+
+func resourceStorageBlobDirectoryUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobDirectoryDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.AccountName
+	containerName := id.ContainerName
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Blobs Client: %s", err)
+	}
+
+	source := d.Get("source").(string)
+	files, err := storageBlobDirectoryLocalFiles(source)
+	if err != nil {
+		return fmt.Errorf("Error reading the contents of the directory %q: %s", source, err)
+	}
+
+	oldFilesRaw, _ := d.GetChange("files")
+	oldFiles := oldFilesRaw.(map[string]interface{})
+
+	// when the properties applied to every file change, all of the files need to be uploaded again
+	uploadAll := d.HasChanges("content_types", "default_content_type", "metadata")
+
+	for relativePath, contentMD5 := range files {
+		if existing, ok := oldFiles[relativePath]; ok && !uploadAll && strings.EqualFold(existing.(string), contentMD5.(string)) {
+			continue
+		}
+
+		if err := storageBlobDirectoryUploadFile(ctx, d, blobsClient, *id, relativePath, contentMD5.(string)); err != nil {
+			return err
+		}
+	}
+
+	for relativePath := range oldFiles {
+		if _, ok := files[relativePath]; ok {
+			continue
+		}
+
+		blobName := id.BlobName(relativePath)
+		log.Printf("[DEBUG] Deleting Blob %q (Container %q / Account %q) since %q no longer exists..", blobName, containerName, accountName, relativePath)
+		input := blobs.DeleteInput{
+			DeleteSnapshots: true,
+		}
+		if resp, err := blobsClient.Delete(ctx, accountName, containerName, blobName, input); err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Blob %q (Container %q / Account %q): %s", blobName, containerName, accountName, err)
+		}
+	}
+
+	d.Set("files", files)
+
+	return resourceStorageBlobDirectoryRead(d, meta)
+}
+
+func resourceStorageBlobDirectoryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobDirectoryDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.AccountName
+	containerName := id.ContainerName
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		log.Printf("[DEBUG] Unable to locate Account %q for Container %q - assuming removed & removing from state!", accountName, containerName)
+		d.SetId("")
+		return nil
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Blobs Client: %s", err)
+	}
+
+	// only the Blobs which this resource has uploaded are tracked, any which have been removed
+	// are dropped from the map so that they're uploaded again during the next apply
+	files := make(map[string]interface{})
+	for relativePath := range d.Get("files").(map[string]interface{}) {
+		blobName := id.BlobName(relativePath)
+		props, err := blobsClient.GetProperties(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			if utils.ResponseWasNotFound(props.Response) {
+				log.Printf("[INFO] Blob %q was not found in Container %q / Account %q - removing from `files`", blobName, containerName, accountName)
+				continue
+			}
+
+			return fmt.Errorf("Error retrieving properties for Blob %q (Container %q / Account %q): %s", blobName, containerName, accountName, err)
+		}
+
+		contentMD5 := ""
+		if props.ContentMD5 != "" {
+			contentMD5, err = convertBase64ToHexEncoding(props.ContentMD5)
+			if err != nil {
+				return fmt.Errorf("Error in converting base64 to hex encoding for the MD5 of Blob %q: %s", blobName, err)
+			}
+		}
+		files[relativePath] = contentMD5
+	}
+
+	d.Set("storage_account_name", accountName)
+	d.Set("storage_container_name", containerName)
+	d.Set("prefix", id.Prefix)
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceStorageBlobDirectoryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageBlobDirectoryDataPlaneID(d.Id())
+	if err != nil {
+		return err
+	}
+	accountName := id.AccountName
+	containerName := id.ContainerName
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Container %q: %s", accountName, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	blobsClient, err := storageClient.BlobsClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Blobs Client: %s", err)
+	}
+
+	for relativePath := range d.Get("files").(map[string]interface{}) {
+		blobName := id.BlobName(relativePath)
+		input := blobs.DeleteInput{
+			DeleteSnapshots: true,
+		}
+		if resp, err := blobsClient.Delete(ctx, accountName, containerName, blobName, input); err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Blob %q (Container %q / Account %q): %s", blobName, containerName, accountName, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceStorageBlobDirectoryImport populates `files` from the Blobs which exist within the prefix, since
+// only the tracked files are refreshed during a Read
+func resourceStorageBlobDirectoryImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := parse.StorageBlobDirectoryDataPlaneID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("retrieving Account %q for Container %q: %s", id.AccountName, id.ContainerName, err)
+	}
+	if account == nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	containersClient, err := storageClient.ContainersClient(ctx, *account)
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	input := containers.ListBlobsInput{}
+	if id.Prefix != "" {
+		input.Prefix = utils.String(id.Prefix + "/")
+	}
+
+	files := make(map[string]interface{})
+	for {
+		result, err := containersClient.ListBlobs(ctx, account.ResourceGroup, id.AccountName, id.ContainerName, input)
+		if err != nil {
+			return []*pluginsdk.ResourceData{d}, fmt.Errorf("listing Blobs within Container %q (Account %q): %s", id.ContainerName, id.AccountName, err)
+		}
+
+		for _, blob := range result.Blobs.Blobs {
+			relativePath, ok := id.RelativePath(blob.Name)
+			if !ok {
+				continue
+			}
+
+			contentMD5 := ""
+			if blob.Properties != nil && blob.Properties.ContentMD5 != nil && *blob.Properties.ContentMD5 != "" {
+				contentMD5, err = convertBase64ToHexEncoding(*blob.Properties.ContentMD5)
+				if err != nil {
+					return []*pluginsdk.ResourceData{d}, fmt.Errorf("converting base64 to hex encoding for the MD5 of Blob %q: %s", blob.Name, err)
+				}
+			}
+			files[relativePath] = contentMD5
+		}
+
+		if result.NextMarker == nil || *result.NextMarker == "" {
+			break
+		}
+		input.Marker = result.NextMarker
+	}
+
+	d.Set("files", files)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func storageBlobDirectoryUploadFile(ctx context.Context, d *pluginsdk.ResourceData, blobsClient *blobs.Client, id parse.StorageBlobDirectoryDataPlaneId, relativePath, contentMD5 string) error {
+	accountName := id.AccountName
+	containerName := id.ContainerName
+	blobName := id.BlobName(relativePath)
+
+	// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+	encodedMD5, err := convertHexToBase64Encoding(contentMD5)
+	if err != nil {
+		return fmt.Errorf("Error in converting hex to base64 encoding for the MD5 of %q: %s", relativePath, err)
+	}
+
+	contentType := storageBlobDirectoryContentType(relativePath, d.Get("content_types").(map[string]interface{}), d.Get("default_content_type").(string))
+
+	log.Printf("[DEBUG] Uploading %q to Blob %q (Container %q / Account %q) with Content Type %q..", relativePath, blobName, containerName, accountName, contentType)
+	input := BlobUpload{
+		AccountName:   accountName,
+		ContainerName: containerName,
+		BlobName:      blobName,
+		Client:        blobsClient,
+
+		BlobType:    "Block",
+		ContentType: contentType,
+		ContentMD5:  encodedMD5,
+		MetaData:    ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+		Parallelism: d.Get("parallelism").(int),
+		Source:      filepath.Join(d.Get("source").(string), filepath.FromSlash(relativePath)),
+	}
+	if err := input.Create(ctx); err != nil {
+		return fmt.Errorf("Error uploading %q to Blob %q (Container %q / Account %q): %s", relativePath, blobName, containerName, accountName, err)
+	}
+
+	return nil
+}
+
+// storageBlobDirectoryLocalFiles walks the specified directory, returning a map of the relative
+// path of each file (using forward slashes) to the Hex encoded MD5 of it's contents
+func storageBlobDirectoryLocalFiles(source string) (map[string]interface{}, error) {
+	files := make(map[string]interface{})
+	err := filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(source, filePath)
+		if err != nil {
+			return err
+		}
+
+		contentMD5, err := fileContentMD5(filePath)
+		if err != nil {
+			return fmt.Errorf("computing MD5 of %q: %s", filePath, err)
+		}
+
+		files[filepath.ToSlash(relativePath)] = contentMD5
+		return nil
+	})
+
+	return files, err
+}
+
+func storageBlobDirectoryFilesDiffer(existing map[string]interface{}, files map[string]interface{}) bool {
+	if len(existing) != len(files) {
+		return true
+	}
+
+	for k, v := range files {
+		existingMD5, ok := existing[k]
+		if !ok || !strings.EqualFold(existingMD5.(string), v.(string)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// storageBlobDirectoryContentType returns the Content Type for the specified file, using any
+// user-specified mapping for the file extension before falling back to the system's MIME types
+func storageBlobDirectoryContentType(fileName string, mappings map[string]interface{}, defaultContentType string) string {
+	extension := strings.ToLower(path.Ext(fileName))
+	if extension == "" {
+		return defaultContentType
+	}
+
+	for k, v := range mappings {
+		if strings.EqualFold(strings.TrimPrefix(k, "."), strings.TrimPrefix(extension, ".")) {
+			return v.(string)
+		}
+	}
+
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+
+	return defaultContentType
+}
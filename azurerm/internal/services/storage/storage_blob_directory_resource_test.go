@@ -0,0 +1,249 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type StorageBlobDirectoryResource struct{}
+
+func TestAccStorageBlobDirectory_basic(t *testing.T) {
+	source := createStorageBlobDirectorySource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				data.CheckWithClient(r.blobHasContentType("index.html", "text/html; charset=utf-8")),
+				data.CheckWithClient(r.blobHasContentType("css/site.css", "text/css; charset=utf-8")),
+				data.CheckWithClient(r.blobHasContentType("data/file.bin", "application/octet-stream")),
+			),
+		},
+		data.ImportStep("source", "content_types", "default_content_type", "parallelism", "metadata"),
+	})
+}
+
+func TestAccStorageBlobDirectory_complete(t *testing.T) {
+	source := createStorageBlobDirectorySource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				data.CheckWithClient(r.blobHasContentType("data/file.bin", "application/x-example")),
+			),
+		},
+		data.ImportStep("source", "content_types", "default_content_type", "parallelism", "metadata"),
+	})
+}
+
+func TestAccStorageBlobDirectory_update(t *testing.T) {
+	source := createStorageBlobDirectorySource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory", "test")
+	r := StorageBlobDirectoryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		{
+			PreConfig: func() {
+				if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+				if err := os.WriteFile(filepath.Join(source, "index.html"), []byte("<html><body>updated</body></html>"), 0600); err != nil {
+					t.Fatalf("updating file: %+v", err)
+				}
+				if err := os.WriteFile(filepath.Join(source, "app.js"), []byte("console.log('hello');"), 0600); err != nil {
+					t.Fatalf("writing file: %+v", err)
+				}
+			},
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.app.js").Exists(),
+				check.That(data.ResourceName).Key("files.css/site.css").DoesNotExist(),
+			),
+		},
+	})
+}
+
+func (r StorageBlobDirectoryResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	accountName := state.Attributes["storage_account_name"]
+	containerName := state.Attributes["storage_container_name"]
+	prefix := state.Attributes["prefix"]
+
+	account, err := client.Storage.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, err
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Account %q for Container %q", accountName, containerName)
+	}
+	blobsClient, err := client.Storage.BlobsClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	for key := range state.Attributes {
+		if !strings.HasPrefix(key, "files.") || key == "files.%" {
+			continue
+		}
+
+		blobName := strings.TrimPrefix(key, "files.")
+		if prefix != "" {
+			blobName = path.Join(prefix, blobName)
+		}
+
+		resp, err := blobsClient.GetProperties(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", blobName, containerName, accountName, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageBlobDirectoryResource) blobHasContentType(blobName string, contentType string) func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+	return func(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) error {
+		accountName := state.Attributes["storage_account_name"]
+		containerName := state.Attributes["storage_container_name"]
+		if prefix := state.Attributes["prefix"]; prefix != "" {
+			blobName = path.Join(prefix, blobName)
+		}
+
+		account, err := clients.Storage.FindAccount(ctx, accountName)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			return fmt.Errorf("unable to locate Account %q for Container %q", accountName, containerName)
+		}
+		blobsClient, err := clients.Storage.BlobsClient(ctx, *account)
+		if err != nil {
+			return fmt.Errorf("building Blobs Client: %+v", err)
+		}
+
+		props, err := blobsClient.GetProperties(ctx, accountName, containerName, blobName, blobs.GetPropertiesInput{})
+		if err != nil {
+			return fmt.Errorf("retrieving Blob %q (Container %q / Account %q): %+v", blobName, containerName, accountName, err)
+		}
+
+		if props.ContentType != contentType {
+			return fmt.Errorf("expected Blob %q to have the Content Type %q but got %q", blobName, contentType, props.ContentType)
+		}
+
+		return nil
+	}
+}
+
+func (r StorageBlobDirectoryResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source                 = "%s"
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) complete(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory" "test" {
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  source                 = "%s"
+  prefix                 = "website"
+  parallelism            = 4
+  default_content_type   = "text/plain"
+
+  content_types = {
+    ".bin" = "application/x-example"
+  }
+
+  metadata = {
+    hello = "world"
+  }
+}
+`, r.template(data), filepath.ToSlash(source))
+}
+
+func (r StorageBlobDirectoryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func createStorageBlobDirectorySource(t *testing.T) string {
+	source := t.TempDir()
+
+	files := map[string]string{
+		"index.html":    "<html><body>hello</body></html>",
+		"css/site.css":  "body { color: red; }",
+		"data/file.bin": "some binary content",
+	}
+	for name, contents := range files {
+		filePath := filepath.Join(source, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0700); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(contents), 0600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+
+	return source
+}
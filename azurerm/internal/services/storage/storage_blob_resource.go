@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -100,7 +101,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"source": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
@@ -121,6 +121,7 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
@@ -131,7 +132,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -141,6 +141,48 @@ func resourceStorageBlob() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		// when the contents of the file at `source` changes we need to re-upload the Blob, however changing
+		// the path of `source` to a file with the same contents shouldn't require the Blob to be recreated
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			source := d.Get("source").(string)
+			if source == "" {
+				if d.HasChange("source") && d.Id() != "" {
+					return d.ForceNew("source")
+				}
+				return nil
+			}
+			// only Block Blobs are compared by their contents, so changing `source` for any other type of Blob recreates it
+			if !strings.EqualFold(d.Get("type").(string), "Block") {
+				if d.HasChange("source") && d.Id() != "" {
+					return d.ForceNew("source")
+				}
+				return nil
+			}
+			if d.HasChange("content_md5") {
+				return nil
+			}
+
+			localMD5, err := fileContentMD5(source)
+			if err != nil {
+				// the file may not exist until apply-time (e.g. when generated by another resource)
+				if d.HasChange("source") {
+					return d.ForceNew("source")
+				}
+				return nil
+			}
+
+			if existing := d.Get("content_md5").(string); !strings.EqualFold(existing, localMD5) {
+				if err := d.SetNew("content_md5", localMD5); err != nil {
+					return err
+				}
+				if d.Id() != "" {
+					return d.ForceNew("content_md5")
+				}
+			}
+
+			return nil
+		}),
 	}
 }
 
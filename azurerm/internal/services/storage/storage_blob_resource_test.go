@@ -243,6 +243,48 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockFromLocalFileContentChanged(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_md5").Exists(),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+		{
+			PreConfig: func() {
+				file, err := os.OpenFile(sourceBlob.Name(), os.O_RDWR, 0600)
+				if err != nil {
+					t.Fatalf("Failed to open local source blob file: %s", err)
+				}
+				if err := populateTempFile(file); err != nil {
+					t.Fatalf("Error populating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "type"),
+	})
+}
+
 func TestAccStorageBlob_contentType(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -364,6 +406,47 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_pageFromLocalFileSourceChanged(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+
+	updatedSourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create updated local source blob file")
+	}
+
+	if err := populateTempFile(updatedSourceBlob); err != nil {
+		t.Fatalf("Error populating updated temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pageFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "source"),
+		{
+			Config: r.pageFromLocalBlob(data, updatedSourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, updatedSourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "type", "source"),
+	})
+}
+
 func TestAccStorageBlob_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
 	r := StorageBlobResource{}
@@ -1,12 +1,14 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
@@ -66,6 +68,21 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
+			"immutable_storage_with_versioning": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			// TODO: support for ACL's, Legal Holds and Immutability Policies
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
@@ -82,6 +99,13 @@ func resourceStorageContainer() *pluginsdk.Resource {
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// version-level immutability can be enabled on an existing container, but can't be disabled
+			pluginsdk.ForceNewIfChange("immutable_storage_with_versioning", func(ctx context.Context, old, new, meta interface{}) bool {
+				return expandStorageContainerImmutableStorageWithVersioning(old.([]interface{})) && !expandStorageContainerImmutableStorageWithVersioning(new.([]interface{}))
+			}),
+		),
 	}
 }
 
@@ -131,6 +155,12 @@ func resourceStorageContainerCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id)
+
+	if expandStorageContainerImmutableStorageWithVersioning(d.Get("immutable_storage_with_versioning").([]interface{})) {
+		if err := enableStorageContainerImmutableStorageWithVersioning(ctx, storageClient, account.ResourceGroup, accountName, containerName); err != nil {
+			return err
+		}
+	}
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -180,6 +210,12 @@ func resourceStorageContainerUpdate(d *pluginsdk.ResourceData, meta interface{})
 		log.Printf("[DEBUG] Updated the MetaData for Container %q (Storage Account %q / Resource Group %q)", id.Name, id.AccountName, account.ResourceGroup)
 	}
 
+	if d.HasChange("immutable_storage_with_versioning") && expandStorageContainerImmutableStorageWithVersioning(d.Get("immutable_storage_with_versioning").([]interface{})) {
+		if err := enableStorageContainerImmutableStorageWithVersioning(ctx, storageClient, account.ResourceGroup, id.AccountName, id.Name); err != nil {
+			return err
+		}
+	}
+
 	return resourceStorageContainerRead(d, meta)
 }
 
@@ -230,6 +266,25 @@ func resourceStorageContainerRead(d *pluginsdk.ResourceData, meta interface{}) e
 	d.Set("has_immutability_policy", props.HasImmutabilityPolicy)
 	d.Set("has_legal_hold", props.HasLegalHold)
 
+	// version-level immutability is only exposed through the Resource Manager API
+	container, err := storageClient.BlobContainersClient.Get(ctx, account.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Resource Manager properties for Container %q (Account %q / Resource Group %q): %+v", id.Name, id.AccountName, account.ResourceGroup, err)
+	}
+	immutableStorageWithVersioning := make([]interface{}, 0)
+	if props := container.ContainerProperties; props != nil && props.ImmutableStorageWithVersioning != nil {
+		enabled := false
+		if props.ImmutableStorageWithVersioning.Enabled != nil {
+			enabled = *props.ImmutableStorageWithVersioning.Enabled
+		}
+		immutableStorageWithVersioning = append(immutableStorageWithVersioning, map[string]interface{}{
+			"enabled": enabled,
+		})
+	}
+	if err := d.Set("immutable_storage_with_versioning", immutableStorageWithVersioning); err != nil {
+		return fmt.Errorf("setting `immutable_storage_with_versioning`: %+v", err)
+	}
+
 	resourceManagerId := parse.NewStorageContainerResourceManagerID(subscriptionId, account.ResourceGroup, id.AccountName, "default", id.Name)
 	d.Set("resource_manager_id", resourceManagerId.ID())
 
@@ -265,6 +320,29 @@ func resourceStorageContainerDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// enableStorageContainerImmutableStorageWithVersioning migrates the Container to version-level immutability, which
+// requires that versioning is enabled on the Storage Account
+func enableStorageContainerImmutableStorageWithVersioning(ctx context.Context, storageClient *client.Client, resourceGroup, accountName, containerName string) error {
+	future, err := storageClient.BlobContainersClient.ObjectLevelWorm(ctx, resourceGroup, accountName, containerName)
+	if err != nil {
+		return fmt.Errorf("enabling version-level immutability for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, storageClient.BlobContainersClient.Client); err != nil {
+		return fmt.Errorf("waiting for version-level immutability to be enabled for Container %q (Storage Account %q / Resource Group %q): %+v", containerName, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandStorageContainerImmutableStorageWithVersioning(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	return input[0].(map[string]interface{})["enabled"].(bool)
+}
+
 func expandStorageContainerAccessLevel(input string) containers.AccessLevel {
 	// for historical reasons, "private" above is an empty string in the API
 	// so the enum doesn't 1:1 match. You could argue the SDK should handle this
@@ -0,0 +1,208 @@
+package storage
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/entities"
+)
+
+func dataSourceStorageTableEntities() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageTableEntitiesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageTableName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"filter": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"select": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"items": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"partition_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"row_key": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"timestamp": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"properties": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"property_types": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageTableEntitiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	storageAccountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+	filter := d.Get("filter").(string)
+
+	account, err := storageClient.FindAccount(ctx, storageAccountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", storageAccountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Account %q for Storage Table %q", storageAccountName, tableName)
+	}
+
+	client, err := storageClient.TableEntityClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Table Entity Client for Storage Account %q (Resource Group %q): %s", storageAccountName, account.ResourceGroup, err)
+	}
+
+	input := entities.QueryEntitiesInput{
+		Filter: &filter,
+		// the full metadata is required to determine the type of each property
+		MetaDataLevel: entities.FullMetaData,
+	}
+	if v := d.Get("select").([]interface{}); len(v) > 0 {
+		// the keys are always required to identify each Entity
+		properties := []string{"PartitionKey", "RowKey", "Timestamp"}
+		for _, item := range v {
+			properties = append(properties, item.(string))
+		}
+		input.PropertyNamesToSelect = &properties
+	}
+
+	results, err := queryStorageTableEntities(ctx, client, storageAccountName, tableName, input)
+	if err != nil {
+		return fmt.Errorf("Error querying Entities with the filter %q (Table %q / Storage Account %q / Resource Group %q): %s", filter, tableName, storageAccountName, account.ResourceGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("https://%s.table.%s/%s()?$filter=%s", storageAccountName, storageClient.Environment.StorageEndpointSuffix, tableName, url.QueryEscape(filter)))
+
+	d.Set("storage_account_name", storageAccountName)
+	d.Set("table_name", tableName)
+	d.Set("filter", filter)
+	if err := d.Set("items", flattenStorageTableEntitiesTyped(results)); err != nil {
+		return fmt.Errorf("Error setting `items`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenStorageTableEntitiesTyped(input []map[string]interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	for _, entity := range input {
+		partitionKey, _ := entity["PartitionKey"].(string)
+		rowKey, _ := entity["RowKey"].(string)
+		timestamp, _ := entity["Timestamp"].(string)
+
+		properties := make(map[string]interface{})
+		propertyTypes := make(map[string]interface{})
+		for k, v := range entity {
+			if k == "PartitionKey" || k == "RowKey" || k == "Timestamp" || strings.HasPrefix(k, "odata.") || strings.HasSuffix(k, "@odata.type") {
+				continue
+			}
+
+			value, propertyType := flattenStorageTableEntityProperty(v)
+			if value == nil {
+				continue
+			}
+
+			// properties which can't be inferred from their JSON representation (e.g. Edm.Int64) are annotated
+			if annotated, ok := entity[fmt.Sprintf("%s@odata.type", k)].(string); ok {
+				propertyType = annotated
+			}
+
+			properties[k] = *value
+			propertyTypes[k] = propertyType
+		}
+
+		output = append(output, map[string]interface{}{
+			"partition_key":  partitionKey,
+			"row_key":        rowKey,
+			"timestamp":      timestamp,
+			"properties":     properties,
+			"property_types": propertyTypes,
+		})
+	}
+
+	return output
+}
+
+func flattenStorageTableEntityProperty(input interface{}) (*string, string) {
+	switch v := input.(type) {
+	case string:
+		return &v, "Edm.String"
+
+	case bool:
+		value := strconv.FormatBool(v)
+		return &value, "Edm.Boolean"
+
+	case float64:
+		value := strconv.FormatFloat(v, 'f', -1, 64)
+		if v == math.Trunc(v) && v >= math.MinInt32 && v <= math.MaxInt32 {
+			return &value, "Edm.Int32"
+		}
+		return &value, "Edm.Double"
+	}
+
+	return nil, ""
+}
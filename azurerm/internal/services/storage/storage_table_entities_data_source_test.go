@@ -0,0 +1,67 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type StorageTableEntitiesDataSource struct{}
+
+func TestAccDataSourceStorageTableEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_table_entities", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageTableEntitiesDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("items.#").HasValue("1"),
+				check.That(data.ResourceName).Key("items.0.row_key").HasValue("it's-second"),
+				check.That(data.ResourceName).Key("items.0.properties.Other").HasValue("Value"),
+				check.That(data.ResourceName).Key("items.0.property_types.Other").HasValue("Edm.String"),
+				check.That(data.ResourceName).Key("items.0.timestamp").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceStorageTableEntities_select(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_table_entities", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageTableEntitiesDataSource{}.selectProperties(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("items.#").HasValue("2"),
+				check.That(data.ResourceName).Key("items.0.properties.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d StorageTableEntitiesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_table_entities" "test" {
+  table_name           = azurerm_storage_table_entities.test.table_name
+  storage_account_name = azurerm_storage_table_entities.test.storage_account_name
+  filter               = "PartitionKey eq 'test_partition' and Other eq 'Value'"
+}
+`, StorageTableEntitiesResource{}.basic(data))
+}
+
+func (d StorageTableEntitiesDataSource) selectProperties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_table_entities" "test" {
+  table_name           = azurerm_storage_table_entities.test.table_name
+  storage_account_name = azurerm_storage_table_entities.test.storage_account_name
+  filter               = "PartitionKey eq 'test_partition'"
+  select               = ["Foo"]
+}
+`, StorageTableEntitiesResource{}.basic(data))
+}
@@ -0,0 +1,411 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/sdk/tablebatch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/entities"
+)
+
+func resourceStorageTableEntities() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageTableEntitiesCreate,
+		Read:   resourceStorageTableEntitiesRead,
+		Update: resourceStorageTableEntitiesUpdate,
+		Delete: resourceStorageTableEntitiesDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"table_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageTableName,
+			},
+
+			"storage_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountName,
+			},
+
+			"entity": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"partition_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"row_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"properties": {
+							Type:     pluginsdk.TypeMap,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			seen := make(map[string]struct{})
+			for _, raw := range d.Get("entity").(*pluginsdk.Set).List() {
+				if raw == nil {
+					continue
+				}
+				entity := raw.(map[string]interface{})
+				partitionKey := entity["partition_key"].(string)
+				rowKey := entity["row_key"].(string)
+				if partitionKey == "" || rowKey == "" {
+					// the keys may not be known until apply-time
+					continue
+				}
+
+				key := storageTableEntityKey(partitionKey, rowKey)
+				if _, exists := seen[key]; exists {
+					return fmt.Errorf("the Entity with the Partition Key %q and Row Key %q is defined more than once", partitionKey, rowKey)
+				}
+				seen[key] = struct{}{}
+			}
+
+			return nil
+		}),
+	}
+}
+
+func resourceStorageTableEntitiesCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Account %q for Storage Table %q", accountName, tableName)
+	}
+
+	client, err := storageClient.TableBatchClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Table Batch Client: %s", err)
+	}
+
+	operations := make([]tablebatch.Operation, 0)
+	for _, raw := range d.Get("entity").(*pluginsdk.Set).List() {
+		operations = append(operations, expandStorageTableEntitiesUpsert(raw.(map[string]interface{})))
+	}
+
+	// the ID is set prior to writing the Entities, since the batches aren't atomic across Partitions - any Entities
+	// which fail to be written are removed from the state during the next refresh and written during the next apply
+	d.SetId(fmt.Sprintf("https://%s.table.%s/%s", accountName, storageClient.Environment.StorageEndpointSuffix, tableName))
+
+	if err := submitStorageTableEntityBatches(ctx, client, tableName, operations); err != nil {
+		return fmt.Errorf("Error seeding Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	return resourceStorageTableEntitiesRead(d, meta)
+}
+
+func resourceStorageTableEntitiesUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Account %q for Storage Table %q", accountName, tableName)
+	}
+
+	client, err := storageClient.TableBatchClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Table Batch Client: %s", err)
+	}
+
+	entityClient, err := storageClient.TableEntityClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Entity Client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("entity")
+	oldSet := oldRaw.(*pluginsdk.Set)
+	newSet := newRaw.(*pluginsdk.Set)
+
+	operations := make([]tablebatch.Operation, 0)
+	newKeys := make(map[string]struct{})
+	for _, raw := range newSet.List() {
+		entity := raw.(map[string]interface{})
+		newKeys[storageTableEntityKey(entity["partition_key"].(string), entity["row_key"].(string))] = struct{}{}
+
+		// only Entities which have been added or changed need to be written
+		if !oldSet.Contains(raw) {
+			operations = append(operations, expandStorageTableEntitiesUpsert(entity))
+		}
+	}
+
+	removed := make([]map[string]interface{}, 0)
+	for _, raw := range oldSet.List() {
+		entity := raw.(map[string]interface{})
+		if _, ok := newKeys[storageTableEntityKey(entity["partition_key"].(string), entity["row_key"].(string))]; !ok {
+			removed = append(removed, entity)
+		}
+	}
+
+	if len(removed) > 0 {
+		existing, err := retrieveStorageTableEntities(ctx, entityClient, accountName, tableName, removed)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+		}
+
+		for _, entity := range removed {
+			partitionKey := entity["partition_key"].(string)
+			rowKey := entity["row_key"].(string)
+
+			// deleting an Entity which doesn't exist fails the entire batch
+			if _, ok := existing[storageTableEntityKey(partitionKey, rowKey)]; !ok {
+				continue
+			}
+
+			operations = append(operations, tablebatch.Operation{
+				Type:         tablebatch.OperationTypeDelete,
+				PartitionKey: partitionKey,
+				RowKey:       rowKey,
+			})
+		}
+	}
+
+	if err := submitStorageTableEntityBatches(ctx, client, tableName, operations); err != nil {
+		return fmt.Errorf("Error updating Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	return resourceStorageTableEntitiesRead(d, meta)
+}
+
+func resourceStorageTableEntitiesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Resource Group for Storage Table %q (Account %s) - assuming removed & removing from state", tableName, accountName)
+		d.SetId("")
+		return nil
+	}
+
+	client, err := storageClient.TableEntityClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Table Entity Client for Storage Account %q (Resource Group %q): %s", accountName, account.ResourceGroup, err)
+	}
+
+	tracked := make([]map[string]interface{}, 0)
+	for _, raw := range d.Get("entity").(*pluginsdk.Set).List() {
+		tracked = append(tracked, raw.(map[string]interface{}))
+	}
+
+	existing, err := retrieveStorageTableEntities(ctx, client, accountName, tableName, tracked)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	// only the Entities managed by this resource are tracked, any which have been removed
+	// are dropped so that they're written again during the next apply
+	output := make([]interface{}, 0)
+	for _, entity := range tracked {
+		partitionKey := entity["partition_key"].(string)
+		rowKey := entity["row_key"].(string)
+
+		properties, ok := existing[storageTableEntityKey(partitionKey, rowKey)]
+		if !ok {
+			log.Printf("[DEBUG] Entity (Partition Key %q / Row Key %q) was not found in Table %q (Account %q) - removing from state", partitionKey, rowKey, tableName, accountName)
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			"partition_key": partitionKey,
+			"row_key":       rowKey,
+			"properties":    flattenEntity(properties),
+		})
+	}
+
+	d.Set("storage_account_name", accountName)
+	d.Set("table_name", tableName)
+	if err := d.Set("entity", output); err != nil {
+		return fmt.Errorf("Error setting `entity`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageTableEntitiesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	accountName := d.Get("storage_account_name").(string)
+	tableName := d.Get("table_name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Account %q for Table %q: %s", accountName, tableName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Storage Account %q was not found!", accountName)
+	}
+
+	client, err := storageClient.TableBatchClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Table Batch Client: %s", err)
+	}
+
+	entityClient, err := storageClient.TableEntityClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("Error building Entity Client: %s", err)
+	}
+
+	tracked := make([]map[string]interface{}, 0)
+	for _, raw := range d.Get("entity").(*pluginsdk.Set).List() {
+		tracked = append(tracked, raw.(map[string]interface{}))
+	}
+
+	existing, err := retrieveStorageTableEntities(ctx, entityClient, accountName, tableName, tracked)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	operations := make([]tablebatch.Operation, 0)
+	for _, entity := range tracked {
+		partitionKey := entity["partition_key"].(string)
+		rowKey := entity["row_key"].(string)
+		if _, ok := existing[storageTableEntityKey(partitionKey, rowKey)]; !ok {
+			continue
+		}
+
+		operations = append(operations, tablebatch.Operation{
+			Type:         tablebatch.OperationTypeDelete,
+			PartitionKey: partitionKey,
+			RowKey:       rowKey,
+		})
+	}
+
+	if err := submitStorageTableEntityBatches(ctx, client, tableName, operations); err != nil {
+		return fmt.Errorf("Error deleting Entities (Table %q / Storage Account %q / Resource Group %q): %+v", tableName, accountName, account.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandStorageTableEntitiesUpsert(input map[string]interface{}) tablebatch.Operation {
+	return tablebatch.Operation{
+		// the Entity is replaced so that any properties which have been removed are removed from the Entity
+		Type:         tablebatch.OperationTypeInsertOrReplace,
+		PartitionKey: input["partition_key"].(string),
+		RowKey:       input["row_key"].(string),
+		Properties:   input["properties"].(map[string]interface{}),
+	}
+}
+
+func submitStorageTableEntityBatches(ctx context.Context, client *tablebatch.TableBatchClient, tableName string, operations []tablebatch.Operation) error {
+	for _, batch := range tablebatch.PartitionOperations(operations) {
+		log.Printf("[DEBUG] Submitting a batch of %d operations for Partition Key %q in Table %q..", len(batch), batch[0].PartitionKey, tableName)
+		if _, err := client.Submit(ctx, tableName, batch); err != nil {
+			return fmt.Errorf("submitting batch for Partition Key %q: %+v", batch[0].PartitionKey, err)
+		}
+	}
+
+	return nil
+}
+
+// retrieveStorageTableEntities retrieves the specified Entities (querying each Partition once, rather than each Entity),
+// returning a map of the key of each Entity which exists to it's properties
+func retrieveStorageTableEntities(ctx context.Context, client *entities.Client, accountName, tableName string, input []map[string]interface{}) (map[string]map[string]interface{}, error) {
+	partitionKeys := make(map[string]struct{})
+	for _, entity := range input {
+		partitionKeys[entity["partition_key"].(string)] = struct{}{}
+	}
+
+	output := make(map[string]map[string]interface{})
+	for partitionKey := range partitionKeys {
+		filter := fmt.Sprintf("PartitionKey eq '%s'", strings.ReplaceAll(partitionKey, "'", "''"))
+		results, err := queryStorageTableEntities(ctx, client, accountName, tableName, entities.QueryEntitiesInput{
+			Filter:        &filter,
+			MetaDataLevel: entities.NoMetaData,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("querying Partition Key %q: %+v", partitionKey, err)
+		}
+
+		for _, entity := range results {
+			rowKey, _ := entity["RowKey"].(string)
+			output[storageTableEntityKey(partitionKey, rowKey)] = entity
+		}
+	}
+
+	return output, nil
+}
+
+// queryStorageTableEntities runs the specified query, following any continuation tokens until all results are retrieved
+func queryStorageTableEntities(ctx context.Context, client *entities.Client, accountName, tableName string, input entities.QueryEntitiesInput) ([]map[string]interface{}, error) {
+	output := make([]map[string]interface{}, 0)
+	for {
+		result, err := client.Query(ctx, accountName, tableName, input)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, result.Entities...)
+
+		if result.NextPartitionKey == "" && result.NextRowKey == "" {
+			break
+		}
+		input.NextPartitionKey = &result.NextPartitionKey
+		input.NextRowKey = &result.NextRowKey
+	}
+
+	return output, nil
+}
+
+func storageTableEntityKey(partitionKey, rowKey string) string {
+	return fmt.Sprintf("%s\x00%s", partitionKey, rowKey)
+}
@@ -0,0 +1,197 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/table/entities"
+)
+
+type StorageTableEntitiesResource struct{}
+
+func TestAccTableEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntities_manyPartitions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.many(data, 250),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("250"),
+			),
+		},
+	})
+}
+
+func TestAccTableEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.many(data, 150),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("150"),
+			),
+		},
+		{
+			Config: r.many(data, 20),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("20"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (r StorageTableEntitiesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	accountName := state.Attributes["storage_account_name"]
+	tableName := state.Attributes["table_name"]
+
+	account, err := clients.Storage.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Table %q: %+v", accountName, tableName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("storage Account %q was not found", accountName)
+	}
+
+	client, err := clients.Storage.TableEntityClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	for key, partitionKey := range state.Attributes {
+		if !strings.HasPrefix(key, "entity.") || !strings.HasSuffix(key, ".partition_key") {
+			continue
+		}
+		rowKey := state.Attributes[strings.TrimSuffix(key, ".partition_key")+".row_key"]
+
+		input := entities.GetEntityInput{
+			PartitionKey:  partitionKey,
+			RowKey:        rowKey,
+			MetaDataLevel: entities.NoMetaData,
+		}
+		resp, err := client.Get(ctx, accountName, tableName, input)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Entity (Partition Key %q / Row Key %q) (Table %q / Storage Account %q): %+v", partitionKey, rowKey, tableName, accountName, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageTableEntitiesResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  entity {
+    partition_key = "test_partition"
+    row_key       = "first"
+
+    properties = {
+      Foo = "Bar"
+    }
+  }
+
+  entity {
+    partition_key = "test_partition"
+    row_key       = "it's-second"
+
+    properties = {
+      Foo   = "Baz"
+      Other = "Value"
+    }
+  }
+}
+`, template)
+}
+
+func (r StorageTableEntitiesResource) many(data acceptance.TestData, count int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_account_name = azurerm_storage_account.test.name
+  table_name           = azurerm_storage_table.test.name
+
+  dynamic "entity" {
+    for_each = range(%d)
+    content {
+      partition_key = "partition${entity.value %% 3}"
+      row_key       = "row${entity.value}"
+
+      properties = {
+        Value = tostring(entity.value)
+      }
+    }
+  }
+}
+`, template, count)
+}
+
+func (r StorageTableEntitiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name                 = "acctestst%d"
+  storage_account_name = azurerm_storage_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger)
+}
@@ -0,0 +1,32 @@
+package validate
+
+import "testing"
+
+func TestStorageAccountLocalUserName(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"ab", true},
+		{"Abc", true},
+		{"ab-c", true},
+		{"ab_c", true},
+		{"abc", false},
+		{"user01", false},
+		{"a123456789012345678901234567890123456789012345678901234567890123", false},
+		{"a1234567890123456789012345678901234567890123456789012345678901234", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageAccountLocalUserName(test.input, "name")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating name %q to fail", test.input)
+		}
+
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating name %q to succeed", test.input)
+		}
+	}
+}
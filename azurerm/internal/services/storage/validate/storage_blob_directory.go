@@ -0,0 +1,33 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageBlobDirectoryPrefix validates the virtual directory which is prepended to the name of each Blob
+func StorageBlobDirectoryPrefix(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+	if len(value) > 1024 {
+		errors = append(errors, fmt.Errorf("%q must be at most 1024 characters: %q", k, value))
+	}
+	if strings.Contains(value, "\\") {
+		errors = append(errors, fmt.Errorf("%q cannot contain a backslash, use a forward slash to separate directories: %q", k, value))
+	}
+	if strings.HasPrefix(value, "/") || strings.HasSuffix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q cannot begin or end with a forward slash: %q", k, value))
+	}
+	for _, segment := range strings.Split(strings.Trim(value, "/"), "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			errors = append(errors, fmt.Errorf("%q cannot contain empty, `.` or `..` directories: %q", k, value))
+			break
+		}
+	}
+
+	return warnings, errors
+}
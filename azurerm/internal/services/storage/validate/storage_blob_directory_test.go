@@ -0,0 +1,40 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageBlobDirectoryPrefix(t *testing.T) {
+	validPrefixes := []string{
+		"website",
+		"website/assets",
+		"a",
+		"with-dash_and.dot",
+		strings.Repeat("w", 1024),
+	}
+	for _, v := range validPrefixes {
+		_, errors := StorageBlobDirectoryPrefix(v, "prefix")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Blob Directory Prefix: %q", v, errors)
+		}
+	}
+
+	invalidPrefixes := []string{
+		"",
+		"/",
+		"/website",
+		"website/",
+		"website//assets",
+		"website\\assets",
+		"./website",
+		"website/../assets",
+		strings.Repeat("w", 1025),
+	}
+	for _, v := range invalidPrefixes {
+		_, errors := StorageBlobDirectoryPrefix(v, "prefix")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Blob Directory Prefix", v)
+		}
+	}
+}
@@ -671,6 +671,43 @@ func schemaAppServiceStorageAccounts() *pluginsdk.Schema {
 	}
 }
 
+func schemaAppServiceStickySettings() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_setting_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+				},
+
+				"connection_string_names": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					AtLeastOneOf: []string{
+						"sticky_settings.0.app_setting_names",
+						"sticky_settings.0.connection_string_names",
+					},
+				},
+			},
+		},
+	}
+}
+
 func schemaAppServiceDataSourceSiteConfig() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -1993,6 +2030,47 @@ func flattenAppServiceStorageAccounts(input map[string]*web.AzureStorageInfoValu
 	return results
 }
 
+// expandAppServiceStickySettings applies the sticky App Setting and Connection String names onto the existing
+// Slot Config Names, leaving the sticky Azure Storage Config names untouched
+func expandAppServiceStickySettings(input []interface{}, existing *web.SlotConfigNames) *web.SlotConfigNames {
+	output := &web.SlotConfigNames{
+		AppSettingNames:       &[]string{},
+		ConnectionStringNames: &[]string{},
+	}
+	if existing != nil {
+		output.AzureStorageConfigNames = existing.AzureStorageConfigNames
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	output.AppSettingNames = utils.ExpandStringSlice(raw["app_setting_names"].([]interface{}))
+	output.ConnectionStringNames = utils.ExpandStringSlice(raw["connection_string_names"].([]interface{}))
+
+	return output
+}
+
+func flattenAppServiceStickySettings(input *web.SlotConfigNames) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	appSettingNames := utils.FlattenStringSlice(input.AppSettingNames)
+	connectionStringNames := utils.FlattenStringSlice(input.ConnectionStringNames)
+	if len(appSettingNames) == 0 && len(connectionStringNames) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"app_setting_names":       appSettingNames,
+			"connection_string_names": connectionStringNames,
+		},
+	}
+}
+
 func expandAppServiceIpRestriction(input interface{}) ([]web.IPSecurityRestriction, error) {
 	restrictions := make([]web.IPSecurityRestriction, 0)
 
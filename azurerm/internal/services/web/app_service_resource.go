@@ -142,6 +142,8 @@ func resourceAppService() *pluginsdk.Resource {
 
 			"source_control": schemaAppServiceSiteSourceControl(),
 
+			"sticky_settings": schemaAppServiceStickySettings(),
+
 			"tags": tags.Schema(),
 
 			"site_credential": {
@@ -544,6 +546,20 @@ func resourceAppServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("sticky_settings") {
+		existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+		if err != nil {
+			return fmt.Errorf("retrieving Slot Configuration Names for App Service %q: %+v", id.SiteName, err)
+		}
+
+		slotConfigNames := web.SlotConfigNamesResource{
+			SlotConfigNames: expandAppServiceStickySettings(d.Get("sticky_settings").([]interface{}), existing.SlotConfigNames),
+		}
+		if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, slotConfigNames); err != nil {
+			return fmt.Errorf("updating Slot Configuration Names for App Service %q: %+v", id.SiteName, err)
+		}
+	}
+
 	if d.HasChange("identity") {
 		site, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
 		if err != nil {
@@ -634,6 +650,11 @@ func resourceAppServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("making Read request on AzureRM App Service ConnectionStrings %q: %+v", id.SiteName, err)
 	}
 
+	slotConfigNamesResp, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("making Read request on AzureRM App Service Slot Configuration Names %q: %+v", id.SiteName, err)
+	}
+
 	scmResp, err := client.GetSourceControl(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
 		return fmt.Errorf("making Read request on AzureRM App Service Source Control %q: %+v", id.SiteName, err)
@@ -700,6 +721,10 @@ func resourceAppServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `connection_string`: %s", err)
 	}
 
+	if err := d.Set("sticky_settings", flattenAppServiceStickySettings(slotConfigNamesResp.SlotConfigNames)); err != nil {
+		return fmt.Errorf("setting `sticky_settings`: %s", err)
+	}
+
 	siteConfig := flattenAppServiceSiteConfig(configResp.SiteConfig)
 	if err := d.Set("site_config", siteConfig); err != nil {
 		return err
@@ -0,0 +1,1199 @@
+package web
+
+import (
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/sdk/webapps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type AuthV2Settings struct {
+	AuthEnabled                        bool                       `tfschema:"auth_enabled"`
+	RuntimeVersion                     string                     `tfschema:"runtime_version"`
+	ConfigFilePath                     string                     `tfschema:"config_file_path"`
+	RequireAuth                        bool                       `tfschema:"require_authentication"`
+	UnauthenticatedAction              string                     `tfschema:"unauthenticated_action"`
+	DefaultAuthProvider                string                     `tfschema:"default_provider"`
+	ExcludedPaths                      []string                   `tfschema:"excluded_paths"`
+	RequireHTTPS                       bool                       `tfschema:"require_https"`
+	HttpRoutesAPIPrefix                string                     `tfschema:"http_route_api_prefix"`
+	ForwardProxyConvention             string                     `tfschema:"forward_proxy_convention"`
+	ForwardProxyCustomHostHeaderName   string                     `tfschema:"forward_proxy_custom_host_header_name"`
+	ForwardProxyCustomSchemeHeaderName string                     `tfschema:"forward_proxy_custom_scheme_header_name"`
+	AzureActiveDirectoryAuth           []AadAuthV2Settings        `tfschema:"active_directory_v2"`
+	FacebookAuth                       []FacebookAuthV2Settings   `tfschema:"facebook_v2"`
+	GithubAuth                         []GithubAuthV2Settings     `tfschema:"github_v2"`
+	GoogleAuth                         []GoogleAuthV2Settings     `tfschema:"google_v2"`
+	MicrosoftAuth                      []MicrosoftAuthV2Settings  `tfschema:"microsoft_v2"`
+	TwitterAuth                        []TwitterAuthV2Settings    `tfschema:"twitter_v2"`
+	CustomOIDCAuth                     []CustomOIDCAuthV2Settings `tfschema:"custom_oidc_v2"`
+	Login                              []AuthV2Login              `tfschema:"login"`
+}
+
+type AuthV2Login struct {
+	LogoutEndpoint                string   `tfschema:"logout_endpoint"`
+	TokenStoreEnabled             bool     `tfschema:"token_store_enabled"`
+	TokenRefreshExtension         float64  `tfschema:"token_refresh_extension_time"`
+	TokenFilesystemPath           string   `tfschema:"token_store_path"`
+	TokenBlobStorageSAS           string   `tfschema:"token_store_sas_setting_name"`
+	PreserveURLFragmentsForLogins bool     `tfschema:"preserve_url_fragments_for_logins"`
+	AllowedExternalRedirectURLs   []string `tfschema:"allowed_external_redirect_urls"`
+	CookieExpirationConvention    string   `tfschema:"cookie_expiration_convention"`
+	CookieExpirationTime          string   `tfschema:"cookie_expiration_time"`
+	ValidateNonce                 bool     `tfschema:"validate_nonce"`
+	NonceExpirationTime           string   `tfschema:"nonce_expiration_time"`
+}
+
+type AadAuthV2Settings struct {
+	TenantAuthURI                     string            `tfschema:"tenant_auth_endpoint"`
+	ClientId                          string            `tfschema:"client_id"`
+	ClientSecretSettingName           string            `tfschema:"client_secret_setting_name"`
+	ClientSecretCertificateThumbprint string            `tfschema:"client_secret_certificate_thumbprint"`
+	AllowedAudiences                  []string          `tfschema:"allowed_audiences"`
+	AllowedApplications               []string          `tfschema:"allowed_applications"`
+	AllowedGroups                     []string          `tfschema:"allowed_groups"`
+	LoginParameters                   map[string]string `tfschema:"login_parameters"`
+	DisableWWWAuth                    bool              `tfschema:"www_authentication_disabled"`
+}
+
+type FacebookAuthV2Settings struct {
+	AppId                string   `tfschema:"app_id"`
+	AppSecretSettingName string   `tfschema:"app_secret_setting_name"`
+	GraphAPIVersion      string   `tfschema:"graph_api_version"`
+	LoginScopes          []string `tfschema:"login_scopes"`
+}
+
+type GithubAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+type GoogleAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+type MicrosoftAuthV2Settings struct {
+	ClientId                string   `tfschema:"client_id"`
+	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
+	AllowedAudiences        []string `tfschema:"allowed_audiences"`
+	LoginScopes             []string `tfschema:"login_scopes"`
+}
+
+type TwitterAuthV2Settings struct {
+	ConsumerKey               string `tfschema:"consumer_key"`
+	ConsumerSecretSettingName string `tfschema:"consumer_secret_setting_name"`
+}
+
+type CustomOIDCAuthV2Settings struct {
+	Name                        string   `tfschema:"name"`
+	ClientId                    string   `tfschema:"client_id"`
+	ClientSecretSettingName     string   `tfschema:"client_secret_setting_name"`
+	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType               string   `tfschema:"name_claim_type"`
+	Scopes                      []string `tfschema:"scopes"`
+}
+
+func authV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"auth_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"runtime_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "~1",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"config_file_path": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"require_authentication": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"unauthenticated_action": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webapps.UnauthenticatedClientActionV2RedirectToLoginPage),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webapps.UnauthenticatedClientActionV2AllowAnonymous),
+						string(webapps.UnauthenticatedClientActionV2RedirectToLoginPage),
+						string(webapps.UnauthenticatedClientActionV2ReturnFourZeroOne),
+						string(webapps.UnauthenticatedClientActionV2ReturnFourZeroThree),
+					}, false),
+				},
+
+				"default_provider": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"excluded_paths": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"require_https": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"http_route_api_prefix": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "/.auth",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"forward_proxy_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webapps.ForwardProxyConventionNoProxy),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webapps.ForwardProxyConventionNoProxy),
+						string(webapps.ForwardProxyConventionStandard),
+						string(webapps.ForwardProxyConventionCustom),
+					}, false),
+				},
+
+				"forward_proxy_custom_host_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"forward_proxy_custom_scheme_header_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"active_directory_v2": aadAuthV2SettingsSchema(),
+
+				"facebook_v2": facebookAuthV2SettingsSchema(),
+
+				"github_v2": githubAuthV2SettingsSchema(),
+
+				"google_v2": googleAuthV2SettingsSchema(),
+
+				"microsoft_v2": microsoftAuthV2SettingsSchema(),
+
+				"twitter_v2": twitterAuthV2SettingsSchema(),
+
+				"custom_oidc_v2": customOIDCAuthV2SettingsSchema(),
+
+				"login": authV2LoginSchema(),
+			},
+		},
+	}
+}
+
+func authV2LoginSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"logout_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"token_store_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"token_refresh_extension_time": {
+					Type:     pluginsdk.TypeFloat,
+					Optional: true,
+					Default:  72,
+				},
+
+				"token_store_path": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.login.0.token_store_sas_setting_name"},
+				},
+
+				"token_store_sas_setting_name": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.login.0.token_store_path"},
+				},
+
+				"preserve_url_fragments_for_logins": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"cookie_expiration_convention": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  string(webapps.CookieExpirationConventionFixedTime),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(webapps.CookieExpirationConventionFixedTime),
+						string(webapps.CookieExpirationConventionIdentityProviderDerived),
+					}, false),
+				},
+
+				"cookie_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "08:00:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"validate_nonce": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"nonce_expiration_time": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "00:05:00",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func aadAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tenant_auth_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_secret_setting_name": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.active_directory_v2.0.client_secret_certificate_thumbprint"},
+				},
+
+				"client_secret_certificate_thumbprint": {
+					Type:          pluginsdk.TypeString,
+					Optional:      true,
+					ValidateFunc:  validation.StringIsNotEmpty,
+					ConflictsWith: []string{"auth_settings_v2.0.active_directory_v2.0.client_secret_setting_name"},
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"allowed_applications": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"allowed_groups": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"login_parameters": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"www_authentication_disabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func facebookAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"app_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"app_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"graph_api_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+func githubAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+func googleAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+func microsoftAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"allowed_audiences": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"login_scopes": authV2LoginScopesSchema(),
+			},
+		},
+	}
+}
+
+func twitterAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"consumer_key": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"consumer_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func customOIDCAuthV2SettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"client_secret_setting_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"openid_configuration_endpoint": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"name_claim_type": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"scopes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func authV2LoginScopesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func expandAuthV2Settings(input []AuthV2Settings) *webapps.SiteAuthSettingsV2 {
+	result := &webapps.SiteAuthSettingsV2{
+		Properties: &webapps.SiteAuthSettingsV2Properties{
+			Platform: &webapps.AuthPlatform{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	if len(input) != 1 {
+		return result
+	}
+
+	settings := input[0]
+	props := result.Properties
+
+	props.Platform = &webapps.AuthPlatform{
+		Enabled:        utils.Bool(settings.AuthEnabled),
+		RuntimeVersion: utils.String(settings.RuntimeVersion),
+	}
+	if settings.ConfigFilePath != "" {
+		props.Platform.ConfigFilePath = utils.String(settings.ConfigFilePath)
+	}
+
+	unauthenticatedAction := webapps.UnauthenticatedClientActionV2(settings.UnauthenticatedAction)
+	props.GlobalValidation = &webapps.GlobalValidation{
+		RequireAuthentication:       utils.Bool(settings.RequireAuth),
+		UnauthenticatedClientAction: &unauthenticatedAction,
+	}
+	if settings.DefaultAuthProvider != "" {
+		props.GlobalValidation.RedirectToProvider = utils.String(settings.DefaultAuthProvider)
+	}
+	if len(settings.ExcludedPaths) > 0 {
+		props.GlobalValidation.ExcludedPaths = &settings.ExcludedPaths
+	}
+
+	forwardProxyConvention := webapps.ForwardProxyConvention(settings.ForwardProxyConvention)
+	props.HttpSettings = &webapps.HttpSettings{
+		ForwardProxy: &webapps.ForwardProxy{
+			Convention: &forwardProxyConvention,
+		},
+		RequireHttps: utils.Bool(settings.RequireHTTPS),
+		Routes: &webapps.HttpSettingsRoutes{
+			ApiPrefix: utils.String(settings.HttpRoutesAPIPrefix),
+		},
+	}
+	if settings.ForwardProxyCustomHostHeaderName != "" {
+		props.HttpSettings.ForwardProxy.CustomHostHeaderName = utils.String(settings.ForwardProxyCustomHostHeaderName)
+	}
+	if settings.ForwardProxyCustomSchemeHeaderName != "" {
+		props.HttpSettings.ForwardProxy.CustomProtoHeaderName = utils.String(settings.ForwardProxyCustomSchemeHeaderName)
+	}
+
+	props.IdentityProviders = &webapps.IdentityProviders{
+		AzureActiveDirectory:         expandAadAuthV2Settings(settings.AzureActiveDirectoryAuth),
+		CustomOpenIdConnectProviders: expandCustomOIDCAuthV2Settings(settings.CustomOIDCAuth),
+		Facebook:                     expandFacebookAuthV2Settings(settings.FacebookAuth),
+		GitHub:                       expandGithubAuthV2Settings(settings.GithubAuth),
+		Google:                       expandGoogleAuthV2Settings(settings.GoogleAuth),
+		LegacyMicrosoftAccount:       expandMicrosoftAuthV2Settings(settings.MicrosoftAuth),
+		Twitter:                      expandTwitterAuthV2Settings(settings.TwitterAuth),
+	}
+
+	props.Login = expandAuthV2Login(settings.Login)
+
+	return result
+}
+
+func expandAuthV2Login(input []AuthV2Login) *webapps.Login {
+	if len(input) != 1 {
+		return nil
+	}
+
+	login := input[0]
+	cookieExpirationConvention := webapps.CookieExpirationConvention(login.CookieExpirationConvention)
+	result := &webapps.Login{
+		CookieExpiration: &webapps.CookieExpiration{
+			Convention:       &cookieExpirationConvention,
+			TimeToExpiration: utils.String(login.CookieExpirationTime),
+		},
+		Nonce: &webapps.Nonce{
+			NonceExpirationInterval: utils.String(login.NonceExpirationTime),
+			ValidateNonce:           utils.Bool(login.ValidateNonce),
+		},
+		PreserveUrlFragmentsForLogins: utils.Bool(login.PreserveURLFragmentsForLogins),
+		TokenStore: &webapps.TokenStore{
+			Enabled:                    utils.Bool(login.TokenStoreEnabled),
+			TokenRefreshExtensionHours: utils.Float(login.TokenRefreshExtension),
+		},
+	}
+
+	if login.LogoutEndpoint != "" {
+		result.Routes = &webapps.LoginRoutes{
+			LogoutEndpoint: utils.String(login.LogoutEndpoint),
+		}
+	}
+
+	if len(login.AllowedExternalRedirectURLs) > 0 {
+		result.AllowedExternalRedirectUrls = &login.AllowedExternalRedirectURLs
+	}
+
+	if login.TokenFilesystemPath != "" {
+		result.TokenStore.FileSystem = &webapps.FileSystemTokenStore{
+			Directory: utils.String(login.TokenFilesystemPath),
+		}
+	}
+
+	if login.TokenBlobStorageSAS != "" {
+		result.TokenStore.AzureBlobStorage = &webapps.BlobStorageTokenStore{
+			SasUrlSettingName: utils.String(login.TokenBlobStorageSAS),
+		}
+	}
+
+	return result
+}
+
+func expandAadAuthV2Settings(input []AadAuthV2Settings) *webapps.AzureActiveDirectory {
+	if len(input) != 1 {
+		return nil
+	}
+
+	aad := input[0]
+	result := &webapps.AzureActiveDirectory{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.AzureActiveDirectoryRegistration{
+			ClientId:     utils.String(aad.ClientId),
+			OpenIdIssuer: utils.String(aad.TenantAuthURI),
+		},
+		Login: &webapps.AzureActiveDirectoryLogin{
+			DisableWWWAuthenticate: utils.Bool(aad.DisableWWWAuth),
+		},
+		Validation: &webapps.AzureActiveDirectoryValidation{
+			JwtClaimChecks: &webapps.JwtClaimChecks{},
+		},
+	}
+
+	if aad.ClientSecretSettingName != "" {
+		result.Registration.ClientSecretSettingName = utils.String(aad.ClientSecretSettingName)
+	}
+
+	if aad.ClientSecretCertificateThumbprint != "" {
+		result.Registration.ClientSecretCertificateThumbprint = utils.String(aad.ClientSecretCertificateThumbprint)
+	}
+
+	if len(aad.LoginParameters) > 0 {
+		loginParams := make([]string, 0)
+		for k, v := range aad.LoginParameters {
+			loginParams = append(loginParams, k+"="+v)
+		}
+		result.Login.LoginParameters = &loginParams
+	}
+
+	if len(aad.AllowedAudiences) > 0 {
+		result.Validation.AllowedAudiences = &aad.AllowedAudiences
+	}
+
+	if len(aad.AllowedApplications) > 0 {
+		result.Validation.JwtClaimChecks.AllowedClientApplications = &aad.AllowedApplications
+	}
+
+	if len(aad.AllowedGroups) > 0 {
+		result.Validation.JwtClaimChecks.AllowedGroups = &aad.AllowedGroups
+	}
+
+	return result
+}
+
+func expandFacebookAuthV2Settings(input []FacebookAuthV2Settings) *webapps.Facebook {
+	if len(input) != 1 {
+		return nil
+	}
+
+	facebook := input[0]
+	result := &webapps.Facebook{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.AppRegistration{
+			AppId:                utils.String(facebook.AppId),
+			AppSecretSettingName: utils.String(facebook.AppSecretSettingName),
+		},
+	}
+
+	if facebook.GraphAPIVersion != "" {
+		result.GraphApiVersion = utils.String(facebook.GraphAPIVersion)
+	}
+
+	if len(facebook.LoginScopes) > 0 {
+		result.Login = &webapps.LoginScopes{
+			Scopes: &facebook.LoginScopes,
+		}
+	}
+
+	return result
+}
+
+func expandGithubAuthV2Settings(input []GithubAuthV2Settings) *webapps.GitHub {
+	if len(input) != 1 {
+		return nil
+	}
+
+	github := input[0]
+	result := &webapps.GitHub{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.ClientRegistration{
+			ClientId:                utils.String(github.ClientId),
+			ClientSecretSettingName: utils.String(github.ClientSecretSettingName),
+		},
+	}
+
+	if len(github.LoginScopes) > 0 {
+		result.Login = &webapps.LoginScopes{
+			Scopes: &github.LoginScopes,
+		}
+	}
+
+	return result
+}
+
+func expandGoogleAuthV2Settings(input []GoogleAuthV2Settings) *webapps.Google {
+	if len(input) != 1 {
+		return nil
+	}
+
+	google := input[0]
+	result := &webapps.Google{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.ClientRegistration{
+			ClientId:                utils.String(google.ClientId),
+			ClientSecretSettingName: utils.String(google.ClientSecretSettingName),
+		},
+	}
+
+	if len(google.AllowedAudiences) > 0 {
+		result.Validation = &webapps.AllowedAudiencesValidation{
+			AllowedAudiences: &google.AllowedAudiences,
+		}
+	}
+
+	if len(google.LoginScopes) > 0 {
+		result.Login = &webapps.LoginScopes{
+			Scopes: &google.LoginScopes,
+		}
+	}
+
+	return result
+}
+
+func expandMicrosoftAuthV2Settings(input []MicrosoftAuthV2Settings) *webapps.LegacyMicrosoftAccount {
+	if len(input) != 1 {
+		return nil
+	}
+
+	microsoft := input[0]
+	result := &webapps.LegacyMicrosoftAccount{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.ClientRegistration{
+			ClientId:                utils.String(microsoft.ClientId),
+			ClientSecretSettingName: utils.String(microsoft.ClientSecretSettingName),
+		},
+	}
+
+	if len(microsoft.AllowedAudiences) > 0 {
+		result.Validation = &webapps.AllowedAudiencesValidation{
+			AllowedAudiences: &microsoft.AllowedAudiences,
+		}
+	}
+
+	if len(microsoft.LoginScopes) > 0 {
+		result.Login = &webapps.LoginScopes{
+			Scopes: &microsoft.LoginScopes,
+		}
+	}
+
+	return result
+}
+
+func expandTwitterAuthV2Settings(input []TwitterAuthV2Settings) *webapps.Twitter {
+	if len(input) != 1 {
+		return nil
+	}
+
+	twitter := input[0]
+	return &webapps.Twitter{
+		Enabled: utils.Bool(true),
+		Registration: &webapps.TwitterRegistration{
+			ConsumerKey:               utils.String(twitter.ConsumerKey),
+			ConsumerSecretSettingName: utils.String(twitter.ConsumerSecretSettingName),
+		},
+	}
+}
+
+func expandCustomOIDCAuthV2Settings(input []CustomOIDCAuthV2Settings) *map[string]webapps.CustomOpenIdConnectProvider {
+	if len(input) == 0 {
+		return nil
+	}
+
+	method := webapps.ClientCredentialMethodClientSecretPost
+	result := make(map[string]webapps.CustomOpenIdConnectProvider)
+	for _, v := range input {
+		provider := webapps.CustomOpenIdConnectProvider{
+			Enabled: utils.Bool(true),
+			Registration: &webapps.OpenIdConnectRegistration{
+				ClientCredential: &webapps.OpenIdConnectClientCredential{
+					ClientSecretSettingName: utils.String(v.ClientSecretSettingName),
+					Method:                  &method,
+				},
+				ClientId: utils.String(v.ClientId),
+				OpenIdConnectConfiguration: &webapps.OpenIdConnectConfig{
+					WellKnownOpenIdConfiguration: utils.String(v.OpenIDConfigurationEndpoint),
+				},
+			},
+		}
+
+		if v.NameClaimType != "" || len(v.Scopes) > 0 {
+			provider.Login = &webapps.OpenIdConnectLogin{}
+			if v.NameClaimType != "" {
+				provider.Login.NameClaimType = utils.String(v.NameClaimType)
+			}
+			if len(v.Scopes) > 0 {
+				scopes := v.Scopes
+				provider.Login.Scopes = &scopes
+			}
+		}
+
+		result[v.Name] = provider
+	}
+
+	return &result
+}
+
+func flattenAuthV2Settings(input *webapps.SiteAuthSettingsV2) []AuthV2Settings {
+	if input == nil || input.Properties == nil {
+		return []AuthV2Settings{}
+	}
+
+	props := input.Properties
+	result := AuthV2Settings{}
+
+	if platform := props.Platform; platform != nil {
+		result.AuthEnabled = utils.NormaliseNilableBool(platform.Enabled)
+		result.RuntimeVersion = utils.NormalizeNilableString(platform.RuntimeVersion)
+		result.ConfigFilePath = utils.NormalizeNilableString(platform.ConfigFilePath)
+	}
+
+	// the API returns a default (disabled) configuration when Auth V2 has never been configured
+	if !result.AuthEnabled && props.IdentityProviders == nil {
+		return []AuthV2Settings{}
+	}
+
+	if validation := props.GlobalValidation; validation != nil {
+		result.RequireAuth = utils.NormaliseNilableBool(validation.RequireAuthentication)
+		if validation.UnauthenticatedClientAction != nil {
+			result.UnauthenticatedAction = string(*validation.UnauthenticatedClientAction)
+		}
+		result.DefaultAuthProvider = utils.NormalizeNilableString(validation.RedirectToProvider)
+		if validation.ExcludedPaths != nil {
+			result.ExcludedPaths = *validation.ExcludedPaths
+		}
+	}
+
+	if httpSettings := props.HttpSettings; httpSettings != nil {
+		result.RequireHTTPS = utils.NormaliseNilableBool(httpSettings.RequireHttps)
+		if httpSettings.Routes != nil {
+			result.HttpRoutesAPIPrefix = utils.NormalizeNilableString(httpSettings.Routes.ApiPrefix)
+		}
+		if fp := httpSettings.ForwardProxy; fp != nil {
+			if fp.Convention != nil {
+				result.ForwardProxyConvention = string(*fp.Convention)
+			}
+			result.ForwardProxyCustomHostHeaderName = utils.NormalizeNilableString(fp.CustomHostHeaderName)
+			result.ForwardProxyCustomSchemeHeaderName = utils.NormalizeNilableString(fp.CustomProtoHeaderName)
+		}
+	}
+
+	if providers := props.IdentityProviders; providers != nil {
+		result.AzureActiveDirectoryAuth = flattenAadAuthV2Settings(providers.AzureActiveDirectory)
+		result.CustomOIDCAuth = flattenCustomOIDCAuthV2Settings(providers.CustomOpenIdConnectProviders)
+		result.FacebookAuth = flattenFacebookAuthV2Settings(providers.Facebook)
+		result.GithubAuth = flattenGithubAuthV2Settings(providers.GitHub)
+		result.GoogleAuth = flattenGoogleAuthV2Settings(providers.Google)
+		result.MicrosoftAuth = flattenMicrosoftAuthV2Settings(providers.LegacyMicrosoftAccount)
+		result.TwitterAuth = flattenTwitterAuthV2Settings(providers.Twitter)
+	}
+
+	result.Login = flattenAuthV2Login(props.Login)
+
+	return []AuthV2Settings{result}
+}
+
+func flattenAuthV2Login(input *webapps.Login) []AuthV2Login {
+	if input == nil {
+		return []AuthV2Login{}
+	}
+
+	result := AuthV2Login{
+		PreserveURLFragmentsForLogins: utils.NormaliseNilableBool(input.PreserveUrlFragmentsForLogins),
+	}
+
+	if input.AllowedExternalRedirectUrls != nil {
+		result.AllowedExternalRedirectURLs = *input.AllowedExternalRedirectUrls
+	}
+
+	if input.Routes != nil {
+		result.LogoutEndpoint = utils.NormalizeNilableString(input.Routes.LogoutEndpoint)
+	}
+
+	if cookie := input.CookieExpiration; cookie != nil {
+		if cookie.Convention != nil {
+			result.CookieExpirationConvention = string(*cookie.Convention)
+		}
+		result.CookieExpirationTime = utils.NormalizeNilableString(cookie.TimeToExpiration)
+	}
+
+	if nonce := input.Nonce; nonce != nil {
+		result.NonceExpirationTime = utils.NormalizeNilableString(nonce.NonceExpirationInterval)
+		result.ValidateNonce = utils.NormaliseNilableBool(nonce.ValidateNonce)
+	}
+
+	if tokenStore := input.TokenStore; tokenStore != nil {
+		result.TokenStoreEnabled = utils.NormaliseNilableBool(tokenStore.Enabled)
+		if tokenStore.TokenRefreshExtensionHours != nil {
+			result.TokenRefreshExtension = *tokenStore.TokenRefreshExtensionHours
+		}
+		if tokenStore.FileSystem != nil {
+			result.TokenFilesystemPath = utils.NormalizeNilableString(tokenStore.FileSystem.Directory)
+		}
+		if tokenStore.AzureBlobStorage != nil {
+			result.TokenBlobStorageSAS = utils.NormalizeNilableString(tokenStore.AzureBlobStorage.SasUrlSettingName)
+		}
+	}
+
+	return []AuthV2Login{result}
+}
+
+func flattenAadAuthV2Settings(input *webapps.AzureActiveDirectory) []AadAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []AadAuthV2Settings{}
+	}
+
+	result := AadAuthV2Settings{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = utils.NormalizeNilableString(reg.ClientId)
+		result.TenantAuthURI = utils.NormalizeNilableString(reg.OpenIdIssuer)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(reg.ClientSecretSettingName)
+		result.ClientSecretCertificateThumbprint = utils.NormalizeNilableString(reg.ClientSecretCertificateThumbprint)
+	}
+
+	if login := input.Login; login != nil {
+		result.DisableWWWAuth = utils.NormaliseNilableBool(login.DisableWWWAuthenticate)
+		if login.LoginParameters != nil {
+			result.LoginParameters = make(map[string]string)
+			for _, v := range *login.LoginParameters {
+				if parts := strings.SplitN(v, "=", 2); len(parts) == 2 {
+					result.LoginParameters[parts[0]] = parts[1]
+				}
+			}
+		}
+	}
+
+	if validation := input.Validation; validation != nil {
+		if validation.AllowedAudiences != nil {
+			result.AllowedAudiences = *validation.AllowedAudiences
+		}
+		if checks := validation.JwtClaimChecks; checks != nil {
+			if checks.AllowedClientApplications != nil {
+				result.AllowedApplications = *checks.AllowedClientApplications
+			}
+			if checks.AllowedGroups != nil {
+				result.AllowedGroups = *checks.AllowedGroups
+			}
+		}
+	}
+
+	return []AadAuthV2Settings{result}
+}
+
+func flattenFacebookAuthV2Settings(input *webapps.Facebook) []FacebookAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []FacebookAuthV2Settings{}
+	}
+
+	result := FacebookAuthV2Settings{
+		GraphAPIVersion: utils.NormalizeNilableString(input.GraphApiVersion),
+	}
+
+	if reg := input.Registration; reg != nil {
+		result.AppId = utils.NormalizeNilableString(reg.AppId)
+		result.AppSecretSettingName = utils.NormalizeNilableString(reg.AppSecretSettingName)
+	}
+
+	if input.Login != nil && input.Login.Scopes != nil {
+		result.LoginScopes = *input.Login.Scopes
+	}
+
+	return []FacebookAuthV2Settings{result}
+}
+
+func flattenGithubAuthV2Settings(input *webapps.GitHub) []GithubAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []GithubAuthV2Settings{}
+	}
+
+	result := GithubAuthV2Settings{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = utils.NormalizeNilableString(reg.ClientId)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(reg.ClientSecretSettingName)
+	}
+
+	if input.Login != nil && input.Login.Scopes != nil {
+		result.LoginScopes = *input.Login.Scopes
+	}
+
+	return []GithubAuthV2Settings{result}
+}
+
+func flattenGoogleAuthV2Settings(input *webapps.Google) []GoogleAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []GoogleAuthV2Settings{}
+	}
+
+	result := GoogleAuthV2Settings{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = utils.NormalizeNilableString(reg.ClientId)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(reg.ClientSecretSettingName)
+	}
+
+	if input.Validation != nil && input.Validation.AllowedAudiences != nil {
+		result.AllowedAudiences = *input.Validation.AllowedAudiences
+	}
+
+	if input.Login != nil && input.Login.Scopes != nil {
+		result.LoginScopes = *input.Login.Scopes
+	}
+
+	return []GoogleAuthV2Settings{result}
+}
+
+func flattenMicrosoftAuthV2Settings(input *webapps.LegacyMicrosoftAccount) []MicrosoftAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []MicrosoftAuthV2Settings{}
+	}
+
+	result := MicrosoftAuthV2Settings{}
+
+	if reg := input.Registration; reg != nil {
+		result.ClientId = utils.NormalizeNilableString(reg.ClientId)
+		result.ClientSecretSettingName = utils.NormalizeNilableString(reg.ClientSecretSettingName)
+	}
+
+	if input.Validation != nil && input.Validation.AllowedAudiences != nil {
+		result.AllowedAudiences = *input.Validation.AllowedAudiences
+	}
+
+	if input.Login != nil && input.Login.Scopes != nil {
+		result.LoginScopes = *input.Login.Scopes
+	}
+
+	return []MicrosoftAuthV2Settings{result}
+}
+
+func flattenTwitterAuthV2Settings(input *webapps.Twitter) []TwitterAuthV2Settings {
+	if input == nil || !utils.NormaliseNilableBool(input.Enabled) {
+		return []TwitterAuthV2Settings{}
+	}
+
+	result := TwitterAuthV2Settings{}
+	if reg := input.Registration; reg != nil {
+		result.ConsumerKey = utils.NormalizeNilableString(reg.ConsumerKey)
+		result.ConsumerSecretSettingName = utils.NormalizeNilableString(reg.ConsumerSecretSettingName)
+	}
+
+	return []TwitterAuthV2Settings{result}
+}
+
+func flattenCustomOIDCAuthV2Settings(input *map[string]webapps.CustomOpenIdConnectProvider) []CustomOIDCAuthV2Settings {
+	results := make([]CustomOIDCAuthV2Settings, 0)
+	if input == nil {
+		return results
+	}
+
+	for name, v := range *input {
+		result := CustomOIDCAuthV2Settings{
+			Name: name,
+		}
+
+		if reg := v.Registration; reg != nil {
+			result.ClientId = utils.NormalizeNilableString(reg.ClientId)
+			if reg.ClientCredential != nil {
+				result.ClientSecretSettingName = utils.NormalizeNilableString(reg.ClientCredential.ClientSecretSettingName)
+			}
+			if reg.OpenIdConnectConfiguration != nil {
+				result.OpenIDConfigurationEndpoint = utils.NormalizeNilableString(reg.OpenIdConnectConfiguration.WellKnownOpenIdConfiguration)
+			}
+		}
+
+		if login := v.Login; login != nil {
+			result.NameClaimType = utils.NormalizeNilableString(login.NameClaimType)
+			if login.Scopes != nil {
+				result.Scopes = *login.Scopes
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
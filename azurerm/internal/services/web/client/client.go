@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/sdk/webapps"
 )
 
 type Client struct {
@@ -13,6 +14,7 @@ type Client struct {
 	CertificatesClient           *web.CertificatesClient
 	CertificatesOrderClient      *web.AppServiceCertificateOrdersClient
 	StaticSitesClient            *web.StaticSitesClient
+	WebAppsClient                *webapps.WebAppsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -37,6 +39,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	staticSitesClient := web.NewStaticSitesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&staticSitesClient.Client, o.ResourceManagerAuthorizer)
 
+	webAppsClient := webapps.NewWebAppsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&webAppsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AppServiceEnvironmentsClient: &appServiceEnvironmentsClient,
 		AppServicePlansClient:        &appServicePlansClient,
@@ -45,5 +50,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		CertificatesClient:           &certificatesClient,
 		CertificatesOrderClient:      &certificatesOrderClient,
 		StaticSitesClient:            &staticSitesClient,
+		WebAppsClient:                &webAppsClient,
 	}
 }
@@ -200,6 +200,8 @@ func resourceFunctionApp() *pluginsdk.Resource {
 				Default:  "~1",
 			},
 
+			"sticky_settings": schemaAppServiceStickySettings(),
+
 			"tags": tags.Schema(),
 
 			// Computed Only
@@ -557,6 +559,20 @@ func resourceFunctionAppUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if d.HasChange("sticky_settings") {
+		existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Slot Configuration Names for Function App %q: %+v", id.SiteName, err)
+		}
+
+		slotConfigNames := web.SlotConfigNamesResource{
+			SlotConfigNames: expandAppServiceStickySettings(d.Get("sticky_settings").([]interface{}), existing.SlotConfigNames),
+		}
+		if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, slotConfigNames); err != nil {
+			return fmt.Errorf("Error updating Slot Configuration Names for Function App %q: %+v", id.SiteName, err)
+		}
+	}
+
 	return resourceFunctionAppRead(d, meta)
 }
 
@@ -595,6 +611,11 @@ func resourceFunctionAppRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error making Read request on AzureRM Function App ConnectionStrings %q: %+v", id.SiteName, err)
 	}
 
+	slotConfigNamesResp, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM Function App Slot Configuration Names %q: %+v", id.SiteName, err)
+	}
+
 	siteCredFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
 		return err
@@ -694,6 +715,9 @@ func resourceFunctionAppRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err = d.Set("connection_string", flattenFunctionAppConnectionStrings(connectionStringsResp.Properties)); err != nil {
 		return err
 	}
+	if err = d.Set("sticky_settings", flattenAppServiceStickySettings(slotConfigNamesResp.SlotConfigNames)); err != nil {
+		return err
+	}
 
 	identity, err := flattenAppServiceIdentity(resp.Identity)
 	if err != nil {
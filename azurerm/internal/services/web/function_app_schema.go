@@ -0,0 +1,1117 @@
+package web
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type SiteConfigLinuxFunctionApp struct {
+	AlwaysOn                      bool                               `tfschema:"always_on"`
+	AppCommandLine                string                             `tfschema:"app_command_line"`
+	ApiDefinition                 string                             `tfschema:"api_definition_url"`
+	ApiManagementConfigId         string                             `tfschema:"api_management_api_id"`
+	AppInsightsInstrumentationKey string                             `tfschema:"application_insights_key"`
+	AppInsightsConnectionString   string                             `tfschema:"application_insights_connection_string"`
+	AppScaleLimit                 int                                `tfschema:"app_scale_limit"`
+	ApplicationStack              []ApplicationStackLinuxFunctionApp `tfschema:"application_stack"`
+	ContainerRegistryMSI          string                             `tfschema:"container_registry_managed_identity_client_id"`
+	ContainerRegistryUseMSI       bool                               `tfschema:"container_registry_use_managed_identity"`
+	Cors                          []CorsSetting                      `tfschema:"cors"`
+	DefaultDocuments              []string                           `tfschema:"default_documents"`
+	DetailedErrorLogging          bool                               `tfschema:"detailed_error_logging_enabled"`
+	ElasticInstanceMinimum        int                                `tfschema:"elastic_instance_minimum"`
+	FtpsState                     string                             `tfschema:"ftps_state"`
+	HealthCheckPath               string                             `tfschema:"health_check_path"`
+	Http2Enabled                  bool                               `tfschema:"http2_enabled"`
+	IpRestriction                 []IpRestriction                    `tfschema:"ip_restriction"`
+	LinuxFxVersion                string                             `tfschema:"linux_fx_version"`
+	LoadBalancing                 string                             `tfschema:"load_balancing_mode"`
+	ManagedPipelineMode           string                             `tfschema:"managed_pipeline_mode"`
+	MinTlsVersion                 string                             `tfschema:"minimum_tls_version"`
+	PreWarmedInstanceCount        int                                `tfschema:"pre_warmed_instance_count"`
+	RemoteDebugging               bool                               `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion        string                             `tfschema:"remote_debugging_version"`
+	RuntimeScaleMonitoring        bool                               `tfschema:"runtime_scale_monitoring_enabled"`
+	ScmIpRestriction              []IpRestriction                    `tfschema:"scm_ip_restriction"`
+	ScmMinTlsVersion              string                             `tfschema:"scm_minimum_tls_version"`
+	ScmType                       string                             `tfschema:"scm_type"`
+	ScmUseMainIpRestriction       bool                               `tfschema:"scm_use_main_ip_restriction"`
+	Use32BitWorker                bool                               `tfschema:"use_32_bit_worker"`
+	VnetRouteAllEnabled           bool                               `tfschema:"vnet_route_all_enabled"`
+	WebSockets                    bool                               `tfschema:"websockets_enabled"`
+	WorkerCount                   int                                `tfschema:"worker_count"`
+}
+
+type ApplicationStackLinuxFunctionApp struct {
+	DotNetVersion         string                   `tfschema:"dotnet_version"`
+	DotNetIsolated        bool                     `tfschema:"use_dotnet_isolated_runtime"`
+	NodeVersion           string                   `tfschema:"node_version"`
+	PythonVersion         string                   `tfschema:"python_version"`
+	PowerShellCoreVersion string                   `tfschema:"powershell_core_version"`
+	JavaVersion           string                   `tfschema:"java_version"`
+	Docker                []ApplicationStackDocker `tfschema:"docker"`
+	CustomHandler         bool                     `tfschema:"use_custom_runtime"`
+}
+
+type ApplicationStackDocker struct {
+	RegistryURL      string `tfschema:"registry_url"`
+	RegistryUsername string `tfschema:"registry_username"`
+	RegistryPassword string `tfschema:"registry_password"`
+	ImageName        string `tfschema:"image_name"`
+	ImageTag         string `tfschema:"image_tag"`
+}
+
+type SiteConfigWindowsFunctionApp struct {
+	AlwaysOn                      bool                                 `tfschema:"always_on"`
+	AppCommandLine                string                               `tfschema:"app_command_line"`
+	ApiDefinition                 string                               `tfschema:"api_definition_url"`
+	ApiManagementConfigId         string                               `tfschema:"api_management_api_id"`
+	AppInsightsInstrumentationKey string                               `tfschema:"application_insights_key"`
+	AppInsightsConnectionString   string                               `tfschema:"application_insights_connection_string"`
+	AppScaleLimit                 int                                  `tfschema:"app_scale_limit"`
+	ApplicationStack              []ApplicationStackWindowsFunctionApp `tfschema:"application_stack"`
+	Cors                          []CorsSetting                        `tfschema:"cors"`
+	DefaultDocuments              []string                             `tfschema:"default_documents"`
+	DetailedErrorLogging          bool                                 `tfschema:"detailed_error_logging_enabled"`
+	ElasticInstanceMinimum        int                                  `tfschema:"elastic_instance_minimum"`
+	FtpsState                     string                               `tfschema:"ftps_state"`
+	HealthCheckPath               string                               `tfschema:"health_check_path"`
+	Http2Enabled                  bool                                 `tfschema:"http2_enabled"`
+	IpRestriction                 []IpRestriction                      `tfschema:"ip_restriction"`
+	LoadBalancing                 string                               `tfschema:"load_balancing_mode"`
+	ManagedPipelineMode           string                               `tfschema:"managed_pipeline_mode"`
+	MinTlsVersion                 string                               `tfschema:"minimum_tls_version"`
+	PreWarmedInstanceCount        int                                  `tfschema:"pre_warmed_instance_count"`
+	RemoteDebugging               bool                                 `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion        string                               `tfschema:"remote_debugging_version"`
+	RuntimeScaleMonitoring        bool                                 `tfschema:"runtime_scale_monitoring_enabled"`
+	ScmIpRestriction              []IpRestriction                      `tfschema:"scm_ip_restriction"`
+	ScmMinTlsVersion              string                               `tfschema:"scm_minimum_tls_version"`
+	ScmType                       string                               `tfschema:"scm_type"`
+	ScmUseMainIpRestriction       bool                                 `tfschema:"scm_use_main_ip_restriction"`
+	Use32BitWorker                bool                                 `tfschema:"use_32_bit_worker"`
+	VnetRouteAllEnabled           bool                                 `tfschema:"vnet_route_all_enabled"`
+	WebSockets                    bool                                 `tfschema:"websockets_enabled"`
+	WindowsFxVersion              string                               `tfschema:"windows_fx_version"`
+	WorkerCount                   int                                  `tfschema:"worker_count"`
+}
+
+type ApplicationStackWindowsFunctionApp struct {
+	DotNetVersion         string `tfschema:"dotnet_version"`
+	DotNetIsolated        bool   `tfschema:"use_dotnet_isolated_runtime"`
+	NodeVersion           string `tfschema:"node_version"`
+	JavaVersion           string `tfschema:"java_version"`
+	PowerShellCoreVersion string `tfschema:"powershell_core_version"`
+	CustomHandler         bool   `tfschema:"use_custom_runtime"`
+}
+
+// functionAppManagedSettings are the App Settings which are exposed as first class properties of the
+// Function App resources, and are therefore removed from `app_settings` when reading the resource
+var functionAppManagedSettings = []string{
+	"APPINSIGHTS_INSTRUMENTATIONKEY",
+	"APPLICATIONINSIGHTS_CONNECTION_STRING",
+	"AzureWebJobsDashboard",
+	"AzureWebJobsStorage",
+	"AzureWebJobsStorage__accountName",
+	"DOCKER_REGISTRY_SERVER_PASSWORD",
+	"DOCKER_REGISTRY_SERVER_URL",
+	"DOCKER_REGISTRY_SERVER_USERNAME",
+	"FUNCTIONS_EXTENSION_VERSION",
+	"FUNCTIONS_WORKER_RUNTIME",
+	"WEBSITE_CONTENTAZUREFILECONNECTIONSTRING",
+	"WEBSITE_CONTENTSHARE",
+	"WEBSITE_NODE_DEFAULT_VERSION",
+}
+
+var linuxFunctionApplicationStackConstraint = []string{
+	"site_config.0.application_stack.0.dotnet_version",
+	"site_config.0.application_stack.0.java_version",
+	"site_config.0.application_stack.0.node_version",
+	"site_config.0.application_stack.0.powershell_core_version",
+	"site_config.0.application_stack.0.python_version",
+	"site_config.0.application_stack.0.docker",
+	"site_config.0.application_stack.0.use_custom_runtime",
+}
+
+func siteConfigSchemaLinuxFunctionApp() *pluginsdk.Schema {
+	s := functionAppSiteConfigSchemaCommon()
+	s["application_stack"] = linuxFunctionApplicationStackSchema()
+	s["container_registry_use_managed_identity"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	s["container_registry_managed_identity_client_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	s["linux_fx_version"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func siteConfigSchemaWindowsFunctionApp() *pluginsdk.Schema {
+	s := functionAppSiteConfigSchemaCommon()
+	s["application_stack"] = windowsFunctionApplicationStackSchema()
+	s["windows_fx_version"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func functionAppSiteConfigSchemaCommon() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"always_on": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"api_definition_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"api_management_api_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"app_command_line": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"app_scale_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"application_insights_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"application_insights_connection_string": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cors": corsSettingsSchema(),
+
+		"default_documents": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"detailed_error_logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
+		"elastic_instance_minimum": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 20),
+		},
+
+		"ftps_state": ftpsStateSchema(),
+
+		"health_check_path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"http2_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"ip_restriction": ipRestrictionSchema(),
+
+		"load_balancing_mode": loadBalancingModeSchema(),
+
+		"managed_pipeline_mode": managedPipelineModeSchema(),
+
+		"minimum_tls_version": tlsVersionSchema(),
+
+		"pre_warmed_instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 20),
+		},
+
+		"remote_debugging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"remote_debugging_version": remoteDebuggingVersionSchema(),
+
+		"runtime_scale_monitoring_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"scm_ip_restriction": ipRestrictionSchema(),
+
+		"scm_minimum_tls_version": tlsVersionSchema(),
+
+		"scm_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"scm_use_main_ip_restriction": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"use_32_bit_worker": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"vnet_route_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"websockets_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"worker_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+	}
+}
+
+func linuxFunctionApplicationStackSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.1",
+						"6.0",
+					}, false),
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"use_dotnet_isolated_runtime": {
+					Type:          pluginsdk.TypeBool,
+					Optional:      true,
+					Default:       false,
+					ConflictsWith: []string{"site_config.0.application_stack.0.java_version", "site_config.0.application_stack.0.node_version", "site_config.0.application_stack.0.powershell_core_version", "site_config.0.application_stack.0.python_version"},
+				},
+
+				"python_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.7",
+						"3.8",
+						"3.9",
+					}, false),
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"12",
+						"14",
+						"16",
+					}, false),
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"powershell_core_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"7",
+					}, false),
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"8",
+						"11",
+					}, false),
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"docker": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"registry_url": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"image_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"image_tag": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"registry_username": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"registry_password": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+
+				"use_custom_runtime": {
+					Type:         pluginsdk.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: linuxFunctionApplicationStackConstraint,
+				},
+			},
+		},
+	}
+}
+
+var windowsFunctionApplicationStackConstraint = []string{
+	"site_config.0.application_stack.0.dotnet_version",
+	"site_config.0.application_stack.0.java_version",
+	"site_config.0.application_stack.0.node_version",
+	"site_config.0.application_stack.0.powershell_core_version",
+	"site_config.0.application_stack.0.use_custom_runtime",
+}
+
+func windowsFunctionApplicationStackSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.1",
+						"6",
+					}, false),
+					ExactlyOneOf: windowsFunctionApplicationStackConstraint,
+				},
+
+				"use_dotnet_isolated_runtime": {
+					Type:          pluginsdk.TypeBool,
+					Optional:      true,
+					Default:       false,
+					ConflictsWith: []string{"site_config.0.application_stack.0.java_version", "site_config.0.application_stack.0.node_version", "site_config.0.application_stack.0.powershell_core_version"},
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"~12",
+						"~14",
+						"~16",
+					}, false),
+					ExactlyOneOf: windowsFunctionApplicationStackConstraint,
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.8",
+						"11",
+					}, false),
+					ExactlyOneOf: windowsFunctionApplicationStackConstraint,
+				},
+
+				"powershell_core_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"7",
+					}, false),
+					ExactlyOneOf: windowsFunctionApplicationStackConstraint,
+				},
+
+				"use_custom_runtime": {
+					Type:         pluginsdk.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: windowsFunctionApplicationStackConstraint,
+				},
+			},
+		},
+	}
+}
+
+func functionAppStorageArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_account_access_key": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"storage_uses_managed_identity"},
+		},
+
+		"storage_uses_managed_identity": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"storage_account_access_key"},
+		},
+
+		"builtin_logging_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"content_share_force_disabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"daily_memory_time_quota": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"functions_extension_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "~4",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+// functionAppStorageSettings holds the values used to build the App Settings which connect a Function App to its Storage Account
+type functionAppStorageSettings struct {
+	Name                     string
+	StorageAccountName       string
+	StorageAccountKey        string
+	StorageUsesMSI           bool
+	BuiltinLogging           bool
+	ContentShareForceDisable bool
+	ExtensionVersion         string
+	PlanTier                 string
+	IsLinux                  bool
+}
+
+func (s functionAppStorageSettings) appSettings(endpointSuffix string) (map[string]*string, error) {
+	output := make(map[string]*string)
+	output["FUNCTIONS_EXTENSION_VERSION"] = utils.String(s.ExtensionVersion)
+
+	var storageConnection string
+	switch {
+	case s.StorageUsesMSI:
+		output["AzureWebJobsStorage__accountName"] = utils.String(s.StorageAccountName)
+	case s.StorageAccountKey != "":
+		storageConnection = fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", s.StorageAccountName, s.StorageAccountKey, endpointSuffix)
+		output["AzureWebJobsStorage"] = utils.String(storageConnection)
+	default:
+		return nil, fmt.Errorf("one of `storage_account_access_key` or `storage_uses_managed_identity` must be specified")
+	}
+
+	if s.BuiltinLogging {
+		if storageConnection == "" {
+			return nil, fmt.Errorf("`builtin_logging_enabled` requires `storage_account_access_key` to be specified")
+		}
+		output["AzureWebJobsDashboard"] = utils.String(storageConnection)
+	}
+
+	// Consumption and Elastic Premium plans store the Function content in an Azure Files share
+	if !s.ContentShareForceDisable && storageConnection != "" && functionAppPlanUsesContentShare(s.PlanTier) {
+		output["WEBSITE_CONTENTAZUREFILECONNECTIONSTRING"] = utils.String(storageConnection)
+		output["WEBSITE_CONTENTSHARE"] = utils.String(fmt.Sprintf("%s-content", strings.ToLower(s.Name)))
+	}
+
+	return output, nil
+}
+
+func functionAppPlanUsesContentShare(tier string) bool {
+	return strings.EqualFold(tier, "dynamic") || strings.EqualFold(tier, "elasticpremium")
+}
+
+// parseFunctionAppStorageSettings reads the storage related values back out of the App Settings
+func parseFunctionAppStorageSettings(appSettings map[string]string) (accountName string, accountKey string, usesMSI bool) {
+	if v, ok := appSettings["AzureWebJobsStorage__accountName"]; ok {
+		return v, "", true
+	}
+
+	for _, part := range strings.Split(appSettings["AzureWebJobsStorage"], ";") {
+		switch {
+		case strings.HasPrefix(part, "AccountName="):
+			accountName = strings.TrimPrefix(part, "AccountName=")
+		case strings.HasPrefix(part, "AccountKey="):
+			accountKey = strings.TrimPrefix(part, "AccountKey=")
+		}
+	}
+
+	return accountName, accountKey, false
+}
+
+func stripFunctionAppManagedSettings(appSettings map[string]string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range appSettings {
+		output[k] = v
+	}
+	for _, k := range functionAppManagedSettings {
+		delete(output, k)
+	}
+
+	return output
+}
+
+func expandSiteConfigLinuxFunctionApp(siteConfig []SiteConfigLinuxFunctionApp, appSettings map[string]*string) (*web.SiteConfig, error) {
+	if len(siteConfig) == 0 {
+		return nil, nil
+	}
+
+	linuxSiteConfig := siteConfig[0]
+	expanded := &web.SiteConfig{
+		AlwaysOn:                               utils.Bool(linuxSiteConfig.AlwaysOn),
+		AcrUseManagedIdentityCreds:             utils.Bool(linuxSiteConfig.ContainerRegistryUseMSI),
+		AppCommandLine:                         utils.String(linuxSiteConfig.AppCommandLine),
+		FtpsState:                              web.FtpsState(linuxSiteConfig.FtpsState),
+		FunctionsRuntimeScaleMonitoringEnabled: utils.Bool(linuxSiteConfig.RuntimeScaleMonitoring),
+		HTTP20Enabled:                          utils.Bool(linuxSiteConfig.Http2Enabled),
+		LoadBalancing:                          web.SiteLoadBalancing(linuxSiteConfig.LoadBalancing),
+		ManagedPipelineMode:                    web.ManagedPipelineMode(linuxSiteConfig.ManagedPipelineMode),
+		MinTLSVersion:                          web.SupportedTLSVersions(linuxSiteConfig.MinTlsVersion),
+		RemoteDebuggingEnabled:                 utils.Bool(linuxSiteConfig.RemoteDebugging),
+		ScmIPSecurityRestrictionsUseMain:       utils.Bool(linuxSiteConfig.ScmUseMainIpRestriction),
+		ScmMinTLSVersion:                       web.SupportedTLSVersions(linuxSiteConfig.ScmMinTlsVersion),
+		Use32BitWorkerProcess:                  utils.Bool(linuxSiteConfig.Use32BitWorker),
+		VnetRouteAllEnabled:                    utils.Bool(linuxSiteConfig.VnetRouteAllEnabled),
+		WebSocketsEnabled:                      utils.Bool(linuxSiteConfig.WebSockets),
+	}
+
+	if linuxSiteConfig.ContainerRegistryMSI != "" {
+		if !linuxSiteConfig.ContainerRegistryUseMSI {
+			return nil, fmt.Errorf("`container_registry_managed_identity_client_id` can only be set when `container_registry_use_managed_identity` is `true`")
+		}
+		expanded.AcrUserManagedIdentityID = utils.String(linuxSiteConfig.ContainerRegistryMSI)
+	}
+
+	if len(linuxSiteConfig.ApplicationStack) == 1 {
+		stack := linuxSiteConfig.ApplicationStack[0]
+		switch {
+		case stack.DotNetVersion != "":
+			if stack.DotNetIsolated {
+				appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("dotnet-isolated")
+				expanded.LinuxFxVersion = utils.String(fmt.Sprintf("DOTNET-ISOLATED|%s", stack.DotNetVersion))
+			} else {
+				appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("dotnet")
+				expanded.LinuxFxVersion = utils.String(fmt.Sprintf("DOTNET|%s", stack.DotNetVersion))
+			}
+		case stack.NodeVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("node")
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("NODE|%s", stack.NodeVersion))
+		case stack.PythonVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("python")
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("PYTHON|%s", stack.PythonVersion))
+		case stack.JavaVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("java")
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("JAVA|%s", stack.JavaVersion))
+		case stack.PowerShellCoreVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("powershell")
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("POWERSHELL|%s", stack.PowerShellCoreVersion))
+		case len(stack.Docker) == 1:
+			docker := stack.Docker[0]
+			appSettings["DOCKER_REGISTRY_SERVER_URL"] = utils.String(docker.RegistryURL)
+			if docker.RegistryUsername != "" {
+				appSettings["DOCKER_REGISTRY_SERVER_USERNAME"] = utils.String(docker.RegistryUsername)
+			}
+			if docker.RegistryPassword != "" {
+				appSettings["DOCKER_REGISTRY_SERVER_PASSWORD"] = utils.String(docker.RegistryPassword)
+			}
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("DOCKER|%s/%s:%s", strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(docker.RegistryURL, "https://"), "http://"), "/"), docker.ImageName, docker.ImageTag))
+		case stack.CustomHandler:
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("custom")
+		}
+	}
+
+	if err := expandFunctionAppSiteConfigCommon(expanded, functionAppSiteConfigCommon{
+		ApiDefinition:                 linuxSiteConfig.ApiDefinition,
+		ApiManagementConfigId:         linuxSiteConfig.ApiManagementConfigId,
+		AppInsightsInstrumentationKey: linuxSiteConfig.AppInsightsInstrumentationKey,
+		AppInsightsConnectionString:   linuxSiteConfig.AppInsightsConnectionString,
+		AppScaleLimit:                 linuxSiteConfig.AppScaleLimit,
+		Cors:                          linuxSiteConfig.Cors,
+		DefaultDocuments:              linuxSiteConfig.DefaultDocuments,
+		ElasticInstanceMinimum:        linuxSiteConfig.ElasticInstanceMinimum,
+		HealthCheckPath:               linuxSiteConfig.HealthCheckPath,
+		IpRestriction:                 linuxSiteConfig.IpRestriction,
+		PreWarmedInstanceCount:        linuxSiteConfig.PreWarmedInstanceCount,
+		RemoteDebuggingVersion:        linuxSiteConfig.RemoteDebuggingVersion,
+		ScmIpRestriction:              linuxSiteConfig.ScmIpRestriction,
+		WorkerCount:                   linuxSiteConfig.WorkerCount,
+	}, appSettings); err != nil {
+		return nil, err
+	}
+
+	return expanded, nil
+}
+
+func expandSiteConfigWindowsFunctionApp(siteConfig []SiteConfigWindowsFunctionApp, appSettings map[string]*string) (*web.SiteConfig, error) {
+	if len(siteConfig) == 0 {
+		return nil, nil
+	}
+
+	winSiteConfig := siteConfig[0]
+	expanded := &web.SiteConfig{
+		AlwaysOn:                               utils.Bool(winSiteConfig.AlwaysOn),
+		AppCommandLine:                         utils.String(winSiteConfig.AppCommandLine),
+		FtpsState:                              web.FtpsState(winSiteConfig.FtpsState),
+		FunctionsRuntimeScaleMonitoringEnabled: utils.Bool(winSiteConfig.RuntimeScaleMonitoring),
+		HTTP20Enabled:                          utils.Bool(winSiteConfig.Http2Enabled),
+		LoadBalancing:                          web.SiteLoadBalancing(winSiteConfig.LoadBalancing),
+		ManagedPipelineMode:                    web.ManagedPipelineMode(winSiteConfig.ManagedPipelineMode),
+		MinTLSVersion:                          web.SupportedTLSVersions(winSiteConfig.MinTlsVersion),
+		RemoteDebuggingEnabled:                 utils.Bool(winSiteConfig.RemoteDebugging),
+		ScmIPSecurityRestrictionsUseMain:       utils.Bool(winSiteConfig.ScmUseMainIpRestriction),
+		ScmMinTLSVersion:                       web.SupportedTLSVersions(winSiteConfig.ScmMinTlsVersion),
+		Use32BitWorkerProcess:                  utils.Bool(winSiteConfig.Use32BitWorker),
+		VnetRouteAllEnabled:                    utils.Bool(winSiteConfig.VnetRouteAllEnabled),
+		WebSocketsEnabled:                      utils.Bool(winSiteConfig.WebSockets),
+	}
+
+	if len(winSiteConfig.ApplicationStack) == 1 {
+		stack := winSiteConfig.ApplicationStack[0]
+		switch {
+		case stack.DotNetVersion != "":
+			if stack.DotNetIsolated {
+				appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("dotnet-isolated")
+			} else {
+				appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("dotnet")
+			}
+			if stack.DotNetVersion == "3.1" {
+				expanded.NetFrameworkVersion = utils.String("v4.0")
+			} else {
+				expanded.NetFrameworkVersion = utils.String(fmt.Sprintf("v%s.0", stack.DotNetVersion))
+			}
+		case stack.NodeVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("node")
+			appSettings["WEBSITE_NODE_DEFAULT_VERSION"] = utils.String(stack.NodeVersion)
+		case stack.JavaVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("java")
+			expanded.JavaVersion = utils.String(stack.JavaVersion)
+		case stack.PowerShellCoreVersion != "":
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("powershell")
+			expanded.PowerShellVersion = utils.String(fmt.Sprintf("~%s", stack.PowerShellCoreVersion))
+		case stack.CustomHandler:
+			appSettings["FUNCTIONS_WORKER_RUNTIME"] = utils.String("custom")
+		}
+	}
+
+	if err := expandFunctionAppSiteConfigCommon(expanded, functionAppSiteConfigCommon{
+		ApiDefinition:                 winSiteConfig.ApiDefinition,
+		ApiManagementConfigId:         winSiteConfig.ApiManagementConfigId,
+		AppInsightsInstrumentationKey: winSiteConfig.AppInsightsInstrumentationKey,
+		AppInsightsConnectionString:   winSiteConfig.AppInsightsConnectionString,
+		AppScaleLimit:                 winSiteConfig.AppScaleLimit,
+		Cors:                          winSiteConfig.Cors,
+		DefaultDocuments:              winSiteConfig.DefaultDocuments,
+		ElasticInstanceMinimum:        winSiteConfig.ElasticInstanceMinimum,
+		HealthCheckPath:               winSiteConfig.HealthCheckPath,
+		IpRestriction:                 winSiteConfig.IpRestriction,
+		PreWarmedInstanceCount:        winSiteConfig.PreWarmedInstanceCount,
+		RemoteDebuggingVersion:        winSiteConfig.RemoteDebuggingVersion,
+		ScmIpRestriction:              winSiteConfig.ScmIpRestriction,
+		WorkerCount:                   winSiteConfig.WorkerCount,
+	}, appSettings); err != nil {
+		return nil, err
+	}
+
+	return expanded, nil
+}
+
+// functionAppSiteConfigCommon holds the Site Config values which are expanded identically for Linux and Windows Function Apps
+type functionAppSiteConfigCommon struct {
+	ApiDefinition                 string
+	ApiManagementConfigId         string
+	AppInsightsInstrumentationKey string
+	AppInsightsConnectionString   string
+	AppScaleLimit                 int
+	Cors                          []CorsSetting
+	DefaultDocuments              []string
+	ElasticInstanceMinimum        int
+	HealthCheckPath               string
+	IpRestriction                 []IpRestriction
+	PreWarmedInstanceCount        int
+	RemoteDebuggingVersion        string
+	ScmIpRestriction              []IpRestriction
+	WorkerCount                   int
+}
+
+func expandFunctionAppSiteConfigCommon(expanded *web.SiteConfig, input functionAppSiteConfigCommon, appSettings map[string]*string) error {
+	if input.ApiDefinition != "" {
+		expanded.APIDefinition = &web.APIDefinitionInfo{
+			URL: utils.String(input.ApiDefinition),
+		}
+	}
+
+	if input.ApiManagementConfigId != "" {
+		expanded.APIManagementConfig = &web.APIManagementConfig{
+			ID: utils.String(input.ApiManagementConfigId),
+		}
+	}
+
+	if input.AppInsightsInstrumentationKey != "" {
+		appSettings["APPINSIGHTS_INSTRUMENTATIONKEY"] = utils.String(input.AppInsightsInstrumentationKey)
+	}
+
+	if input.AppInsightsConnectionString != "" {
+		appSettings["APPLICATIONINSIGHTS_CONNECTION_STRING"] = utils.String(input.AppInsightsConnectionString)
+	}
+
+	if input.AppScaleLimit != 0 {
+		expanded.FunctionAppScaleLimit = utils.Int32(int32(input.AppScaleLimit))
+	}
+
+	if len(input.DefaultDocuments) != 0 {
+		defaultDocuments := input.DefaultDocuments
+		expanded.DefaultDocuments = &defaultDocuments
+	}
+
+	if input.ElasticInstanceMinimum != 0 {
+		expanded.MinimumElasticInstanceCount = utils.Int32(int32(input.ElasticInstanceMinimum))
+	}
+
+	if input.HealthCheckPath != "" {
+		expanded.HealthCheckPath = utils.String(input.HealthCheckPath)
+	}
+
+	if input.PreWarmedInstanceCount != 0 {
+		expanded.PreWarmedInstanceCount = utils.Int32(int32(input.PreWarmedInstanceCount))
+	}
+
+	if input.RemoteDebuggingVersion != "" {
+		expanded.RemoteDebuggingVersion = utils.String(input.RemoteDebuggingVersion)
+	}
+
+	if input.WorkerCount != 0 {
+		expanded.NumberOfWorkers = utils.Int32(int32(input.WorkerCount))
+	}
+
+	ipRestrictions, err := expandIpRestrictions(input.IpRestriction)
+	if err != nil {
+		return err
+	}
+	expanded.IPSecurityRestrictions = ipRestrictions
+
+	scmIpRestrictions, err := expandIpRestrictions(input.ScmIpRestriction)
+	if err != nil {
+		return err
+	}
+	expanded.ScmIPSecurityRestrictions = scmIpRestrictions
+
+	expanded.Cors = expandCorsSettings(input.Cors)
+
+	return nil
+}
+
+func flattenSiteConfigLinuxFunctionApp(functionAppSiteConfig *web.SiteConfig, appSettings map[string]string) []SiteConfigLinuxFunctionApp {
+	if functionAppSiteConfig == nil {
+		return []SiteConfigLinuxFunctionApp{}
+	}
+
+	siteConfig := SiteConfigLinuxFunctionApp{
+		AlwaysOn:                      utils.NormaliseNilableBool(functionAppSiteConfig.AlwaysOn),
+		AppCommandLine:                utils.NormalizeNilableString(functionAppSiteConfig.AppCommandLine),
+		AppInsightsInstrumentationKey: appSettings["APPINSIGHTS_INSTRUMENTATIONKEY"],
+		AppInsightsConnectionString:   appSettings["APPLICATIONINSIGHTS_CONNECTION_STRING"],
+		ContainerRegistryMSI:          utils.NormalizeNilableString(functionAppSiteConfig.AcrUserManagedIdentityID),
+		ContainerRegistryUseMSI:       utils.NormaliseNilableBool(functionAppSiteConfig.AcrUseManagedIdentityCreds),
+		Cors:                          flattenCorsSettings(functionAppSiteConfig.Cors),
+		DetailedErrorLogging:          utils.NormaliseNilableBool(functionAppSiteConfig.DetailedErrorLoggingEnabled),
+		FtpsState:                     string(functionAppSiteConfig.FtpsState),
+		HealthCheckPath:               utils.NormalizeNilableString(functionAppSiteConfig.HealthCheckPath),
+		Http2Enabled:                  utils.NormaliseNilableBool(functionAppSiteConfig.HTTP20Enabled),
+		IpRestriction:                 flattenIpRestrictions(functionAppSiteConfig.IPSecurityRestrictions),
+		LinuxFxVersion:                utils.NormalizeNilableString(functionAppSiteConfig.LinuxFxVersion),
+		LoadBalancing:                 string(functionAppSiteConfig.LoadBalancing),
+		ManagedPipelineMode:           string(functionAppSiteConfig.ManagedPipelineMode),
+		MinTlsVersion:                 string(functionAppSiteConfig.MinTLSVersion),
+		RemoteDebugging:               utils.NormaliseNilableBool(functionAppSiteConfig.RemoteDebuggingEnabled),
+		RemoteDebuggingVersion:        strings.ToUpper(utils.NormalizeNilableString(functionAppSiteConfig.RemoteDebuggingVersion)),
+		RuntimeScaleMonitoring:        utils.NormaliseNilableBool(functionAppSiteConfig.FunctionsRuntimeScaleMonitoringEnabled),
+		ScmIpRestriction:              flattenIpRestrictions(functionAppSiteConfig.ScmIPSecurityRestrictions),
+		ScmMinTlsVersion:              string(functionAppSiteConfig.ScmMinTLSVersion),
+		ScmType:                       string(functionAppSiteConfig.ScmType),
+		ScmUseMainIpRestriction:       utils.NormaliseNilableBool(functionAppSiteConfig.ScmIPSecurityRestrictionsUseMain),
+		Use32BitWorker:                utils.NormaliseNilableBool(functionAppSiteConfig.Use32BitWorkerProcess),
+		VnetRouteAllEnabled:           utils.NormaliseNilableBool(functionAppSiteConfig.VnetRouteAllEnabled),
+		WebSockets:                    utils.NormaliseNilableBool(functionAppSiteConfig.WebSocketsEnabled),
+	}
+
+	if functionAppSiteConfig.APIDefinition != nil && functionAppSiteConfig.APIDefinition.URL != nil {
+		siteConfig.ApiDefinition = *functionAppSiteConfig.APIDefinition.URL
+	}
+
+	if functionAppSiteConfig.APIManagementConfig != nil && functionAppSiteConfig.APIManagementConfig.ID != nil {
+		siteConfig.ApiManagementConfigId = *functionAppSiteConfig.APIManagementConfig.ID
+	}
+
+	if functionAppSiteConfig.DefaultDocuments != nil {
+		siteConfig.DefaultDocuments = *functionAppSiteConfig.DefaultDocuments
+	}
+
+	if functionAppSiteConfig.FunctionAppScaleLimit != nil {
+		siteConfig.AppScaleLimit = int(*functionAppSiteConfig.FunctionAppScaleLimit)
+	}
+
+	if functionAppSiteConfig.MinimumElasticInstanceCount != nil {
+		siteConfig.ElasticInstanceMinimum = int(*functionAppSiteConfig.MinimumElasticInstanceCount)
+	}
+
+	if functionAppSiteConfig.PreWarmedInstanceCount != nil {
+		siteConfig.PreWarmedInstanceCount = int(*functionAppSiteConfig.PreWarmedInstanceCount)
+	}
+
+	if functionAppSiteConfig.NumberOfWorkers != nil {
+		siteConfig.WorkerCount = int(*functionAppSiteConfig.NumberOfWorkers)
+	}
+
+	stack := ApplicationStackLinuxFunctionApp{}
+	hasStack := true
+	parts := strings.SplitN(siteConfig.LinuxFxVersion, "|", 2)
+	if len(parts) == 2 {
+		switch strings.ToUpper(parts[0]) {
+		case "DOTNET":
+			stack.DotNetVersion = parts[1]
+		case "DOTNET-ISOLATED":
+			stack.DotNetVersion = parts[1]
+			stack.DotNetIsolated = true
+		case "NODE":
+			stack.NodeVersion = parts[1]
+		case "PYTHON":
+			stack.PythonVersion = parts[1]
+		case "JAVA":
+			stack.JavaVersion = parts[1]
+		case "POWERSHELL":
+			stack.PowerShellCoreVersion = parts[1]
+		case "DOCKER":
+			docker := ApplicationStackDocker{
+				RegistryURL:      appSettings["DOCKER_REGISTRY_SERVER_URL"],
+				RegistryUsername: appSettings["DOCKER_REGISTRY_SERVER_USERNAME"],
+				RegistryPassword: appSettings["DOCKER_REGISTRY_SERVER_PASSWORD"],
+			}
+			image := parts[1]
+			if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+				docker.ImageTag = image[idx+1:]
+				image = image[:idx]
+			}
+			if idx := strings.Index(image, "/"); idx > 0 {
+				image = image[idx+1:]
+			}
+			docker.ImageName = image
+			stack.Docker = []ApplicationStackDocker{docker}
+		default:
+			hasStack = false
+		}
+	} else {
+		hasStack = strings.EqualFold(appSettings["FUNCTIONS_WORKER_RUNTIME"], "custom")
+		stack.CustomHandler = hasStack
+	}
+
+	if hasStack {
+		siteConfig.ApplicationStack = []ApplicationStackLinuxFunctionApp{stack}
+	}
+
+	return []SiteConfigLinuxFunctionApp{siteConfig}
+}
+
+func flattenSiteConfigWindowsFunctionApp(functionAppSiteConfig *web.SiteConfig, appSettings map[string]string) []SiteConfigWindowsFunctionApp {
+	if functionAppSiteConfig == nil {
+		return []SiteConfigWindowsFunctionApp{}
+	}
+
+	siteConfig := SiteConfigWindowsFunctionApp{
+		AlwaysOn:                      utils.NormaliseNilableBool(functionAppSiteConfig.AlwaysOn),
+		AppCommandLine:                utils.NormalizeNilableString(functionAppSiteConfig.AppCommandLine),
+		AppInsightsInstrumentationKey: appSettings["APPINSIGHTS_INSTRUMENTATIONKEY"],
+		AppInsightsConnectionString:   appSettings["APPLICATIONINSIGHTS_CONNECTION_STRING"],
+		Cors:                          flattenCorsSettings(functionAppSiteConfig.Cors),
+		DetailedErrorLogging:          utils.NormaliseNilableBool(functionAppSiteConfig.DetailedErrorLoggingEnabled),
+		FtpsState:                     string(functionAppSiteConfig.FtpsState),
+		HealthCheckPath:               utils.NormalizeNilableString(functionAppSiteConfig.HealthCheckPath),
+		Http2Enabled:                  utils.NormaliseNilableBool(functionAppSiteConfig.HTTP20Enabled),
+		IpRestriction:                 flattenIpRestrictions(functionAppSiteConfig.IPSecurityRestrictions),
+		LoadBalancing:                 string(functionAppSiteConfig.LoadBalancing),
+		ManagedPipelineMode:           string(functionAppSiteConfig.ManagedPipelineMode),
+		MinTlsVersion:                 string(functionAppSiteConfig.MinTLSVersion),
+		RemoteDebugging:               utils.NormaliseNilableBool(functionAppSiteConfig.RemoteDebuggingEnabled),
+		RemoteDebuggingVersion:        strings.ToUpper(utils.NormalizeNilableString(functionAppSiteConfig.RemoteDebuggingVersion)),
+		RuntimeScaleMonitoring:        utils.NormaliseNilableBool(functionAppSiteConfig.FunctionsRuntimeScaleMonitoringEnabled),
+		ScmIpRestriction:              flattenIpRestrictions(functionAppSiteConfig.ScmIPSecurityRestrictions),
+		ScmMinTlsVersion:              string(functionAppSiteConfig.ScmMinTLSVersion),
+		ScmType:                       string(functionAppSiteConfig.ScmType),
+		ScmUseMainIpRestriction:       utils.NormaliseNilableBool(functionAppSiteConfig.ScmIPSecurityRestrictionsUseMain),
+		Use32BitWorker:                utils.NormaliseNilableBool(functionAppSiteConfig.Use32BitWorkerProcess),
+		VnetRouteAllEnabled:           utils.NormaliseNilableBool(functionAppSiteConfig.VnetRouteAllEnabled),
+		WebSockets:                    utils.NormaliseNilableBool(functionAppSiteConfig.WebSocketsEnabled),
+		WindowsFxVersion:              utils.NormalizeNilableString(functionAppSiteConfig.WindowsFxVersion),
+	}
+
+	if functionAppSiteConfig.APIDefinition != nil && functionAppSiteConfig.APIDefinition.URL != nil {
+		siteConfig.ApiDefinition = *functionAppSiteConfig.APIDefinition.URL
+	}
+
+	if functionAppSiteConfig.APIManagementConfig != nil && functionAppSiteConfig.APIManagementConfig.ID != nil {
+		siteConfig.ApiManagementConfigId = *functionAppSiteConfig.APIManagementConfig.ID
+	}
+
+	if functionAppSiteConfig.DefaultDocuments != nil {
+		siteConfig.DefaultDocuments = *functionAppSiteConfig.DefaultDocuments
+	}
+
+	if functionAppSiteConfig.FunctionAppScaleLimit != nil {
+		siteConfig.AppScaleLimit = int(*functionAppSiteConfig.FunctionAppScaleLimit)
+	}
+
+	if functionAppSiteConfig.MinimumElasticInstanceCount != nil {
+		siteConfig.ElasticInstanceMinimum = int(*functionAppSiteConfig.MinimumElasticInstanceCount)
+	}
+
+	if functionAppSiteConfig.PreWarmedInstanceCount != nil {
+		siteConfig.PreWarmedInstanceCount = int(*functionAppSiteConfig.PreWarmedInstanceCount)
+	}
+
+	if functionAppSiteConfig.NumberOfWorkers != nil {
+		siteConfig.WorkerCount = int(*functionAppSiteConfig.NumberOfWorkers)
+	}
+
+	stack := ApplicationStackWindowsFunctionApp{}
+	hasStack := true
+	switch strings.ToLower(appSettings["FUNCTIONS_WORKER_RUNTIME"]) {
+	case "dotnet", "dotnet-isolated":
+		stack.DotNetIsolated = strings.EqualFold(appSettings["FUNCTIONS_WORKER_RUNTIME"], "dotnet-isolated")
+		stack.DotNetVersion = "3.1"
+		if v := utils.NormalizeNilableString(functionAppSiteConfig.NetFrameworkVersion); v != "" && v != "v4.0" {
+			stack.DotNetVersion = strings.TrimSuffix(strings.TrimPrefix(v, "v"), ".0")
+		}
+	case "node":
+		stack.NodeVersion = appSettings["WEBSITE_NODE_DEFAULT_VERSION"]
+	case "java":
+		stack.JavaVersion = utils.NormalizeNilableString(functionAppSiteConfig.JavaVersion)
+	case "powershell":
+		stack.PowerShellCoreVersion = strings.TrimPrefix(utils.NormalizeNilableString(functionAppSiteConfig.PowerShellVersion), "~")
+	case "custom":
+		stack.CustomHandler = true
+	default:
+		hasStack = false
+	}
+
+	if hasStack {
+		siteConfig.ApplicationStack = []ApplicationStackWindowsFunctionApp{stack}
+	}
+
+	return []SiteConfigWindowsFunctionApp{siteConfig}
+}
+
+func expandFunctionAppSettingsList(input map[string]*string) *[]web.NameValuePair {
+	output := make([]web.NameValuePair, 0)
+	for k, v := range input {
+		output = append(output, web.NameValuePair{
+			Name:  utils.String(k),
+			Value: v,
+		})
+	}
+
+	return &output
+}
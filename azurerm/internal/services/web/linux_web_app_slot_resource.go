@@ -0,0 +1,571 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/sdk/webapps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type LinuxWebAppSlotResource struct{}
+
+type LinuxWebAppSlotModel struct {
+	Name                          string                 `tfschema:"name"`
+	AppServiceId                  string                 `tfschema:"app_service_id"`
+	AppSettings                   map[string]string      `tfschema:"app_settings"`
+	AuthV2Settings                []AuthV2Settings       `tfschema:"auth_settings_v2"`
+	ClientAffinityEnabled         bool                   `tfschema:"client_affinity_enabled"`
+	ClientCertEnabled             bool                   `tfschema:"client_certificate_enabled"`
+	ClientCertMode                string                 `tfschema:"client_certificate_mode"`
+	ConnectionStrings             []ConnectionString     `tfschema:"connection_string"`
+	Enabled                       bool                   `tfschema:"enabled"`
+	HttpsOnly                     bool                   `tfschema:"https_only"`
+	KeyVaultReferenceIdentityID   string                 `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                    []SiteConfigLinux      `tfschema:"site_config"`
+	StorageAccounts               []StorageAccount       `tfschema:"storage_account"`
+	Tags                          map[string]interface{} `tfschema:"tags"`
+	ZipDeployFile                 string                 `tfschema:"zip_deploy_file"`
+	CustomDomainVerificationId    string                 `tfschema:"custom_domain_verification_id"`
+	DefaultHostname               string                 `tfschema:"default_hostname"`
+	Kind                          string                 `tfschema:"kind"`
+	OutboundIPAddresses           string                 `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string               `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string                 `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string               `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials               []SiteCredential       `tfschema:"site_credential"`
+	ZipDeployFileHash             string                 `tfschema:"zip_deploy_file_hash"`
+}
+
+var _ sdk.Resource = LinuxWebAppSlotResource{}
+var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppSlotResource{}
+
+func (r LinuxWebAppSlotResource) ModelObject() interface{} {
+	return LinuxWebAppSlotModel{}
+}
+
+func (r LinuxWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppServiceSlotID
+}
+
+func (r LinuxWebAppSlotResource) ResourceType() string {
+	return "azurerm_linux_web_app_slot"
+}
+
+func (r LinuxWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return zipDeployFileCustomizeDiff()
+}
+
+func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AppServiceName,
+		},
+
+		"app_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AppServiceID,
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"auth_settings_v2": authV2SettingsSchema(),
+
+		"client_affinity_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"client_certificate_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"client_certificate_mode": clientCertificateModeSchema(),
+
+		"connection_string": connectionStringSchema(),
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": webAppIdentity{}.Schema(),
+
+		"key_vault_reference_identity_id": keyVaultReferenceIdentityIdSchema(),
+
+		"site_config": siteConfigSchemaLinux(),
+
+		"storage_account": storageAccountSchema(),
+
+		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r LinuxWebAppSlotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_credential": siteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var webApp LinuxWebAppSlotModel
+			if err := metadata.Decode(&webApp); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Web.AppServicesClient
+
+			appServiceId, err := parse.AppServiceID(webApp.AppServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewAppServiceSlotID(appServiceId.SubscriptionId, appServiceId.ResourceGroup, appServiceId.SiteName, webApp.Name)
+			existing, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			appService, err := client.Get(ctx, appServiceId.ResourceGroup, appServiceId.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving parent %s: %+v", appServiceId, err)
+			}
+			if appService.SiteProperties == nil || appService.ServerFarmID == nil {
+				return fmt.Errorf("reading parent %s: `properties.serverFarmId` was nil", appServiceId)
+			}
+
+			siteConfig, err := expandSiteConfigLinux(webApp.SiteConfig)
+			if err != nil {
+				return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+			}
+
+			siteIdentity, err := expandWebAppIdentity(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			siteEnvelope := web.Site{
+				Location: appService.Location,
+				Identity: siteIdentity,
+				Tags:     tags.Expand(webApp.Tags),
+				SiteProperties: &web.SiteProperties{
+					ServerFarmID:          appService.ServerFarmID,
+					Enabled:               utils.Bool(webApp.Enabled),
+					HTTPSOnly:             utils.Bool(webApp.HttpsOnly),
+					SiteConfig:            siteConfig,
+					ClientAffinityEnabled: utils.Bool(webApp.ClientAffinityEnabled),
+					ClientCertEnabled:     utils.Bool(webApp.ClientCertEnabled),
+					ClientCertMode:        web.ClientCertMode(webApp.ClientCertMode),
+				},
+			}
+
+			if webApp.KeyVaultReferenceIdentityID != "" {
+				siteEnvelope.SiteProperties.KeyVaultReferenceIdentity = utils.String(webApp.KeyVaultReferenceIdentityID)
+			}
+
+			future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, siteEnvelope, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(webApp.AppSettings) > 0 {
+				appSettings := web.StringDictionary{
+					Properties: expandAppSettings(webApp.AppSettings),
+				}
+				if _, err := client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, appSettings, id.SlotName); err != nil {
+					return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.AuthV2Settings) > 0 {
+				authId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2Slot(ctx, authId, *expandAuthV2Settings(webApp.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.ConnectionStrings) > 0 {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(webApp.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, connectionStrings, id.SlotName); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.StorageAccounts) > 0 {
+				storageAccounts := web.AzureStoragePropertyDictionaryResource{
+					Properties: expandStorageAccounts(webApp.StorageAccounts),
+				}
+				if _, err := client.UpdateAzureStorageAccountsSlot(ctx, id.ResourceGroup, id.SiteName, storageAccounts, id.SlotName); err != nil {
+					return fmt.Errorf("updating Storage Accounts for %s: %+v", id, err)
+				}
+			}
+
+			if webApp.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, webApp.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state LinuxWebAppSlotModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			webApp, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(webApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			siteConfig, err := client.GetConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("retrieving Site Config for %s: %+v", id, err)
+			}
+
+			appSettings, err := client.ListApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("retrieving App Settings for %s: %+v", id, err)
+			}
+
+			authV2Settings, err := metadata.Client.Web.WebAppsClient.GetAuthSettingsV2Slot(ctx, webapps.NewSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName))
+			if err != nil {
+				return fmt.Errorf("retrieving Auth V2 Settings for %s: %+v", id, err)
+			}
+
+			connectionStrings, err := client.ListConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("retrieving Connection Strings for %s: %+v", id, err)
+			}
+
+			storageAccounts, err := client.ListAzureStorageAccountsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Accounts for %s: %+v", id, err)
+			}
+
+			siteCredentialsFuture, err := client.ListPublishingCredentialsSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("listing Site Publishing Credentials for %s: %+v", id, err)
+			}
+			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for Site Publishing Credentials for %s: %+v", id, err)
+			}
+			siteCredentials, err := siteCredentialsFuture.Result(*client)
+			if err != nil {
+				return fmt.Errorf("reading Site Publishing Credentials for %s: %+v", id, err)
+			}
+
+			model := LinuxWebAppSlotModel{
+				Name:              id.SlotName,
+				AppServiceId:      parse.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				AppSettings:       flattenAppSettings(appSettings),
+				AuthV2Settings:    flattenAuthV2Settings(authV2Settings.Model),
+				ConnectionStrings: flattenConnectionStrings(connectionStrings),
+				Kind:              utils.NormalizeNilableString(webApp.Kind),
+				SiteConfig:        flattenSiteConfigLinux(siteConfig.SiteConfig),
+				SiteCredentials:   flattenSiteCredentials(siteCredentials),
+				StorageAccounts:   flattenStorageAccounts(storageAccounts, state.StorageAccounts),
+				Tags:              tags.Flatten(webApp.Tags),
+			}
+
+			if props := webApp.SiteProperties; props != nil {
+				model.ClientAffinityEnabled = utils.NormaliseNilableBool(props.ClientAffinityEnabled)
+				model.ClientCertEnabled = utils.NormaliseNilableBool(props.ClientCertEnabled)
+				model.ClientCertMode = string(props.ClientCertMode)
+				model.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationID)
+				model.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostName)
+				model.Enabled = utils.NormaliseNilableBool(props.Enabled)
+				model.HttpsOnly = utils.NormaliseNilableBool(props.HTTPSOnly)
+				model.KeyVaultReferenceIdentityID = utils.NormalizeNilableString(props.KeyVaultReferenceIdentity)
+				model.OutboundIPAddresses = utils.NormalizeNilableString(props.OutboundIPAddresses)
+				model.OutboundIPAddressList = splitIpAddresses(model.OutboundIPAddresses)
+				model.PossibleOutboundIPAddresses = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+				model.PossibleOutboundIPAddressList = splitIpAddresses(model.PossibleOutboundIPAddresses)
+			}
+
+			// the API returns the System Assigned Identity as the Key Vault Reference Identity when none is configured
+			if strings.EqualFold(model.KeyVaultReferenceIdentityID, "SystemAssigned") {
+				model.KeyVaultReferenceIdentityID = ""
+			}
+
+			if err := metadata.ResourceData.Set("identity", flattenWebAppIdentity(webApp.Identity)); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			// the zip package isn't returned by the API, so these are retained from the state
+			model.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			model.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r LinuxWebAppSlotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", id)
+
+			deleteMetrics := true
+			deleteEmptyServerFarm := false
+			if resp, err := client.DeleteSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceSlotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state LinuxWebAppSlotModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+			if existing.SiteProperties == nil {
+				return fmt.Errorf("reading %s: `properties` was nil", id)
+			}
+
+			rd := metadata.ResourceData
+
+			if rd.HasChange("enabled") {
+				existing.SiteProperties.Enabled = utils.Bool(state.Enabled)
+			}
+
+			if rd.HasChange("https_only") {
+				existing.SiteProperties.HTTPSOnly = utils.Bool(state.HttpsOnly)
+			}
+
+			if rd.HasChange("client_affinity_enabled") {
+				existing.SiteProperties.ClientAffinityEnabled = utils.Bool(state.ClientAffinityEnabled)
+			}
+
+			if rd.HasChange("client_certificate_enabled") {
+				existing.SiteProperties.ClientCertEnabled = utils.Bool(state.ClientCertEnabled)
+			}
+
+			if rd.HasChange("client_certificate_mode") {
+				existing.SiteProperties.ClientCertMode = web.ClientCertMode(state.ClientCertMode)
+			}
+
+			if rd.HasChange("identity") {
+				siteIdentity, err := expandWebAppIdentity(rd.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				existing.Identity = siteIdentity
+			}
+
+			if rd.HasChange("key_vault_reference_identity_id") {
+				existing.SiteProperties.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
+			}
+
+			if rd.HasChange("site_config") {
+				siteConfig, err := expandSiteConfigLinux(state.SiteConfig)
+				if err != nil {
+					return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+				}
+				existing.SiteProperties.SiteConfig = siteConfig
+			}
+
+			if rd.HasChange("tags") {
+				existing.Tags = tags.Expand(state.Tags)
+			}
+
+			future, err := client.CreateOrUpdateSlot(ctx, id.ResourceGroup, id.SiteName, existing, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			if rd.HasChange("app_settings") {
+				appSettings := web.StringDictionary{
+					Properties: expandAppSettings(state.AppSettings),
+				}
+				if _, err := client.UpdateApplicationSettingsSlot(ctx, id.ResourceGroup, id.SiteName, appSettings, id.SlotName); err != nil {
+					return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("auth_settings_v2") {
+				authId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2Slot(ctx, authId, *expandAuthV2Settings(state.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("connection_string") {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(state.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStringsSlot(ctx, id.ResourceGroup, id.SiteName, connectionStrings, id.SlotName); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("storage_account") {
+				storageAccounts := web.AzureStoragePropertyDictionaryResource{
+					Properties: expandStorageAccounts(state.StorageAccounts),
+				}
+				if _, err := client.UpdateAzureStorageAccountsSlot(ctx, id.ResourceGroup, id.SiteName, storageAccounts, id.SlotName); err != nil {
+					return fmt.Errorf("updating Storage Accounts for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChanges("zip_deploy_file", "zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, id.SlotName, state.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := rd.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
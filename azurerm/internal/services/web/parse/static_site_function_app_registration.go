@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type StaticSiteFunctionAppRegistrationId struct {
+	SubscriptionId              string
+	ResourceGroup               string
+	StaticSiteName              string
+	UserProvidedFunctionAppName string
+}
+
+func NewStaticSiteFunctionAppRegistrationID(subscriptionId, resourceGroup, staticSiteName, userProvidedFunctionAppName string) StaticSiteFunctionAppRegistrationId {
+	return StaticSiteFunctionAppRegistrationId{
+		SubscriptionId:              subscriptionId,
+		ResourceGroup:               resourceGroup,
+		StaticSiteName:              staticSiteName,
+		UserProvidedFunctionAppName: userProvidedFunctionAppName,
+	}
+}
+
+func (id StaticSiteFunctionAppRegistrationId) String() string {
+	segments := []string{
+		fmt.Sprintf("User Provided Function App Name %q", id.UserProvidedFunctionAppName),
+		fmt.Sprintf("Static Site Name %q", id.StaticSiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Static Site Function App Registration", segmentsStr)
+}
+
+func (id StaticSiteFunctionAppRegistrationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/staticSites/%s/userProvidedFunctionApps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StaticSiteName, id.UserProvidedFunctionAppName)
+}
+
+// StaticSiteFunctionAppRegistrationID parses a StaticSiteFunctionAppRegistration ID into an StaticSiteFunctionAppRegistrationId struct
+func StaticSiteFunctionAppRegistrationID(input string) (*StaticSiteFunctionAppRegistrationId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StaticSiteFunctionAppRegistrationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StaticSiteName, err = id.PopSegment("staticSites"); err != nil {
+		return nil, err
+	}
+	if resourceId.UserProvidedFunctionAppName, err = id.PopSegment("userProvidedFunctionApps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
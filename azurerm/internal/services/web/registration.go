@@ -52,6 +52,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_function_app":                                      resourceFunctionApp(),
 		"azurerm_function_app_slot":                                 resourceFunctionAppSlot(),
 		"azurerm_static_site":                                       resourceStaticSite(),
+		"azurerm_static_site_custom_domain":                         resourceStaticSiteCustomDomain(),
+		"azurerm_static_site_function_app_registration":             resourceStaticSiteFunctionAppRegistration(),
 	}
 }
 
@@ -64,5 +66,13 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AppServiceEnvironmentV3Resource{},
+		LinuxFunctionAppResource{},
+		LinuxFunctionAppSlotResource{},
+		LinuxWebAppResource{},
+		LinuxWebAppSlotResource{},
+		WindowsFunctionAppResource{},
+		WindowsFunctionAppSlotResource{},
+		WindowsWebAppResource{},
+		WindowsWebAppSlotResource{},
 	}
 }
@@ -0,0 +1,15 @@
+package webapps
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WebAppsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWebAppsClientWithBaseURI(endpoint string) WebAppsClient {
+	return WebAppsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package webapps
+
+type ClientCredentialMethod string
+
+const (
+	ClientCredentialMethodClientSecretPost ClientCredentialMethod = "ClientSecretPost"
+)
+
+type CookieExpirationConvention string
+
+const (
+	CookieExpirationConventionFixedTime               CookieExpirationConvention = "FixedTime"
+	CookieExpirationConventionIdentityProviderDerived CookieExpirationConvention = "IdentityProviderDerived"
+)
+
+type ForwardProxyConvention string
+
+const (
+	ForwardProxyConventionCustom   ForwardProxyConvention = "Custom"
+	ForwardProxyConventionNoProxy  ForwardProxyConvention = "NoProxy"
+	ForwardProxyConventionStandard ForwardProxyConvention = "Standard"
+)
+
+type UnauthenticatedClientActionV2 string
+
+const (
+	UnauthenticatedClientActionV2AllowAnonymous      UnauthenticatedClientActionV2 = "AllowAnonymous"
+	UnauthenticatedClientActionV2RedirectToLoginPage UnauthenticatedClientActionV2 = "RedirectToLoginPage"
+	UnauthenticatedClientActionV2ReturnFourZeroOne   UnauthenticatedClientActionV2 = "Return401"
+	UnauthenticatedClientActionV2ReturnFourZeroThree UnauthenticatedClientActionV2 = "Return403"
+)
@@ -0,0 +1,126 @@
+package webapps
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type SlotId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	Name           string
+}
+
+func NewSlotID(subscriptionId, resourceGroup, siteName, name string) SlotId {
+	return SlotId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		Name:           name,
+	}
+}
+
+func (id SlotId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Slot", segmentsStr)
+}
+
+func (id SlotId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/slots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.Name)
+}
+
+// ParseSlotID parses a Slot ID into a SlotId struct
+func ParseSlotID(input string) (*SlotId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SlotId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("slots"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ParseSlotIDInsensitively parses a Slot ID into a SlotId struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the ParseSlotID method should be used instead for validation etc.
+func ParseSlotIDInsensitively(input string) (*SlotId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SlotId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'sites' segment
+	sitesKey := "sites"
+	for key := range id.Path {
+		if strings.EqualFold(key, sitesKey) {
+			sitesKey = key
+			break
+		}
+	}
+	if resourceId.SiteName, err = id.PopSegment(sitesKey); err != nil {
+		return nil, err
+	}
+
+	// find the correct casing for the 'slots' segment
+	slotsKey := "slots"
+	for key := range id.Path {
+		if strings.EqualFold(key, slotsKey) {
+			slotsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(slotsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
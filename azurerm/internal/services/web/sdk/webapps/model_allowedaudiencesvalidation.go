@@ -0,0 +1,5 @@
+package webapps
+
+type AllowedAudiencesValidation struct {
+	AllowedAudiences *[]string `json:"allowedAudiences,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type AppRegistration struct {
+	AppId                *string `json:"appId,omitempty"`
+	AppSecretSettingName *string `json:"appSecretSettingName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapps
+
+type AuthPlatform struct {
+	ConfigFilePath *string `json:"configFilePath,omitempty"`
+	Enabled        *bool   `json:"enabled,omitempty"`
+	RuntimeVersion *string `json:"runtimeVersion,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapps
+
+type AzureActiveDirectory struct {
+	Enabled           *bool                             `json:"enabled,omitempty"`
+	IsAutoProvisioned *bool                             `json:"isAutoProvisioned,omitempty"`
+	Login             *AzureActiveDirectoryLogin        `json:"login,omitempty"`
+	Registration      *AzureActiveDirectoryRegistration `json:"registration,omitempty"`
+	Validation        *AzureActiveDirectoryValidation   `json:"validation,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type AzureActiveDirectoryLogin struct {
+	DisableWWWAuthenticate *bool     `json:"disableWWWAuthenticate,omitempty"`
+	LoginParameters        *[]string `json:"loginParameters,omitempty"`
+}
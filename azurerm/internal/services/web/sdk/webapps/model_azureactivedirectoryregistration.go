@@ -0,0 +1,8 @@
+package webapps
+
+type AzureActiveDirectoryRegistration struct {
+	ClientId                          *string `json:"clientId,omitempty"`
+	ClientSecretCertificateThumbprint *string `json:"clientSecretCertificateThumbprint,omitempty"`
+	ClientSecretSettingName           *string `json:"clientSecretSettingName,omitempty"`
+	OpenIdIssuer                      *string `json:"openIdIssuer,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type AzureActiveDirectoryValidation struct {
+	AllowedAudiences *[]string       `json:"allowedAudiences,omitempty"`
+	JwtClaimChecks   *JwtClaimChecks `json:"jwtClaimChecks,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapps
+
+type BlobStorageTokenStore struct {
+	SasUrlSettingName *string `json:"sasUrlSettingName,omitempty"`
+}
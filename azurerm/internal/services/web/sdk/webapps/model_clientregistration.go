@@ -0,0 +1,6 @@
+package webapps
+
+type ClientRegistration struct {
+	ClientId                *string `json:"clientId,omitempty"`
+	ClientSecretSettingName *string `json:"clientSecretSettingName,omitempty"`
+}
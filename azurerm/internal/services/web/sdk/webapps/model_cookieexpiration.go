@@ -0,0 +1,6 @@
+package webapps
+
+type CookieExpiration struct {
+	Convention       *CookieExpirationConvention `json:"convention,omitempty"`
+	TimeToExpiration *string                     `json:"timeToExpiration,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapps
+
+type CustomOpenIdConnectProvider struct {
+	Enabled      *bool                      `json:"enabled,omitempty"`
+	Login        *OpenIdConnectLogin        `json:"login,omitempty"`
+	Registration *OpenIdConnectRegistration `json:"registration,omitempty"`
+}
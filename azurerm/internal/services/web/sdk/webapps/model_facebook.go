@@ -0,0 +1,8 @@
+package webapps
+
+type Facebook struct {
+	Enabled         *bool            `json:"enabled,omitempty"`
+	GraphApiVersion *string          `json:"graphApiVersion,omitempty"`
+	Login           *LoginScopes     `json:"login,omitempty"`
+	Registration    *AppRegistration `json:"registration,omitempty"`
+}
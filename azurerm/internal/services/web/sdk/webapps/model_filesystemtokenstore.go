@@ -0,0 +1,5 @@
+package webapps
+
+type FileSystemTokenStore struct {
+	Directory *string `json:"directory,omitempty"`
+}
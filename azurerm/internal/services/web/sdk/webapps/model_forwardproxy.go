@@ -0,0 +1,7 @@
+package webapps
+
+type ForwardProxy struct {
+	Convention            *ForwardProxyConvention `json:"convention,omitempty"`
+	CustomHostHeaderName  *string                 `json:"customHostHeaderName,omitempty"`
+	CustomProtoHeaderName *string                 `json:"customProtoHeaderName,omitempty"`
+}
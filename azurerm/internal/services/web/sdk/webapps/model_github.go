@@ -0,0 +1,7 @@
+package webapps
+
+type GitHub struct {
+	Enabled      *bool               `json:"enabled,omitempty"`
+	Login        *LoginScopes        `json:"login,omitempty"`
+	Registration *ClientRegistration `json:"registration,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webapps
+
+type GlobalValidation struct {
+	ExcludedPaths               *[]string                      `json:"excludedPaths,omitempty"`
+	RedirectToProvider          *string                        `json:"redirectToProvider,omitempty"`
+	RequireAuthentication       *bool                          `json:"requireAuthentication,omitempty"`
+	UnauthenticatedClientAction *UnauthenticatedClientActionV2 `json:"unauthenticatedClientAction,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapps
+
+type HttpSettings struct {
+	ForwardProxy *ForwardProxy       `json:"forwardProxy,omitempty"`
+	RequireHttps *bool               `json:"requireHttps,omitempty"`
+	Routes       *HttpSettingsRoutes `json:"routes,omitempty"`
+}
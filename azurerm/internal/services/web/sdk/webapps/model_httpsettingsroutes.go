@@ -0,0 +1,5 @@
+package webapps
+
+type HttpSettingsRoutes struct {
+	ApiPrefix *string `json:"apiPrefix,omitempty"`
+}
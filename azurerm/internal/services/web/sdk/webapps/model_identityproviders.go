@@ -0,0 +1,11 @@
+package webapps
+
+type IdentityProviders struct {
+	AzureActiveDirectory         *AzureActiveDirectory                   `json:"azureActiveDirectory,omitempty"`
+	CustomOpenIdConnectProviders *map[string]CustomOpenIdConnectProvider `json:"customOpenIdConnectProviders,omitempty"`
+	Facebook                     *Facebook                               `json:"facebook,omitempty"`
+	GitHub                       *GitHub                                 `json:"gitHub,omitempty"`
+	Google                       *Google                                 `json:"google,omitempty"`
+	LegacyMicrosoftAccount       *LegacyMicrosoftAccount                 `json:"legacyMicrosoftAccount,omitempty"`
+	Twitter                      *Twitter                                `json:"twitter,omitempty"`
+}
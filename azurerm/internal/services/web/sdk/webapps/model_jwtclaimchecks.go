@@ -0,0 +1,6 @@
+package webapps
+
+type JwtClaimChecks struct {
+	AllowedClientApplications *[]string `json:"allowedClientApplications,omitempty"`
+	AllowedGroups             *[]string `json:"allowedGroups,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webapps
+
+type LegacyMicrosoftAccount struct {
+	Enabled      *bool                       `json:"enabled,omitempty"`
+	Login        *LoginScopes                `json:"login,omitempty"`
+	Registration *ClientRegistration         `json:"registration,omitempty"`
+	Validation   *AllowedAudiencesValidation `json:"validation,omitempty"`
+}
@@ -0,0 +1,10 @@
+package webapps
+
+type Login struct {
+	AllowedExternalRedirectUrls   *[]string         `json:"allowedExternalRedirectUrls,omitempty"`
+	CookieExpiration              *CookieExpiration `json:"cookieExpiration,omitempty"`
+	Nonce                         *Nonce            `json:"nonce,omitempty"`
+	PreserveUrlFragmentsForLogins *bool             `json:"preserveUrlFragmentsForLogins,omitempty"`
+	Routes                        *LoginRoutes      `json:"routes,omitempty"`
+	TokenStore                    *TokenStore       `json:"tokenStore,omitempty"`
+}
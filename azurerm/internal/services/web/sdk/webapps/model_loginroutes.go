@@ -0,0 +1,5 @@
+package webapps
+
+type LoginRoutes struct {
+	LogoutEndpoint *string `json:"logoutEndpoint,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webapps
+
+type LoginScopes struct {
+	Scopes *[]string `json:"scopes,omitempty"`
+}
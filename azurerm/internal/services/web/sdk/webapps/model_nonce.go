@@ -0,0 +1,6 @@
+package webapps
+
+type Nonce struct {
+	NonceExpirationInterval *string `json:"nonceExpirationInterval,omitempty"`
+	ValidateNonce           *bool   `json:"validateNonce,omitempty"`
+}
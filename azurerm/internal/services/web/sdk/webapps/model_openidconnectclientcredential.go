@@ -0,0 +1,6 @@
+package webapps
+
+type OpenIdConnectClientCredential struct {
+	ClientSecretSettingName *string                 `json:"clientSecretSettingName,omitempty"`
+	Method                  *ClientCredentialMethod `json:"method,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapps
+
+type OpenIdConnectConfig struct {
+	AuthorizationEndpoint        *string `json:"authorizationEndpoint,omitempty"`
+	CertificationUri             *string `json:"certificationUri,omitempty"`
+	Issuer                       *string `json:"issuer,omitempty"`
+	TokenEndpoint                *string `json:"tokenEndpoint,omitempty"`
+	WellKnownOpenIdConfiguration *string `json:"wellKnownOpenIdConfiguration,omitempty"`
+}
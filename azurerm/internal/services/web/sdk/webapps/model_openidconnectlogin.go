@@ -0,0 +1,6 @@
+package webapps
+
+type OpenIdConnectLogin struct {
+	NameClaimType *string   `json:"nameClaimType,omitempty"`
+	Scopes        *[]string `json:"scopes,omitempty"`
+}
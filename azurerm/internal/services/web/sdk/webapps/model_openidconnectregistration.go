@@ -0,0 +1,7 @@
+package webapps
+
+type OpenIdConnectRegistration struct {
+	ClientCredential           *OpenIdConnectClientCredential `json:"clientCredential,omitempty"`
+	ClientId                   *string                        `json:"clientId,omitempty"`
+	OpenIdConnectConfiguration *OpenIdConnectConfig           `json:"openIdConnectConfiguration,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapps
+
+type SiteAuthSettingsV2 struct {
+	Id         *string                       `json:"id,omitempty"`
+	Kind       *string                       `json:"kind,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *SiteAuthSettingsV2Properties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapps
+
+type SiteAuthSettingsV2Properties struct {
+	GlobalValidation  *GlobalValidation  `json:"globalValidation,omitempty"`
+	HttpSettings      *HttpSettings      `json:"httpSettings,omitempty"`
+	IdentityProviders *IdentityProviders `json:"identityProviders,omitempty"`
+	Login             *Login             `json:"login,omitempty"`
+	Platform          *AuthPlatform      `json:"platform,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webapps
+
+type TokenStore struct {
+	AzureBlobStorage           *BlobStorageTokenStore `json:"azureBlobStorage,omitempty"`
+	Enabled                    *bool                  `json:"enabled,omitempty"`
+	FileSystem                 *FileSystemTokenStore  `json:"fileSystem,omitempty"`
+	TokenRefreshExtensionHours *float64               `json:"tokenRefreshExtensionHours,omitempty"`
+}
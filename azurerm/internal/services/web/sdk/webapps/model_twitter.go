@@ -0,0 +1,6 @@
+package webapps
+
+type Twitter struct {
+	Enabled      *bool                `json:"enabled,omitempty"`
+	Registration *TwitterRegistration `json:"registration,omitempty"`
+}
@@ -0,0 +1,6 @@
+package webapps
+
+type TwitterRegistration struct {
+	ConsumerKey               *string `json:"consumerKey,omitempty"`
+	ConsumerSecretSettingName *string `json:"consumerSecretSettingName,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapps
+
+import "fmt"
+
+const defaultApiVersion = "2021-02-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/webapps/%s", defaultApiVersion)
+}
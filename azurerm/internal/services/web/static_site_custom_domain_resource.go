@@ -0,0 +1,248 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/hashicorp/go-azure-helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	staticSiteCustomDomainValidationTypeCname = "cname-delegation"
+	staticSiteCustomDomainValidationTypeTxt   = "dns-txt-token"
+)
+
+func resourceStaticSiteCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStaticSiteCustomDomainCreate,
+		Read:   resourceStaticSiteCustomDomainRead,
+		Delete: resourceStaticSiteCustomDomainDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.StaticSiteCustomDomainID(id)
+			return err
+		}, resourceStaticSiteCustomDomainImport),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"static_site_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StaticSiteID,
+			},
+
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"validation_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					staticSiteCustomDomainValidationTypeCname,
+					staticSiteCustomDomainValidationTypeTxt,
+				}, false),
+			},
+
+			"validation_token": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceStaticSiteCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	staticSiteId, err := parse.StaticSiteID(d.Get("static_site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewStaticSiteCustomDomainID(staticSiteId.SubscriptionId, staticSiteId.ResourceGroup, staticSiteId.Name, d.Get("domain_name").(string))
+
+	existing, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_static_site_custom_domain", id.ID())
+	}
+
+	validationType := d.Get("validation_type").(string)
+	envelope := web.StaticSiteCustomDomainRequestPropertiesARMResource{
+		StaticSiteCustomDomainRequestPropertiesARMResourceProperties: &web.StaticSiteCustomDomainRequestPropertiesARMResourceProperties{
+			ValidationMethod: utils.String(validationType),
+		},
+	}
+
+	future, err := client.CreateOrUpdateStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName, envelope)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	// when validating using a TXT record the operation only completes once the record has been created, which
+	// requires the validation token - so we instead wait for the token to become available
+	if validationType == staticSiteCustomDomainValidationTypeTxt {
+		if err := waitForStaticSiteCustomDomainValidationToken(ctx, client, id); err != nil {
+			return err
+		}
+	} else {
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStaticSiteCustomDomainRead(d, meta)
+}
+
+func resourceStaticSiteCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("static_site_id", parse.NewStaticSiteID(id.SubscriptionId, id.ResourceGroup, id.StaticSiteName).ID())
+	d.Set("domain_name", id.CustomDomainName)
+
+	// the validation token is only returned whilst the domain is being validated, so is cleared once it's been validated
+	validationToken := ""
+	if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil && props.ValidationToken != nil {
+		validationToken = *props.ValidationToken
+	}
+	d.Set("validation_token", validationToken)
+
+	return nil
+}
+
+// the API doesn't return the validation method once the custom domain has been created, as such when importing
+// this is inferred from the validation token - which is only available for TXT validation whilst it's outstanding
+func resourceStaticSiteCustomDomainImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	validationType := staticSiteCustomDomainValidationTypeCname
+	if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil && props.ValidationToken != nil && *props.ValidationToken != "" {
+		validationType = staticSiteCustomDomainValidationTypeTxt
+	}
+	d.Set("validation_type", validationType)
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func resourceStaticSiteCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.StaticSitesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StaticSiteCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func waitForStaticSiteCustomDomainValidationToken(ctx context.Context, client *web.StaticSitesClient, id parse.StaticSiteCustomDomainId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Available"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetStaticSiteCustomDomain(ctx, id.ResourceGroup, id.StaticSiteName, id.CustomDomainName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "Pending", nil
+				}
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if props := resp.StaticSiteCustomDomainOverviewARMResourceProperties; props != nil {
+				if props.Status == web.CustomDomainStatusFailed {
+					return nil, "", fmt.Errorf("validation of %s failed: %s", id, utils.NormalizeNilableString(props.ErrorMessage))
+				}
+				if props.ValidationToken != nil && *props.ValidationToken != "" {
+					return resp, "Available", nil
+				}
+			}
+
+			return resp, "Pending", nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Validation Token for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -54,14 +54,22 @@ func resourceStaticSite() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Default:      "Free",
-				ValidateFunc: validation.StringInSlice([]string{"Free"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"Free", "Standard"}, false),
 			},
 
 			"sku_size": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Default:      "Free",
-				ValidateFunc: validation.StringInSlice([]string{"Free"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"Free", "Standard"}, false),
+			},
+
+			"app_settings": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
 			},
 
 			"default_host_name": {
@@ -120,6 +128,15 @@ func resourceStaticSiteCreateOrUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(id.ID())
 
+	if d.HasChange("app_settings") {
+		appSettings := web.StringDictionary{
+			Properties: expandAppServiceAppSettings(d),
+		}
+		if _, err := client.CreateOrUpdateStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name, appSettings); err != nil {
+			return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+		}
+	}
+
 	return resourceStaticSiteRead(d, meta)
 }
 
@@ -180,6 +197,15 @@ func resourceStaticSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("api_key", apiKey)
 
+	appSettingsResp, err := client.ListStaticSiteAppSettings(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing App Settings for %s: %+v", id, err)
+	}
+
+	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+		return fmt.Errorf("setting `app_settings`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
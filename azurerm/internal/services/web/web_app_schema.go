@@ -0,0 +1,1898 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
+	msiValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type webAppIdentity = identity.SystemAssignedUserAssigned
+
+type SiteConfigLinux struct {
+	AlwaysOn                bool                    `tfschema:"always_on"`
+	ApiDefinition           string                  `tfschema:"api_definition_url"`
+	ApiManagementConfigId   string                  `tfschema:"api_management_api_id"`
+	AppCommandLine          string                  `tfschema:"app_command_line"`
+	ApplicationStack        []ApplicationStackLinux `tfschema:"application_stack"`
+	ContainerRegistryMSI    string                  `tfschema:"container_registry_managed_identity_client_id"`
+	ContainerRegistryUseMSI bool                    `tfschema:"container_registry_use_managed_identity"`
+	Cors                    []CorsSetting           `tfschema:"cors"`
+	DefaultDocuments        []string                `tfschema:"default_documents"`
+	DetailedErrorLogging    bool                    `tfschema:"detailed_error_logging_enabled"`
+	FtpsState               string                  `tfschema:"ftps_state"`
+	HealthCheckPath         string                  `tfschema:"health_check_path"`
+	Http2Enabled            bool                    `tfschema:"http2_enabled"`
+	IpRestriction           []IpRestriction         `tfschema:"ip_restriction"`
+	LinuxFxVersion          string                  `tfschema:"linux_fx_version"`
+	LoadBalancing           string                  `tfschema:"load_balancing_mode"`
+	LocalMysql              bool                    `tfschema:"local_mysql_enabled"`
+	ManagedPipelineMode     string                  `tfschema:"managed_pipeline_mode"`
+	MinTlsVersion           string                  `tfschema:"minimum_tls_version"`
+	RemoteDebugging         bool                    `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion  string                  `tfschema:"remote_debugging_version"`
+	ScmIpRestriction        []IpRestriction         `tfschema:"scm_ip_restriction"`
+	ScmMinTlsVersion        string                  `tfschema:"scm_minimum_tls_version"`
+	ScmType                 string                  `tfschema:"scm_type"`
+	ScmUseMainIpRestriction bool                    `tfschema:"scm_use_main_ip_restriction"`
+	Use32BitWorker          bool                    `tfschema:"use_32_bit_worker"`
+	VnetRouteAllEnabled     bool                    `tfschema:"vnet_route_all_enabled"`
+	WebSockets              bool                    `tfschema:"websockets_enabled"`
+	WorkerCount             int                     `tfschema:"worker_count"`
+	AutoSwapSlotName        string                  `tfschema:"auto_swap_slot_name"`
+}
+
+type ApplicationStackLinux struct {
+	NetFrameworkVersion string `tfschema:"dotnet_version"`
+	PhpVersion          string `tfschema:"php_version"`
+	PythonVersion       string `tfschema:"python_version"`
+	NodeVersion         string `tfschema:"node_version"`
+	JavaVersion         string `tfschema:"java_version"`
+	JavaServer          string `tfschema:"java_server"`
+	JavaServerVersion   string `tfschema:"java_server_version"`
+	DockerImageTag      string `tfschema:"docker_image_tag"`
+	DockerImage         string `tfschema:"docker_image"`
+	RubyVersion         string `tfschema:"ruby_version"`
+}
+
+type SiteConfigWindows struct {
+	AlwaysOn                bool                      `tfschema:"always_on"`
+	ApiDefinition           string                    `tfschema:"api_definition_url"`
+	ApiManagementConfigId   string                    `tfschema:"api_management_api_id"`
+	AppCommandLine          string                    `tfschema:"app_command_line"`
+	ApplicationStack        []ApplicationStackWindows `tfschema:"application_stack"`
+	ContainerRegistryMSI    string                    `tfschema:"container_registry_managed_identity_client_id"`
+	ContainerRegistryUseMSI bool                      `tfschema:"container_registry_use_managed_identity"`
+	Cors                    []CorsSetting             `tfschema:"cors"`
+	DefaultDocuments        []string                  `tfschema:"default_documents"`
+	DetailedErrorLogging    bool                      `tfschema:"detailed_error_logging_enabled"`
+	FtpsState               string                    `tfschema:"ftps_state"`
+	HealthCheckPath         string                    `tfschema:"health_check_path"`
+	Http2Enabled            bool                      `tfschema:"http2_enabled"`
+	IpRestriction           []IpRestriction           `tfschema:"ip_restriction"`
+	LoadBalancing           string                    `tfschema:"load_balancing_mode"`
+	LocalMysql              bool                      `tfschema:"local_mysql_enabled"`
+	ManagedPipelineMode     string                    `tfschema:"managed_pipeline_mode"`
+	MinTlsVersion           string                    `tfschema:"minimum_tls_version"`
+	RemoteDebugging         bool                      `tfschema:"remote_debugging_enabled"`
+	RemoteDebuggingVersion  string                    `tfschema:"remote_debugging_version"`
+	ScmIpRestriction        []IpRestriction           `tfschema:"scm_ip_restriction"`
+	ScmMinTlsVersion        string                    `tfschema:"scm_minimum_tls_version"`
+	ScmType                 string                    `tfschema:"scm_type"`
+	ScmUseMainIpRestriction bool                      `tfschema:"scm_use_main_ip_restriction"`
+	Use32BitWorker          bool                      `tfschema:"use_32_bit_worker"`
+	VirtualApplications     []VirtualApplication      `tfschema:"virtual_application"`
+	VnetRouteAllEnabled     bool                      `tfschema:"vnet_route_all_enabled"`
+	WebSockets              bool                      `tfschema:"websockets_enabled"`
+	WindowsFxVersion        string                    `tfschema:"windows_fx_version"`
+	WorkerCount             int                       `tfschema:"worker_count"`
+	AutoSwapSlotName        string                    `tfschema:"auto_swap_slot_name"`
+}
+
+type ApplicationStackWindows struct {
+	NetFrameworkVersion     string `tfschema:"dotnet_version"`
+	PhpVersion              string `tfschema:"php_version"`
+	JavaVersion             string `tfschema:"java_version"`
+	PythonVersion           string `tfschema:"python_version"`
+	NodeVersion             string `tfschema:"node_version"`
+	JavaContainer           string `tfschema:"java_container"`
+	JavaContainerVersion    string `tfschema:"java_container_version"`
+	DockerContainerName     string `tfschema:"docker_container_name"`
+	DockerContainerRegistry string `tfschema:"docker_container_registry"`
+	DockerContainerTag      string `tfschema:"docker_container_tag"`
+	CurrentStack            string `tfschema:"current_stack"`
+}
+
+type VirtualApplication struct {
+	VirtualPath        string             `tfschema:"virtual_path"`
+	PhysicalPath       string             `tfschema:"physical_path"`
+	Preload            bool               `tfschema:"preload"`
+	VirtualDirectories []VirtualDirectory `tfschema:"virtual_directory"`
+}
+
+type VirtualDirectory struct {
+	VirtualPath  string `tfschema:"virtual_path"`
+	PhysicalPath string `tfschema:"physical_path"`
+}
+
+type CorsSetting struct {
+	AllowedOrigins     []string `tfschema:"allowed_origins"`
+	SupportCredentials bool     `tfschema:"support_credentials"`
+}
+
+type IpRestriction struct {
+	Action       string                 `tfschema:"action"`
+	Headers      []IpRestrictionHeaders `tfschema:"headers"`
+	IpAddress    string                 `tfschema:"ip_address"`
+	Name         string                 `tfschema:"name"`
+	Priority     int                    `tfschema:"priority"`
+	ServiceTag   string                 `tfschema:"service_tag"`
+	VnetSubnetId string                 `tfschema:"virtual_network_subnet_id"`
+}
+
+type IpRestrictionHeaders struct {
+	XForwardedHost []string `tfschema:"x_forwarded_host"`
+	XForwardedFor  []string `tfschema:"x_forwarded_for"`
+	XAzureFDID     []string `tfschema:"x_azure_fdid"`
+	XFDHealthProbe []string `tfschema:"x_fd_health_probe"`
+}
+
+type ConnectionString struct {
+	Name  string `tfschema:"name"`
+	Type  string `tfschema:"type"`
+	Value string `tfschema:"value"`
+}
+
+type StorageAccount struct {
+	AccessKey   string `tfschema:"access_key"`
+	AccountName string `tfschema:"account_name"`
+	Name        string `tfschema:"name"`
+	ShareName   string `tfschema:"share_name"`
+	Type        string `tfschema:"type"`
+	MountPath   string `tfschema:"mount_path"`
+}
+
+type SiteCredential struct {
+	Username string `tfschema:"name"`
+	Password string `tfschema:"password"`
+}
+
+var linuxApplicationStackConstraint = []string{
+	"site_config.0.application_stack.0.dotnet_version",
+	"site_config.0.application_stack.0.php_version",
+	"site_config.0.application_stack.0.python_version",
+	"site_config.0.application_stack.0.node_version",
+	"site_config.0.application_stack.0.java_version",
+	"site_config.0.application_stack.0.docker_image",
+	"site_config.0.application_stack.0.ruby_version",
+}
+
+func siteConfigSchemaLinux() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"always_on": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"api_definition_url": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"api_management_api_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"app_command_line": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"application_stack": linuxApplicationStackSchema(),
+
+				"auto_swap_slot_name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"container_registry_use_managed_identity": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"container_registry_managed_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"cors": corsSettingsSchema(),
+
+				"default_documents": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"detailed_error_logging_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ftps_state": ftpsStateSchema(),
+
+				"health_check_path": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"http2_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"ip_restriction": ipRestrictionSchema(),
+
+				"linux_fx_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"load_balancing_mode": loadBalancingModeSchema(),
+
+				"local_mysql_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"managed_pipeline_mode": managedPipelineModeSchema(),
+
+				"minimum_tls_version": tlsVersionSchema(),
+
+				"remote_debugging_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"remote_debugging_version": remoteDebuggingVersionSchema(),
+
+				"scm_ip_restriction": ipRestrictionSchema(),
+
+				"scm_minimum_tls_version": tlsVersionSchema(),
+
+				"scm_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"scm_use_main_ip_restriction": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"use_32_bit_worker": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"vnet_route_all_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"websockets_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"worker_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func linuxApplicationStackSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.1",
+						"5.0",
+						"6.0",
+					}, false),
+					ExactlyOneOf: linuxApplicationStackConstraint,
+				},
+
+				"php_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"7.4",
+						"8.0",
+					}, false),
+					ExactlyOneOf: linuxApplicationStackConstraint,
+				},
+
+				"python_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"3.7",
+						"3.8",
+						"3.9",
+					}, false),
+					ExactlyOneOf: linuxApplicationStackConstraint,
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"12-lts",
+						"14-lts",
+						"16-lts",
+					}, false),
+					ExactlyOneOf: linuxApplicationStackConstraint,
+				},
+
+				"ruby_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.6",
+						"2.7",
+					}, false),
+					ExactlyOneOf: linuxApplicationStackConstraint,
+				},
+
+				"java_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ExactlyOneOf: linuxApplicationStackConstraint,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.java_server",
+						"site_config.0.application_stack.0.java_server_version",
+					},
+				},
+
+				"java_server": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"JAVA",
+						"TOMCAT",
+						"JBOSSEAP",
+					}, false),
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.java_version",
+					},
+				},
+
+				"java_server_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.java_version",
+					},
+				},
+
+				"docker_image": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					ExactlyOneOf: linuxApplicationStackConstraint,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.docker_image_tag",
+					},
+				},
+
+				"docker_image_tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.docker_image",
+					},
+				},
+			},
+		},
+	}
+}
+
+var windowsApplicationStackConstraint = []string{
+	"site_config.0.application_stack.0.dotnet_version",
+	"site_config.0.application_stack.0.php_version",
+	"site_config.0.application_stack.0.python_version",
+	"site_config.0.application_stack.0.java_version",
+	"site_config.0.application_stack.0.node_version",
+	"site_config.0.application_stack.0.docker_container_name",
+}
+
+func siteConfigSchemaWindows() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"always_on": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"api_definition_url": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				},
+
+				"api_management_api_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"app_command_line": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"application_stack": windowsApplicationStackSchema(),
+
+				"auto_swap_slot_name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"container_registry_use_managed_identity": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"container_registry_managed_identity_client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"cors": corsSettingsSchema(),
+
+				"default_documents": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+
+				"detailed_error_logging_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Computed: true,
+				},
+
+				"ftps_state": ftpsStateSchema(),
+
+				"health_check_path": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+
+				"http2_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"ip_restriction": ipRestrictionSchema(),
+
+				"load_balancing_mode": loadBalancingModeSchema(),
+
+				"local_mysql_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"managed_pipeline_mode": managedPipelineModeSchema(),
+
+				"minimum_tls_version": tlsVersionSchema(),
+
+				"remote_debugging_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"remote_debugging_version": remoteDebuggingVersionSchema(),
+
+				"scm_ip_restriction": ipRestrictionSchema(),
+
+				"scm_minimum_tls_version": tlsVersionSchema(),
+
+				"scm_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"scm_use_main_ip_restriction": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"use_32_bit_worker": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"virtual_application": virtualApplicationsSchema(),
+
+				"vnet_route_all_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"websockets_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"windows_fx_version": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"worker_count": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func windowsApplicationStackSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"dotnet_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"v2.0",
+						"v3.0",
+						"v4.0",
+						"v5.0",
+						"v6.0",
+					}, false),
+					AtLeastOneOf: windowsApplicationStackConstraint,
+				},
+
+				"php_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Off",
+						"7.3",
+						"7.4",
+					}, false),
+					AtLeastOneOf: windowsApplicationStackConstraint,
+				},
+
+				"python_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.7",
+						"3.4.0",
+					}, false),
+					AtLeastOneOf: windowsApplicationStackConstraint,
+				},
+
+				"node_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"~12",
+						"~14",
+						"~16",
+					}, false),
+					AtLeastOneOf: windowsApplicationStackConstraint,
+				},
+
+				"java_version": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.7",
+						"1.8",
+						"11",
+					}, false),
+					AtLeastOneOf: windowsApplicationStackConstraint,
+				},
+
+				"java_container": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"JAVA",
+						"JETTY",
+						"TOMCAT",
+					}, false),
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.java_container_version",
+					},
+				},
+
+				"java_container_version": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.java_container",
+					},
+				},
+
+				"docker_container_name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					AtLeastOneOf: windowsApplicationStackConstraint,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.docker_container_tag",
+					},
+				},
+
+				"docker_container_registry": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.docker_container_name",
+					},
+				},
+
+				"docker_container_tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					RequiredWith: []string{
+						"site_config.0.application_stack.0.docker_container_name",
+					},
+				},
+
+				"current_stack": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"dotnet",
+						"dotnetcore",
+						"node",
+						"python",
+						"php",
+						"java",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func virtualApplicationsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"virtual_path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"physical_path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"preload": {
+					Type:     pluginsdk.TypeBool,
+					Required: true,
+				},
+
+				"virtual_directory": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"virtual_path": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"physical_path": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsSettingsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"allowed_origins": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"support_credentials": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func ipRestrictionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"ip_address": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"service_tag": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"virtual_network_subnet_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: networkValidate.SubnetID,
+				},
+
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"priority": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      65000,
+					ValidateFunc: validation.IntBetween(1, 2147483647),
+				},
+
+				"action": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Default:  "Allow",
+					ValidateFunc: validation.StringInSlice([]string{
+						"Allow",
+						"Deny",
+					}, false),
+				},
+
+				"headers": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"x_forwarded_host": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 8,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+								},
+							},
+
+							"x_forwarded_for": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 8,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.IsCIDR,
+								},
+							},
+
+							"x_azure_fdid": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 8,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.IsUUID,
+								},
+							},
+
+							"x_fd_health_probe": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										"1",
+									}, false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ftpsStateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(web.FtpsStateDisabled),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.FtpsStateAllAllowed),
+			string(web.FtpsStateDisabled),
+			string(web.FtpsStateFtpsOnly),
+		}, false),
+	}
+}
+
+func loadBalancingModeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(web.SiteLoadBalancingLeastRequests),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.SiteLoadBalancingLeastRequests),
+			string(web.SiteLoadBalancingWeightedRoundRobin),
+			string(web.SiteLoadBalancingLeastResponseTime),
+			string(web.SiteLoadBalancingWeightedTotalTraffic),
+			string(web.SiteLoadBalancingRequestHash),
+			string(web.SiteLoadBalancingPerSiteRoundRobin),
+		}, false),
+	}
+}
+
+func managedPipelineModeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(web.ManagedPipelineModeIntegrated),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.ManagedPipelineModeClassic),
+			string(web.ManagedPipelineModeIntegrated),
+		}, false),
+	}
+}
+
+func tlsVersionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(web.SupportedTLSVersionsOneFullStopTwo),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.SupportedTLSVersionsOneFullStopZero),
+			string(web.SupportedTLSVersionsOneFullStopOne),
+			string(web.SupportedTLSVersionsOneFullStopTwo),
+		}, false),
+	}
+}
+
+func remoteDebuggingVersionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"VS2017",
+			"VS2019",
+		}, false),
+	}
+}
+
+func connectionStringSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.ConnectionStringTypeAPIHub),
+						string(web.ConnectionStringTypeCustom),
+						string(web.ConnectionStringTypeDocDb),
+						string(web.ConnectionStringTypeEventHub),
+						string(web.ConnectionStringTypeMySQL),
+						string(web.ConnectionStringTypeNotificationHub),
+						string(web.ConnectionStringTypePostgreSQL),
+						string(web.ConnectionStringTypeRedisCache),
+						string(web.ConnectionStringTypeServiceBus),
+						string(web.ConnectionStringTypeSQLAzure),
+						string(web.ConnectionStringTypeSQLServer),
+					}, false),
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func storageAccountSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"access_key": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"account_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"share_name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureStorageTypeAzureBlob),
+						string(web.AzureStorageTypeAzureFiles),
+					}, false),
+				},
+
+				"mount_path": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func siteCredentialSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Computed:  true,
+		Sensitive: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"password": {
+					Type:      pluginsdk.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func keyVaultReferenceIdentityIdSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: msiValidate.UserAssignedIdentityID,
+	}
+}
+
+func clientCertificateModeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(web.ClientCertModeRequired),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(web.ClientCertModeOptional),
+			string(web.ClientCertModeRequired),
+		}, false),
+	}
+}
+
+func expandSiteConfigLinux(siteConfig []SiteConfigLinux) (*web.SiteConfig, error) {
+	if len(siteConfig) == 0 {
+		return nil, nil
+	}
+
+	linuxSiteConfig := siteConfig[0]
+	expanded := &web.SiteConfig{
+		AlwaysOn:                         utils.Bool(linuxSiteConfig.AlwaysOn),
+		AcrUseManagedIdentityCreds:       utils.Bool(linuxSiteConfig.ContainerRegistryUseMSI),
+		AppCommandLine:                   utils.String(linuxSiteConfig.AppCommandLine),
+		FtpsState:                        web.FtpsState(linuxSiteConfig.FtpsState),
+		HTTP20Enabled:                    utils.Bool(linuxSiteConfig.Http2Enabled),
+		LoadBalancing:                    web.SiteLoadBalancing(linuxSiteConfig.LoadBalancing),
+		LocalMySQLEnabled:                utils.Bool(linuxSiteConfig.LocalMysql),
+		ManagedPipelineMode:              web.ManagedPipelineMode(linuxSiteConfig.ManagedPipelineMode),
+		MinTLSVersion:                    web.SupportedTLSVersions(linuxSiteConfig.MinTlsVersion),
+		RemoteDebuggingEnabled:           utils.Bool(linuxSiteConfig.RemoteDebugging),
+		ScmIPSecurityRestrictionsUseMain: utils.Bool(linuxSiteConfig.ScmUseMainIpRestriction),
+		ScmMinTLSVersion:                 web.SupportedTLSVersions(linuxSiteConfig.ScmMinTlsVersion),
+		Use32BitWorkerProcess:            utils.Bool(linuxSiteConfig.Use32BitWorker),
+		VnetRouteAllEnabled:              utils.Bool(linuxSiteConfig.VnetRouteAllEnabled),
+		WebSocketsEnabled:                utils.Bool(linuxSiteConfig.WebSockets),
+	}
+
+	if linuxSiteConfig.ApiDefinition != "" {
+		expanded.APIDefinition = &web.APIDefinitionInfo{
+			URL: utils.String(linuxSiteConfig.ApiDefinition),
+		}
+	}
+
+	if linuxSiteConfig.ApiManagementConfigId != "" {
+		expanded.APIManagementConfig = &web.APIManagementConfig{
+			ID: utils.String(linuxSiteConfig.ApiManagementConfigId),
+		}
+	}
+
+	if len(linuxSiteConfig.ApplicationStack) == 1 {
+		linuxAppStack := linuxSiteConfig.ApplicationStack[0]
+		switch {
+		case linuxAppStack.NetFrameworkVersion != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("DOTNETCORE|%s", linuxAppStack.NetFrameworkVersion))
+		case linuxAppStack.PhpVersion != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("PHP|%s", linuxAppStack.PhpVersion))
+		case linuxAppStack.NodeVersion != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("NODE|%s", linuxAppStack.NodeVersion))
+		case linuxAppStack.PythonVersion != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("PYTHON|%s", linuxAppStack.PythonVersion))
+		case linuxAppStack.RubyVersion != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("RUBY|%s", linuxAppStack.RubyVersion))
+		case linuxAppStack.JavaServer != "":
+			javaServer := linuxAppStack.JavaServer
+			if javaServer != "JAVA" && javaServer != "TOMCAT" && javaServer != "JBOSSEAP" {
+				return nil, fmt.Errorf("`java_server` must be one of `JAVA`, `TOMCAT` or `JBOSSEAP`")
+			}
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("%s|%s-java%s", javaServer, linuxAppStack.JavaServerVersion, strings.TrimPrefix(linuxAppStack.JavaVersion, "1.")))
+		case linuxAppStack.DockerImage != "":
+			expanded.LinuxFxVersion = utils.String(fmt.Sprintf("DOCKER|%s:%s", linuxAppStack.DockerImage, linuxAppStack.DockerImageTag))
+		}
+	}
+
+	if linuxSiteConfig.ContainerRegistryMSI != "" {
+		if !linuxSiteConfig.ContainerRegistryUseMSI {
+			return nil, fmt.Errorf("`container_registry_managed_identity_client_id` can only be set when `container_registry_use_managed_identity` is `true`")
+		}
+		expanded.AcrUserManagedIdentityID = utils.String(linuxSiteConfig.ContainerRegistryMSI)
+	}
+
+	if len(linuxSiteConfig.DefaultDocuments) != 0 {
+		expanded.DefaultDocuments = &linuxSiteConfig.DefaultDocuments
+	}
+
+	if linuxSiteConfig.HealthCheckPath != "" {
+		expanded.HealthCheckPath = utils.String(linuxSiteConfig.HealthCheckPath)
+	}
+
+	if linuxSiteConfig.RemoteDebuggingVersion != "" {
+		expanded.RemoteDebuggingVersion = utils.String(linuxSiteConfig.RemoteDebuggingVersion)
+	}
+
+	if linuxSiteConfig.WorkerCount != 0 {
+		expanded.NumberOfWorkers = utils.Int32(int32(linuxSiteConfig.WorkerCount))
+	}
+
+	ipRestrictions, err := expandIpRestrictions(linuxSiteConfig.IpRestriction)
+	if err != nil {
+		return nil, err
+	}
+	expanded.IPSecurityRestrictions = ipRestrictions
+
+	scmIpRestrictions, err := expandIpRestrictions(linuxSiteConfig.ScmIpRestriction)
+	if err != nil {
+		return nil, err
+	}
+	expanded.ScmIPSecurityRestrictions = scmIpRestrictions
+
+	expanded.Cors = expandCorsSettings(linuxSiteConfig.Cors)
+
+	return expanded, nil
+}
+
+func flattenSiteConfigLinux(appSiteConfig *web.SiteConfig) []SiteConfigLinux {
+	if appSiteConfig == nil {
+		return []SiteConfigLinux{}
+	}
+
+	siteConfig := SiteConfigLinux{
+		AlwaysOn:                utils.NormaliseNilableBool(appSiteConfig.AlwaysOn),
+		AppCommandLine:          utils.NormalizeNilableString(appSiteConfig.AppCommandLine),
+		AutoSwapSlotName:        utils.NormalizeNilableString(appSiteConfig.AutoSwapSlotName),
+		ContainerRegistryMSI:    utils.NormalizeNilableString(appSiteConfig.AcrUserManagedIdentityID),
+		ContainerRegistryUseMSI: utils.NormaliseNilableBool(appSiteConfig.AcrUseManagedIdentityCreds),
+		Cors:                    flattenCorsSettings(appSiteConfig.Cors),
+		DetailedErrorLogging:    utils.NormaliseNilableBool(appSiteConfig.DetailedErrorLoggingEnabled),
+		FtpsState:               string(appSiteConfig.FtpsState),
+		HealthCheckPath:         utils.NormalizeNilableString(appSiteConfig.HealthCheckPath),
+		Http2Enabled:            utils.NormaliseNilableBool(appSiteConfig.HTTP20Enabled),
+		IpRestriction:           flattenIpRestrictions(appSiteConfig.IPSecurityRestrictions),
+		LinuxFxVersion:          utils.NormalizeNilableString(appSiteConfig.LinuxFxVersion),
+		LoadBalancing:           string(appSiteConfig.LoadBalancing),
+		LocalMysql:              utils.NormaliseNilableBool(appSiteConfig.LocalMySQLEnabled),
+		ManagedPipelineMode:     string(appSiteConfig.ManagedPipelineMode),
+		MinTlsVersion:           string(appSiteConfig.MinTLSVersion),
+		RemoteDebugging:         utils.NormaliseNilableBool(appSiteConfig.RemoteDebuggingEnabled),
+		RemoteDebuggingVersion:  strings.ToUpper(utils.NormalizeNilableString(appSiteConfig.RemoteDebuggingVersion)),
+		ScmIpRestriction:        flattenIpRestrictions(appSiteConfig.ScmIPSecurityRestrictions),
+		ScmMinTlsVersion:        string(appSiteConfig.ScmMinTLSVersion),
+		ScmType:                 string(appSiteConfig.ScmType),
+		ScmUseMainIpRestriction: utils.NormaliseNilableBool(appSiteConfig.ScmIPSecurityRestrictionsUseMain),
+		Use32BitWorker:          utils.NormaliseNilableBool(appSiteConfig.Use32BitWorkerProcess),
+		VnetRouteAllEnabled:     utils.NormaliseNilableBool(appSiteConfig.VnetRouteAllEnabled),
+		WebSockets:              utils.NormaliseNilableBool(appSiteConfig.WebSocketsEnabled),
+	}
+
+	if appSiteConfig.APIDefinition != nil && appSiteConfig.APIDefinition.URL != nil {
+		siteConfig.ApiDefinition = *appSiteConfig.APIDefinition.URL
+	}
+
+	if appSiteConfig.APIManagementConfig != nil && appSiteConfig.APIManagementConfig.ID != nil {
+		siteConfig.ApiManagementConfigId = *appSiteConfig.APIManagementConfig.ID
+	}
+
+	if appSiteConfig.DefaultDocuments != nil {
+		siteConfig.DefaultDocuments = *appSiteConfig.DefaultDocuments
+	}
+
+	if appSiteConfig.NumberOfWorkers != nil {
+		siteConfig.WorkerCount = int(*appSiteConfig.NumberOfWorkers)
+	}
+
+	if stack := flattenLinuxApplicationStack(siteConfig.LinuxFxVersion); stack != nil {
+		siteConfig.ApplicationStack = []ApplicationStackLinux{*stack}
+	}
+
+	return []SiteConfigLinux{siteConfig}
+}
+
+func flattenLinuxApplicationStack(linuxFxVersion string) *ApplicationStackLinux {
+	parts := strings.SplitN(linuxFxVersion, "|", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+
+	stack := ApplicationStackLinux{}
+	switch strings.ToUpper(parts[0]) {
+	case "DOTNETCORE":
+		stack.NetFrameworkVersion = parts[1]
+	case "NODE":
+		stack.NodeVersion = parts[1]
+	case "PYTHON":
+		stack.PythonVersion = parts[1]
+	case "PHP":
+		stack.PhpVersion = parts[1]
+	case "RUBY":
+		stack.RubyVersion = parts[1]
+	case "JAVA", "TOMCAT", "JBOSSEAP":
+		stack.JavaServer = strings.ToUpper(parts[0])
+		javaParts := strings.SplitN(parts[1], "-java", 2)
+		stack.JavaServerVersion = javaParts[0]
+		if len(javaParts) == 2 {
+			stack.JavaVersion = javaParts[1]
+		}
+	case "DOCKER":
+		image := parts[1]
+		if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+			stack.DockerImage = image[:idx]
+			stack.DockerImageTag = image[idx+1:]
+		} else {
+			stack.DockerImage = image
+			stack.DockerImageTag = "latest"
+		}
+	default:
+		return nil
+	}
+
+	return &stack
+}
+
+// expandSiteConfigWindows returns the Site Config along with the `CURRENT_STACK` value, which the Portal
+// stores in the Site Metadata rather than the Site Config
+func expandSiteConfigWindows(siteConfig []SiteConfigWindows) (*web.SiteConfig, string, error) {
+	if len(siteConfig) == 0 {
+		return nil, "", nil
+	}
+
+	winSiteConfig := siteConfig[0]
+	expanded := &web.SiteConfig{
+		AlwaysOn:                         utils.Bool(winSiteConfig.AlwaysOn),
+		AcrUseManagedIdentityCreds:       utils.Bool(winSiteConfig.ContainerRegistryUseMSI),
+		AppCommandLine:                   utils.String(winSiteConfig.AppCommandLine),
+		FtpsState:                        web.FtpsState(winSiteConfig.FtpsState),
+		HTTP20Enabled:                    utils.Bool(winSiteConfig.Http2Enabled),
+		LoadBalancing:                    web.SiteLoadBalancing(winSiteConfig.LoadBalancing),
+		LocalMySQLEnabled:                utils.Bool(winSiteConfig.LocalMysql),
+		ManagedPipelineMode:              web.ManagedPipelineMode(winSiteConfig.ManagedPipelineMode),
+		MinTLSVersion:                    web.SupportedTLSVersions(winSiteConfig.MinTlsVersion),
+		RemoteDebuggingEnabled:           utils.Bool(winSiteConfig.RemoteDebugging),
+		ScmIPSecurityRestrictionsUseMain: utils.Bool(winSiteConfig.ScmUseMainIpRestriction),
+		ScmMinTLSVersion:                 web.SupportedTLSVersions(winSiteConfig.ScmMinTlsVersion),
+		Use32BitWorkerProcess:            utils.Bool(winSiteConfig.Use32BitWorker),
+		VirtualApplications:              expandVirtualApplications(winSiteConfig.VirtualApplications),
+		VnetRouteAllEnabled:              utils.Bool(winSiteConfig.VnetRouteAllEnabled),
+		WebSocketsEnabled:                utils.Bool(winSiteConfig.WebSockets),
+	}
+
+	if winSiteConfig.ApiDefinition != "" {
+		expanded.APIDefinition = &web.APIDefinitionInfo{
+			URL: utils.String(winSiteConfig.ApiDefinition),
+		}
+	}
+
+	if winSiteConfig.ApiManagementConfigId != "" {
+		expanded.APIManagementConfig = &web.APIManagementConfig{
+			ID: utils.String(winSiteConfig.ApiManagementConfigId),
+		}
+	}
+
+	currentStack := ""
+	if len(winSiteConfig.ApplicationStack) == 1 {
+		winAppStack := winSiteConfig.ApplicationStack[0]
+		if winAppStack.NetFrameworkVersion != "" {
+			expanded.NetFrameworkVersion = utils.String(winAppStack.NetFrameworkVersion)
+		}
+		if winAppStack.PhpVersion != "" {
+			expanded.PhpVersion = utils.String(winAppStack.PhpVersion)
+		}
+		if winAppStack.PythonVersion != "" {
+			expanded.PythonVersion = utils.String(winAppStack.PythonVersion)
+		}
+		if winAppStack.JavaVersion != "" {
+			expanded.JavaVersion = utils.String(winAppStack.JavaVersion)
+			expanded.JavaContainer = utils.String(winAppStack.JavaContainer)
+			expanded.JavaContainerVersion = utils.String(winAppStack.JavaContainerVersion)
+		}
+		if winAppStack.DockerContainerName != "" {
+			image := winAppStack.DockerContainerName
+			if winAppStack.DockerContainerRegistry != "" {
+				image = fmt.Sprintf("%s/%s", winAppStack.DockerContainerRegistry, winAppStack.DockerContainerName)
+			}
+			expanded.WindowsFxVersion = utils.String(fmt.Sprintf("DOCKER|%s:%s", image, winAppStack.DockerContainerTag))
+		}
+		currentStack = winAppStack.CurrentStack
+	}
+
+	if winSiteConfig.ContainerRegistryMSI != "" {
+		if !winSiteConfig.ContainerRegistryUseMSI {
+			return nil, "", fmt.Errorf("`container_registry_managed_identity_client_id` can only be set when `container_registry_use_managed_identity` is `true`")
+		}
+		expanded.AcrUserManagedIdentityID = utils.String(winSiteConfig.ContainerRegistryMSI)
+	}
+
+	if len(winSiteConfig.DefaultDocuments) != 0 {
+		expanded.DefaultDocuments = &winSiteConfig.DefaultDocuments
+	}
+
+	if winSiteConfig.HealthCheckPath != "" {
+		expanded.HealthCheckPath = utils.String(winSiteConfig.HealthCheckPath)
+	}
+
+	if winSiteConfig.RemoteDebuggingVersion != "" {
+		expanded.RemoteDebuggingVersion = utils.String(winSiteConfig.RemoteDebuggingVersion)
+	}
+
+	if winSiteConfig.WorkerCount != 0 {
+		expanded.NumberOfWorkers = utils.Int32(int32(winSiteConfig.WorkerCount))
+	}
+
+	ipRestrictions, err := expandIpRestrictions(winSiteConfig.IpRestriction)
+	if err != nil {
+		return nil, "", err
+	}
+	expanded.IPSecurityRestrictions = ipRestrictions
+
+	scmIpRestrictions, err := expandIpRestrictions(winSiteConfig.ScmIpRestriction)
+	if err != nil {
+		return nil, "", err
+	}
+	expanded.ScmIPSecurityRestrictions = scmIpRestrictions
+
+	expanded.Cors = expandCorsSettings(winSiteConfig.Cors)
+
+	return expanded, currentStack, nil
+}
+
+// flattenSiteConfigWindows flattens the Site Config - the `node_version` isn't part of the Site Config on Windows
+// and is instead exposed via the `WEBSITE_NODE_DEFAULT_VERSION` App Setting, so this is passed in separately
+func flattenSiteConfigWindows(appSiteConfig *web.SiteConfig, currentStack string, nodeVersion string) []SiteConfigWindows {
+	if appSiteConfig == nil {
+		return []SiteConfigWindows{}
+	}
+
+	siteConfig := SiteConfigWindows{
+		AlwaysOn:                utils.NormaliseNilableBool(appSiteConfig.AlwaysOn),
+		AppCommandLine:          utils.NormalizeNilableString(appSiteConfig.AppCommandLine),
+		AutoSwapSlotName:        utils.NormalizeNilableString(appSiteConfig.AutoSwapSlotName),
+		ContainerRegistryMSI:    utils.NormalizeNilableString(appSiteConfig.AcrUserManagedIdentityID),
+		ContainerRegistryUseMSI: utils.NormaliseNilableBool(appSiteConfig.AcrUseManagedIdentityCreds),
+		Cors:                    flattenCorsSettings(appSiteConfig.Cors),
+		DetailedErrorLogging:    utils.NormaliseNilableBool(appSiteConfig.DetailedErrorLoggingEnabled),
+		FtpsState:               string(appSiteConfig.FtpsState),
+		HealthCheckPath:         utils.NormalizeNilableString(appSiteConfig.HealthCheckPath),
+		Http2Enabled:            utils.NormaliseNilableBool(appSiteConfig.HTTP20Enabled),
+		IpRestriction:           flattenIpRestrictions(appSiteConfig.IPSecurityRestrictions),
+		LoadBalancing:           string(appSiteConfig.LoadBalancing),
+		LocalMysql:              utils.NormaliseNilableBool(appSiteConfig.LocalMySQLEnabled),
+		ManagedPipelineMode:     string(appSiteConfig.ManagedPipelineMode),
+		MinTlsVersion:           string(appSiteConfig.MinTLSVersion),
+		RemoteDebugging:         utils.NormaliseNilableBool(appSiteConfig.RemoteDebuggingEnabled),
+		RemoteDebuggingVersion:  strings.ToUpper(utils.NormalizeNilableString(appSiteConfig.RemoteDebuggingVersion)),
+		ScmIpRestriction:        flattenIpRestrictions(appSiteConfig.ScmIPSecurityRestrictions),
+		ScmMinTlsVersion:        string(appSiteConfig.ScmMinTLSVersion),
+		ScmType:                 string(appSiteConfig.ScmType),
+		ScmUseMainIpRestriction: utils.NormaliseNilableBool(appSiteConfig.ScmIPSecurityRestrictionsUseMain),
+		Use32BitWorker:          utils.NormaliseNilableBool(appSiteConfig.Use32BitWorkerProcess),
+		VirtualApplications:     flattenVirtualApplications(appSiteConfig.VirtualApplications),
+		VnetRouteAllEnabled:     utils.NormaliseNilableBool(appSiteConfig.VnetRouteAllEnabled),
+		WebSockets:              utils.NormaliseNilableBool(appSiteConfig.WebSocketsEnabled),
+		WindowsFxVersion:        utils.NormalizeNilableString(appSiteConfig.WindowsFxVersion),
+	}
+
+	if appSiteConfig.APIDefinition != nil && appSiteConfig.APIDefinition.URL != nil {
+		siteConfig.ApiDefinition = *appSiteConfig.APIDefinition.URL
+	}
+
+	if appSiteConfig.APIManagementConfig != nil && appSiteConfig.APIManagementConfig.ID != nil {
+		siteConfig.ApiManagementConfigId = *appSiteConfig.APIManagementConfig.ID
+	}
+
+	if appSiteConfig.DefaultDocuments != nil {
+		siteConfig.DefaultDocuments = *appSiteConfig.DefaultDocuments
+	}
+
+	if appSiteConfig.NumberOfWorkers != nil {
+		siteConfig.WorkerCount = int(*appSiteConfig.NumberOfWorkers)
+	}
+
+	winAppStack := ApplicationStackWindows{
+		NetFrameworkVersion:  utils.NormalizeNilableString(appSiteConfig.NetFrameworkVersion),
+		PhpVersion:           utils.NormalizeNilableString(appSiteConfig.PhpVersion),
+		PythonVersion:        utils.NormalizeNilableString(appSiteConfig.PythonVersion),
+		NodeVersion:          nodeVersion,
+		JavaVersion:          utils.NormalizeNilableString(appSiteConfig.JavaVersion),
+		JavaContainer:        utils.NormalizeNilableString(appSiteConfig.JavaContainer),
+		JavaContainerVersion: utils.NormalizeNilableString(appSiteConfig.JavaContainerVersion),
+		CurrentStack:         currentStack,
+	}
+
+	if parts := strings.SplitN(siteConfig.WindowsFxVersion, "|", 2); len(parts) == 2 && strings.EqualFold(parts[0], "DOCKER") {
+		image := parts[1]
+		if idx := strings.LastIndex(image, ":"); idx > strings.LastIndex(image, "/") {
+			winAppStack.DockerContainerTag = image[idx+1:]
+			image = image[:idx]
+		}
+		if idx := strings.Index(image, "/"); idx > 0 {
+			winAppStack.DockerContainerRegistry = image[:idx]
+			image = image[idx+1:]
+		}
+		winAppStack.DockerContainerName = image
+	}
+
+	siteConfig.ApplicationStack = []ApplicationStackWindows{winAppStack}
+
+	return []SiteConfigWindows{siteConfig}
+}
+
+func expandVirtualApplications(input []VirtualApplication) *[]web.VirtualApplication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]web.VirtualApplication, 0)
+	for _, v := range input {
+		virtualDirectories := make([]web.VirtualDirectory, 0)
+		for _, d := range v.VirtualDirectories {
+			virtualDirectories = append(virtualDirectories, web.VirtualDirectory{
+				VirtualPath:  utils.String(d.VirtualPath),
+				PhysicalPath: utils.String(d.PhysicalPath),
+			})
+		}
+
+		results = append(results, web.VirtualApplication{
+			VirtualPath:        utils.String(v.VirtualPath),
+			PhysicalPath:       utils.String(v.PhysicalPath),
+			PreloadEnabled:     utils.Bool(v.Preload),
+			VirtualDirectories: &virtualDirectories,
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualApplications(input *[]web.VirtualApplication) []VirtualApplication {
+	results := make([]VirtualApplication, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		virtualDirectories := make([]VirtualDirectory, 0)
+		if v.VirtualDirectories != nil {
+			for _, d := range *v.VirtualDirectories {
+				virtualDirectories = append(virtualDirectories, VirtualDirectory{
+					VirtualPath:  utils.NormalizeNilableString(d.VirtualPath),
+					PhysicalPath: utils.NormalizeNilableString(d.PhysicalPath),
+				})
+			}
+		}
+
+		results = append(results, VirtualApplication{
+			VirtualPath:        utils.NormalizeNilableString(v.VirtualPath),
+			PhysicalPath:       utils.NormalizeNilableString(v.PhysicalPath),
+			Preload:            utils.NormaliseNilableBool(v.PreloadEnabled),
+			VirtualDirectories: virtualDirectories,
+		})
+	}
+
+	return results
+}
+
+func expandCorsSettings(input []CorsSetting) *web.CorsSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	allowedOrigins := input[0].AllowedOrigins
+	return &web.CorsSettings{
+		AllowedOrigins:     &allowedOrigins,
+		SupportCredentials: utils.Bool(input[0].SupportCredentials),
+	}
+}
+
+func flattenCorsSettings(input *web.CorsSettings) []CorsSetting {
+	if input == nil {
+		return []CorsSetting{}
+	}
+
+	allowedOrigins := make([]string, 0)
+	if input.AllowedOrigins != nil {
+		allowedOrigins = *input.AllowedOrigins
+	}
+
+	// the API returns an empty CORS block when it's not configured
+	if len(allowedOrigins) == 0 && !utils.NormaliseNilableBool(input.SupportCredentials) {
+		return []CorsSetting{}
+	}
+
+	return []CorsSetting{
+		{
+			AllowedOrigins:     allowedOrigins,
+			SupportCredentials: utils.NormaliseNilableBool(input.SupportCredentials),
+		},
+	}
+}
+
+func expandIpRestrictions(input []IpRestriction) (*[]web.IPSecurityRestriction, error) {
+	restrictions := make([]web.IPSecurityRestriction, 0)
+
+	for _, v := range input {
+		if (v.IpAddress != "" && v.ServiceTag != "") || (v.IpAddress != "" && v.VnetSubnetId != "") || (v.ServiceTag != "" && v.VnetSubnetId != "") {
+			return nil, fmt.Errorf("only one of `ip_address`, `service_tag` or `virtual_network_subnet_id` can be set for an IP restriction")
+		}
+
+		if v.IpAddress == "" && v.ServiceTag == "" && v.VnetSubnetId == "" {
+			return nil, fmt.Errorf("one of `ip_address`, `service_tag` or `virtual_network_subnet_id` must be set for an IP restriction")
+		}
+
+		restriction := web.IPSecurityRestriction{
+			Action:   utils.String(v.Action),
+			Headers:  expandIpRestrictionHeaders(v.Headers),
+			Priority: utils.Int32(int32(v.Priority)),
+		}
+
+		if v.Name != "" {
+			restriction.Name = utils.String(v.Name)
+		}
+
+		if v.IpAddress != "" {
+			restriction.IPAddress = utils.String(v.IpAddress)
+		}
+
+		if v.ServiceTag != "" {
+			restriction.IPAddress = utils.String(v.ServiceTag)
+			restriction.Tag = web.IPFilterTagServiceTag
+		}
+
+		if v.VnetSubnetId != "" {
+			restriction.VnetSubnetResourceID = utils.String(v.VnetSubnetId)
+		}
+
+		restrictions = append(restrictions, restriction)
+	}
+
+	return &restrictions, nil
+}
+
+func flattenIpRestrictions(input *[]web.IPSecurityRestriction) []IpRestriction {
+	results := make([]IpRestriction, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		ipAddress := utils.NormalizeNilableString(v.IPAddress)
+
+		// the API returns an implicit "Allow all" rule when no restrictions are configured
+		if ipAddress == "Any" {
+			continue
+		}
+
+		restriction := IpRestriction{
+			Action:       utils.NormalizeNilableString(v.Action),
+			Headers:      flattenIpRestrictionHeaders(v.Headers),
+			Name:         utils.NormalizeNilableString(v.Name),
+			VnetSubnetId: utils.NormalizeNilableString(v.VnetSubnetResourceID),
+		}
+
+		if v.Priority != nil {
+			restriction.Priority = int(*v.Priority)
+		}
+
+		if v.Tag == web.IPFilterTagServiceTag {
+			restriction.ServiceTag = ipAddress
+		} else {
+			restriction.IpAddress = ipAddress
+		}
+
+		results = append(results, restriction)
+	}
+
+	return results
+}
+
+func expandIpRestrictionHeaders(input []IpRestrictionHeaders) map[string][]string {
+	output := make(map[string][]string)
+	if len(input) == 0 {
+		return output
+	}
+
+	headers := input[0]
+	if len(headers.XForwardedHost) > 0 {
+		output["x-forwarded-host"] = headers.XForwardedHost
+	}
+	if len(headers.XForwardedFor) > 0 {
+		output["x-forwarded-for"] = headers.XForwardedFor
+	}
+	if len(headers.XAzureFDID) > 0 {
+		output["x-azure-fdid"] = headers.XAzureFDID
+	}
+	if len(headers.XFDHealthProbe) > 0 {
+		output["x-fd-healthprobe"] = headers.XFDHealthProbe
+	}
+
+	return output
+}
+
+func flattenIpRestrictionHeaders(input map[string][]string) []IpRestrictionHeaders {
+	if len(input) == 0 {
+		return []IpRestrictionHeaders{}
+	}
+
+	return []IpRestrictionHeaders{
+		{
+			XForwardedHost: input["x-forwarded-host"],
+			XForwardedFor:  input["x-forwarded-for"],
+			XAzureFDID:     input["x-azure-fdid"],
+			XFDHealthProbe: input["x-fd-healthprobe"],
+		},
+	}
+}
+
+func expandAppSettings(input map[string]string) map[string]*string {
+	output := make(map[string]*string)
+	for k, v := range input {
+		output[k] = utils.String(v)
+	}
+
+	return output
+}
+
+func flattenAppSettings(input web.StringDictionary) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input.Properties {
+		output[k] = utils.NormalizeNilableString(v)
+	}
+
+	return output
+}
+
+func expandConnectionStrings(input []ConnectionString) map[string]*web.ConnStringValueTypePair {
+	output := make(map[string]*web.ConnStringValueTypePair)
+	for _, v := range input {
+		output[v.Name] = &web.ConnStringValueTypePair{
+			Value: utils.String(v.Value),
+			Type:  web.ConnectionStringType(v.Type),
+		}
+	}
+
+	return output
+}
+
+func flattenConnectionStrings(input web.ConnectionStringDictionary) []ConnectionString {
+	results := make([]ConnectionString, 0)
+	for k, v := range input.Properties {
+		if v == nil {
+			continue
+		}
+
+		results = append(results, ConnectionString{
+			Name:  k,
+			Type:  string(v.Type),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return results
+}
+
+func expandStorageAccounts(input []StorageAccount) map[string]*web.AzureStorageInfoValue {
+	output := make(map[string]*web.AzureStorageInfoValue)
+	for _, v := range input {
+		value := &web.AzureStorageInfoValue{
+			Type:        web.AzureStorageType(v.Type),
+			AccountName: utils.String(v.AccountName),
+			ShareName:   utils.String(v.ShareName),
+			AccessKey:   utils.String(v.AccessKey),
+		}
+		if v.MountPath != "" {
+			value.MountPath = utils.String(v.MountPath)
+		}
+		output[v.Name] = value
+	}
+
+	return output
+}
+
+// flattenStorageAccounts flattens the Storage Mounts - since the API doesn't return the `access_key` this is
+// taken from the existing configuration, when present
+func flattenStorageAccounts(input web.AzureStoragePropertyDictionaryResource, existing []StorageAccount) []StorageAccount {
+	accessKeys := make(map[string]string)
+	for _, v := range existing {
+		accessKeys[v.Name] = v.AccessKey
+	}
+
+	results := make([]StorageAccount, 0)
+	for k, v := range input.Properties {
+		if v == nil {
+			continue
+		}
+
+		results = append(results, StorageAccount{
+			Name:        k,
+			Type:        string(v.Type),
+			AccountName: utils.NormalizeNilableString(v.AccountName),
+			ShareName:   utils.NormalizeNilableString(v.ShareName),
+			AccessKey:   accessKeys[k],
+			MountPath:   utils.NormalizeNilableString(v.MountPath),
+		})
+	}
+
+	return results
+}
+
+func flattenSiteCredentials(input web.User) []SiteCredential {
+	if input.UserProperties == nil {
+		return []SiteCredential{}
+	}
+
+	return []SiteCredential{
+		{
+			Username: utils.NormalizeNilableString(input.PublishingUserName),
+			Password: utils.NormalizeNilableString(input.PublishingPassword),
+		},
+	}
+}
+
+func expandWebAppIdentity(input []interface{}) (*web.ManagedServiceIdentity, error) {
+	config, err := webAppIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var identityIds map[string]*web.UserAssignedIdentity
+	if config.UserAssignedIdentityIds != nil {
+		identityIds = make(map[string]*web.UserAssignedIdentity)
+		for _, id := range *config.UserAssignedIdentityIds {
+			identityIds[id] = &web.UserAssignedIdentity{}
+		}
+	}
+
+	return &web.ManagedServiceIdentity{
+		Type:                   web.ManagedServiceIdentityType(config.Type),
+		UserAssignedIdentities: identityIds,
+	}, nil
+}
+
+func flattenWebAppIdentity(input *web.ManagedServiceIdentity) []interface{} {
+	var config *identity.ExpandedConfig
+	if input != nil {
+		config = &identity.ExpandedConfig{
+			Type:        identity.Type(input.Type),
+			PrincipalId: input.PrincipalID,
+			TenantId:    input.TenantID,
+		}
+
+		if input.UserAssignedIdentities != nil {
+			ids := make([]string, 0)
+			for id := range input.UserAssignedIdentities {
+				ids = append(ids, id)
+			}
+			config.UserAssignedIdentityIds = &ids
+		}
+	}
+
+	return webAppIdentity{}.Flatten(config)
+}
+
+func splitIpAddresses(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+// checkWebAppNameAvailability confirms that the name of a new Web or Function App is globally unique, taking into account
+// the App Service Environment (if any) which hosts the Service Plan, and returns the Service Plan
+func checkWebAppNameAvailability(ctx context.Context, client *clients.Client, name string, servicePlanId parse.AppServicePlanId) (*web.AppServicePlan, error) {
+	servicePlan, err := client.Web.AppServicePlansClient.Get(ctx, servicePlanId.ResourceGroup, servicePlanId.ServerfarmName)
+	// 404 is incorrectly being considered an acceptable response, issue tracked at https://github.com/Azure/azure-sdk-for-go/issues/15002
+	if err != nil || utils.ResponseWasNotFound(servicePlan.Response) {
+		return nil, fmt.Errorf("reading %s: %+v", servicePlanId, err)
+	}
+
+	availabilityRequest := web.ResourceNameAvailabilityRequest{
+		Name: utils.String(name),
+		Type: web.CheckNameResourceTypesMicrosoftWebsites,
+	}
+	if ase := servicePlan.HostingEnvironmentProfile; ase != nil && ase.Name != nil {
+		// Apps in an App Service Environment are named <app name>.<ASE name>.appserviceenvironment.net
+		availabilityRequest.Name = utils.String(fmt.Sprintf("%s.%s.appserviceenvironment.net", name, *ase.Name))
+		availabilityRequest.IsFqdn = utils.Bool(true)
+	}
+
+	available, err := client.Web.AppServicesClient.CheckNameAvailability(ctx, availabilityRequest)
+	if err != nil {
+		return nil, fmt.Errorf("checking if the name %q was available: %+v", name, err)
+	}
+
+	if available.NameAvailable != nil && !*available.NameAvailable {
+		return nil, fmt.Errorf("the name %q used for the App needs to be globally unique and isn't available: %s", name, utils.NormalizeNilableString(available.Message))
+	}
+
+	return &servicePlan, nil
+}
+
+func servicePlanTier(servicePlan *web.AppServicePlan) string {
+	if servicePlan == nil || servicePlan.Sku == nil {
+		return ""
+	}
+
+	return utils.NormalizeNilableString(servicePlan.Sku.Tier)
+}
@@ -0,0 +1,609 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/sdk/webapps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type WindowsFunctionAppResource struct{}
+
+type WindowsFunctionAppModel struct {
+	Name                          string                         `tfschema:"name"`
+	ResourceGroup                 string                         `tfschema:"resource_group_name"`
+	Location                      string                         `tfschema:"location"`
+	ServicePlanId                 string                         `tfschema:"service_plan_id"`
+	StorageAccountName            string                         `tfschema:"storage_account_name"`
+	StorageAccountKey             string                         `tfschema:"storage_account_access_key"`
+	StorageUsesMSI                bool                           `tfschema:"storage_uses_managed_identity"`
+	AppSettings                   map[string]string              `tfschema:"app_settings"`
+	AuthV2Settings                []AuthV2Settings               `tfschema:"auth_settings_v2"`
+	BuiltinLogging                bool                           `tfschema:"builtin_logging_enabled"`
+	ClientCertEnabled             bool                           `tfschema:"client_certificate_enabled"`
+	ClientCertMode                string                         `tfschema:"client_certificate_mode"`
+	ConnectionStrings             []ConnectionString             `tfschema:"connection_string"`
+	ContentShareForceDisabled     bool                           `tfschema:"content_share_force_disabled"`
+	DailyMemoryTimeQuota          int                            `tfschema:"daily_memory_time_quota"`
+	Enabled                       bool                           `tfschema:"enabled"`
+	FunctionExtensionsVersion     string                         `tfschema:"functions_extension_version"`
+	HttpsOnly                     bool                           `tfschema:"https_only"`
+	KeyVaultReferenceIdentityID   string                         `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                    []SiteConfigWindowsFunctionApp `tfschema:"site_config"`
+	Tags                          map[string]interface{}         `tfschema:"tags"`
+	ZipDeployFile                 string                         `tfschema:"zip_deploy_file"`
+	CustomDomainVerificationId    string                         `tfschema:"custom_domain_verification_id"`
+	DefaultHostname               string                         `tfschema:"default_hostname"`
+	Kind                          string                         `tfschema:"kind"`
+	OutboundIPAddresses           string                         `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string                       `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string                         `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string                       `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials               []SiteCredential               `tfschema:"site_credential"`
+	ZipDeployFileHash             string                         `tfschema:"zip_deploy_file_hash"`
+}
+
+var _ sdk.Resource = WindowsFunctionAppResource{}
+var _ sdk.ResourceWithUpdate = WindowsFunctionAppResource{}
+var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppResource{}
+
+func (r WindowsFunctionAppResource) ModelObject() interface{} {
+	return WindowsFunctionAppModel{}
+}
+
+func (r WindowsFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FunctionAppID
+}
+
+func (r WindowsFunctionAppResource) ResourceType() string {
+	return "azurerm_windows_function_app"
+}
+
+func (r WindowsFunctionAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return zipDeployFileCustomizeDiff()
+}
+
+func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AppServiceName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"service_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AppServicePlanID,
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"auth_settings_v2": authV2SettingsSchema(),
+
+		"client_certificate_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"client_certificate_mode": clientCertificateModeSchema(),
+
+		"connection_string": connectionStringSchema(),
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": webAppIdentity{}.Schema(),
+
+		"key_vault_reference_identity_id": keyVaultReferenceIdentityIdSchema(),
+
+		"site_config": siteConfigSchemaWindowsFunctionApp(),
+
+		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+
+	for k, v := range functionAppStorageArguments() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r WindowsFunctionAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_credential": siteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var functionApp WindowsFunctionAppModel
+			if err := metadata.Decode(&functionApp); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Web.AppServicesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewFunctionAppID(subscriptionId, functionApp.ResourceGroup, functionApp.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			servicePlanId, err := parse.AppServicePlanID(functionApp.ServicePlanId)
+			if err != nil {
+				return err
+			}
+
+			servicePlan, err := checkWebAppNameAvailability(ctx, metadata.Client, functionApp.Name, *servicePlanId)
+			if err != nil {
+				return err
+			}
+			if servicePlan.AppServicePlanProperties != nil && utils.NormaliseNilableBool(servicePlan.Reserved) {
+				return fmt.Errorf("the Service Plan %q is a Linux plan and cannot be used for a Windows Function App", servicePlanId.ServerfarmName)
+			}
+
+			appSettings, err := r.expandAppSettings(functionApp, servicePlanTier(servicePlan), metadata.Client.Account.Environment.StorageEndpointSuffix)
+			if err != nil {
+				return fmt.Errorf("expanding `app_settings` for %s: %+v", id, err)
+			}
+
+			siteConfig, err := expandSiteConfigWindowsFunctionApp(functionApp.SiteConfig, appSettings)
+			if err != nil {
+				return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+			}
+			if siteConfig == nil {
+				siteConfig = &web.SiteConfig{}
+			}
+			siteConfig.AppSettings = expandFunctionAppSettingsList(appSettings)
+
+			siteIdentity, err := expandWebAppIdentity(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			siteEnvelope := web.Site{
+				Location: utils.String(location.Normalize(functionApp.Location)),
+				Kind:     utils.String("functionapp"),
+				Identity: siteIdentity,
+				Tags:     tags.Expand(functionApp.Tags),
+				SiteProperties: &web.SiteProperties{
+					ServerFarmID:         utils.String(functionApp.ServicePlanId),
+					Enabled:              utils.Bool(functionApp.Enabled),
+					HTTPSOnly:            utils.Bool(functionApp.HttpsOnly),
+					SiteConfig:           siteConfig,
+					ClientCertEnabled:    utils.Bool(functionApp.ClientCertEnabled),
+					ClientCertMode:       web.ClientCertMode(functionApp.ClientCertMode),
+					DailyMemoryTimeQuota: utils.Int32(int32(functionApp.DailyMemoryTimeQuota)),
+				},
+			}
+
+			if functionApp.KeyVaultReferenceIdentityID != "" {
+				siteEnvelope.SiteProperties.KeyVaultReferenceIdentity = utils.String(functionApp.KeyVaultReferenceIdentityID)
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, siteEnvelope)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(functionApp.AuthV2Settings) > 0 {
+				authId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2(ctx, authId, *expandAuthV2Settings(functionApp.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(functionApp.ConnectionStrings) > 0 {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(functionApp.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, connectionStrings); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if functionApp.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, "", functionApp.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			functionApp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(functionApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			siteConfig, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Site Config for %s: %+v", id, err)
+			}
+
+			appSettingsResp, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving App Settings for %s: %+v", id, err)
+			}
+
+			authV2Settings, err := metadata.Client.Web.WebAppsClient.GetAuthSettingsV2(ctx, webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+			if err != nil {
+				return fmt.Errorf("retrieving Auth V2 Settings for %s: %+v", id, err)
+			}
+
+			connectionStrings, err := client.ListConnectionStrings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Connection Strings for %s: %+v", id, err)
+			}
+
+			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("listing Site Publishing Credentials for %s: %+v", id, err)
+			}
+			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for Site Publishing Credentials for %s: %+v", id, err)
+			}
+			siteCredentials, err := siteCredentialsFuture.Result(*client)
+			if err != nil {
+				return fmt.Errorf("reading Site Publishing Credentials for %s: %+v", id, err)
+			}
+
+			appSettings := flattenAppSettings(appSettingsResp)
+			storageAccountName, storageAccountKey, storageUsesMSI := parseFunctionAppStorageSettings(appSettings)
+			_, builtinLogging := appSettings["AzureWebJobsDashboard"]
+			_, contentShare := appSettings["WEBSITE_CONTENTSHARE"]
+
+			model := WindowsFunctionAppModel{
+				Name:                      id.SiteName,
+				ResourceGroup:             id.ResourceGroup,
+				Location:                  location.NormalizeNilable(functionApp.Location),
+				AppSettings:               stripFunctionAppManagedSettings(appSettings),
+				AuthV2Settings:            flattenAuthV2Settings(authV2Settings.Model),
+				BuiltinLogging:            builtinLogging,
+				ConnectionStrings:         flattenConnectionStrings(connectionStrings),
+				ContentShareForceDisabled: metadata.ResourceData.Get("content_share_force_disabled").(bool) && !contentShare,
+				FunctionExtensionsVersion: appSettings["FUNCTIONS_EXTENSION_VERSION"],
+				Kind:                      utils.NormalizeNilableString(functionApp.Kind),
+				SiteConfig:                flattenSiteConfigWindowsFunctionApp(siteConfig.SiteConfig, appSettings),
+				SiteCredentials:           flattenSiteCredentials(siteCredentials),
+				StorageAccountName:        storageAccountName,
+				StorageAccountKey:         storageAccountKey,
+				StorageUsesMSI:            storageUsesMSI,
+				Tags:                      tags.Flatten(functionApp.Tags),
+			}
+
+			if props := functionApp.SiteProperties; props != nil {
+				model.ServicePlanId = utils.NormalizeNilableString(props.ServerFarmID)
+				model.ClientCertEnabled = utils.NormaliseNilableBool(props.ClientCertEnabled)
+				model.ClientCertMode = string(props.ClientCertMode)
+				model.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationID)
+				model.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostName)
+				model.Enabled = utils.NormaliseNilableBool(props.Enabled)
+				model.HttpsOnly = utils.NormaliseNilableBool(props.HTTPSOnly)
+				model.KeyVaultReferenceIdentityID = utils.NormalizeNilableString(props.KeyVaultReferenceIdentity)
+				model.OutboundIPAddresses = utils.NormalizeNilableString(props.OutboundIPAddresses)
+				model.OutboundIPAddressList = splitIpAddresses(model.OutboundIPAddresses)
+				model.PossibleOutboundIPAddresses = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+				model.PossibleOutboundIPAddressList = splitIpAddresses(model.PossibleOutboundIPAddresses)
+				if props.DailyMemoryTimeQuota != nil {
+					model.DailyMemoryTimeQuota = int(*props.DailyMemoryTimeQuota)
+				}
+			}
+
+			// the API returns the System Assigned Identity as the Key Vault Reference Identity when none is configured
+			if strings.EqualFold(model.KeyVaultReferenceIdentityID, "SystemAssigned") {
+				model.KeyVaultReferenceIdentityID = ""
+			}
+
+			if err := metadata.ResourceData.Set("identity", flattenWebAppIdentity(functionApp.Identity)); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			// the zip package isn't returned by the API, so these are retained from the state
+			model.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			model.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r WindowsFunctionAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", id)
+
+			deleteMetrics := true
+			deleteEmptyServerFarm := false
+			if resp, err := client.Delete(ctx, id.ResourceGroup, id.SiteName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.FunctionAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state WindowsFunctionAppModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+			if existing.SiteProperties == nil {
+				return fmt.Errorf("reading %s: `properties` was nil", id)
+			}
+
+			servicePlanId, err := parse.AppServicePlanID(state.ServicePlanId)
+			if err != nil {
+				return err
+			}
+
+			servicePlan, err := metadata.Client.Web.AppServicePlansClient.Get(ctx, servicePlanId.ResourceGroup, servicePlanId.ServerfarmName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", servicePlanId, err)
+			}
+
+			rd := metadata.ResourceData
+
+			if rd.HasChange("service_plan_id") {
+				existing.SiteProperties.ServerFarmID = utils.String(state.ServicePlanId)
+			}
+
+			if rd.HasChange("enabled") {
+				existing.SiteProperties.Enabled = utils.Bool(state.Enabled)
+			}
+
+			if rd.HasChange("https_only") {
+				existing.SiteProperties.HTTPSOnly = utils.Bool(state.HttpsOnly)
+			}
+
+			if rd.HasChange("client_certificate_enabled") {
+				existing.SiteProperties.ClientCertEnabled = utils.Bool(state.ClientCertEnabled)
+			}
+
+			if rd.HasChange("client_certificate_mode") {
+				existing.SiteProperties.ClientCertMode = web.ClientCertMode(state.ClientCertMode)
+			}
+
+			if rd.HasChange("daily_memory_time_quota") {
+				existing.SiteProperties.DailyMemoryTimeQuota = utils.Int32(int32(state.DailyMemoryTimeQuota))
+			}
+
+			if rd.HasChange("identity") {
+				siteIdentity, err := expandWebAppIdentity(rd.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				existing.Identity = siteIdentity
+			}
+
+			if rd.HasChange("key_vault_reference_identity_id") {
+				existing.SiteProperties.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
+			}
+
+			if rd.HasChange("tags") {
+				existing.Tags = tags.Expand(state.Tags)
+			}
+
+			// the App Settings are derived from several arguments (including the `site_config`) so these are always rebuilt
+			appSettings, err := r.expandAppSettings(state, servicePlanTier(&servicePlan), metadata.Client.Account.Environment.StorageEndpointSuffix)
+			if err != nil {
+				return fmt.Errorf("expanding `app_settings` for %s: %+v", id, err)
+			}
+
+			siteConfig, err := expandSiteConfigWindowsFunctionApp(state.SiteConfig, appSettings)
+			if err != nil {
+				return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+			}
+			if rd.HasChange("site_config") {
+				existing.SiteProperties.SiteConfig = siteConfig
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, existing)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, web.StringDictionary{Properties: appSettings}); err != nil {
+				return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+			}
+
+			if rd.HasChange("auth_settings_v2") {
+				authId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2(ctx, authId, *expandAuthV2Settings(state.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("connection_string") {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(state.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, connectionStrings); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChanges("zip_deploy_file", "zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, "", state.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := rd.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WindowsFunctionAppResource) expandAppSettings(functionApp WindowsFunctionAppModel, planTier string, endpointSuffix string) (map[string]*string, error) {
+	storageSettings := functionAppStorageSettings{
+		Name:                     functionApp.Name,
+		StorageAccountName:       functionApp.StorageAccountName,
+		StorageAccountKey:        functionApp.StorageAccountKey,
+		StorageUsesMSI:           functionApp.StorageUsesMSI,
+		BuiltinLogging:           functionApp.BuiltinLogging,
+		ContentShareForceDisable: functionApp.ContentShareForceDisabled,
+		ExtensionVersion:         functionApp.FunctionExtensionsVersion,
+		PlanTier:                 planTier,
+	}
+
+	managedSettings, err := storageSettings.appSettings(endpointSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	appSettings := expandAppSettings(functionApp.AppSettings)
+	for k, v := range managedSettings {
+		appSettings[k] = v
+	}
+
+	return appSettings, nil
+}
@@ -0,0 +1,636 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/sdk/webapps"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type WindowsWebAppResource struct{}
+
+type WindowsWebAppModel struct {
+	Name                          string                 `tfschema:"name"`
+	ResourceGroup                 string                 `tfschema:"resource_group_name"`
+	Location                      string                 `tfschema:"location"`
+	ServicePlanId                 string                 `tfschema:"service_plan_id"`
+	AppSettings                   map[string]string      `tfschema:"app_settings"`
+	AuthV2Settings                []AuthV2Settings       `tfschema:"auth_settings_v2"`
+	ClientAffinityEnabled         bool                   `tfschema:"client_affinity_enabled"`
+	ClientCertEnabled             bool                   `tfschema:"client_certificate_enabled"`
+	ClientCertMode                string                 `tfschema:"client_certificate_mode"`
+	ConnectionStrings             []ConnectionString     `tfschema:"connection_string"`
+	Enabled                       bool                   `tfschema:"enabled"`
+	HttpsOnly                     bool                   `tfschema:"https_only"`
+	KeyVaultReferenceIdentityID   string                 `tfschema:"key_vault_reference_identity_id"`
+	SiteConfig                    []SiteConfigWindows    `tfschema:"site_config"`
+	StorageAccounts               []StorageAccount       `tfschema:"storage_account"`
+	Tags                          map[string]interface{} `tfschema:"tags"`
+	ZipDeployFile                 string                 `tfschema:"zip_deploy_file"`
+	CustomDomainVerificationId    string                 `tfschema:"custom_domain_verification_id"`
+	DefaultHostname               string                 `tfschema:"default_hostname"`
+	Kind                          string                 `tfschema:"kind"`
+	OutboundIPAddresses           string                 `tfschema:"outbound_ip_addresses"`
+	OutboundIPAddressList         []string               `tfschema:"outbound_ip_address_list"`
+	PossibleOutboundIPAddresses   string                 `tfschema:"possible_outbound_ip_addresses"`
+	PossibleOutboundIPAddressList []string               `tfschema:"possible_outbound_ip_address_list"`
+	SiteCredentials               []SiteCredential       `tfschema:"site_credential"`
+	ZipDeployFileHash             string                 `tfschema:"zip_deploy_file_hash"`
+}
+
+var _ sdk.Resource = WindowsWebAppResource{}
+var _ sdk.ResourceWithUpdate = WindowsWebAppResource{}
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+
+func (r WindowsWebAppResource) ModelObject() interface{} {
+	return WindowsWebAppModel{}
+}
+
+func (r WindowsWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppServiceID
+}
+
+func (r WindowsWebAppResource) ResourceType() string {
+	return "azurerm_windows_web_app"
+}
+
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return zipDeployFileCustomizeDiff()
+}
+
+func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AppServiceName,
+		},
+
+		"resource_group_name": azure.SchemaResourceGroupName(),
+
+		"location": location.Schema(),
+
+		"service_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.AppServicePlanID,
+		},
+
+		"app_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"auth_settings_v2": authV2SettingsSchema(),
+
+		"client_affinity_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"client_certificate_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"client_certificate_mode": clientCertificateModeSchema(),
+
+		"connection_string": connectionStringSchema(),
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"https_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"identity": webAppIdentity{}.Schema(),
+
+		"key_vault_reference_identity_id": keyVaultReferenceIdentityIdSchema(),
+
+		"site_config": siteConfigSchemaWindows(),
+
+		"storage_account": storageAccountSchema(),
+
+		"tags": tags.Schema(),
+
+		"zip_deploy_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WindowsWebAppResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"custom_domain_verification_id": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"default_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"possible_outbound_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"possible_outbound_ip_address_list": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"site_credential": siteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var webApp WindowsWebAppModel
+			if err := metadata.Decode(&webApp); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Web.AppServicesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewAppServiceID(subscriptionId, webApp.ResourceGroup, webApp.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			servicePlanId, err := parse.AppServicePlanID(webApp.ServicePlanId)
+			if err != nil {
+				return err
+			}
+
+			servicePlan, err := checkWebAppNameAvailability(ctx, metadata.Client, webApp.Name, *servicePlanId)
+			if err != nil {
+				return err
+			}
+			if servicePlan.AppServicePlanProperties != nil && utils.NormaliseNilableBool(servicePlan.Reserved) {
+				return fmt.Errorf("the Service Plan %q is a Linux plan and cannot be used for a Windows Web App", servicePlanId.ServerfarmName)
+			}
+
+			siteConfig, currentStack, err := expandSiteConfigWindows(webApp.SiteConfig)
+			if err != nil {
+				return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+			}
+
+			siteIdentity, err := expandWebAppIdentity(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			siteEnvelope := web.Site{
+				Location: utils.String(location.Normalize(webApp.Location)),
+				Identity: siteIdentity,
+				Tags:     tags.Expand(webApp.Tags),
+				SiteProperties: &web.SiteProperties{
+					ServerFarmID:          utils.String(webApp.ServicePlanId),
+					Enabled:               utils.Bool(webApp.Enabled),
+					HTTPSOnly:             utils.Bool(webApp.HttpsOnly),
+					SiteConfig:            siteConfig,
+					ClientAffinityEnabled: utils.Bool(webApp.ClientAffinityEnabled),
+					ClientCertEnabled:     utils.Bool(webApp.ClientCertEnabled),
+					ClientCertMode:        web.ClientCertMode(webApp.ClientCertMode),
+				},
+			}
+
+			if webApp.KeyVaultReferenceIdentityID != "" {
+				siteEnvelope.SiteProperties.KeyVaultReferenceIdentity = utils.String(webApp.KeyVaultReferenceIdentityID)
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, siteEnvelope)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if currentStack != "" {
+				siteMetadata := web.StringDictionary{
+					Properties: map[string]*string{
+						"CURRENT_STACK": utils.String(currentStack),
+					},
+				}
+				if _, err := client.UpdateMetadata(ctx, id.ResourceGroup, id.SiteName, siteMetadata); err != nil {
+					return fmt.Errorf("setting Site Metadata for Current Stack on %s: %+v", id, err)
+				}
+			}
+
+			appSettings := expandWindowsWebAppSettings(webApp.AppSettings, webApp.SiteConfig)
+			if len(appSettings) > 0 {
+				if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, web.StringDictionary{Properties: appSettings}); err != nil {
+					return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.AuthV2Settings) > 0 {
+				authId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2(ctx, authId, *expandAuthV2Settings(webApp.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.ConnectionStrings) > 0 {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(webApp.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, connectionStrings); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if len(webApp.StorageAccounts) > 0 {
+				storageAccounts := web.AzureStoragePropertyDictionaryResource{
+					Properties: expandStorageAccounts(webApp.StorageAccounts),
+				}
+				if _, err := client.UpdateAzureStorageAccounts(ctx, id.ResourceGroup, id.SiteName, storageAccounts); err != nil {
+					return fmt.Errorf("updating Storage Accounts for %s: %+v", id, err)
+				}
+			}
+
+			if webApp.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, "", webApp.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := metadata.ResourceData.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state WindowsWebAppModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			webApp, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(webApp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			siteConfig, err := client.GetConfiguration(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Site Config for %s: %+v", id, err)
+			}
+
+			appSettings, err := client.ListApplicationSettings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving App Settings for %s: %+v", id, err)
+			}
+
+			authV2Settings, err := metadata.Client.Web.WebAppsClient.GetAuthSettingsV2(ctx, webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+			if err != nil {
+				return fmt.Errorf("retrieving Auth V2 Settings for %s: %+v", id, err)
+			}
+
+			siteMetadata, err := client.ListMetadata(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Site Metadata for %s: %+v", id, err)
+			}
+
+			connectionStrings, err := client.ListConnectionStrings(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Connection Strings for %s: %+v", id, err)
+			}
+
+			storageAccounts, err := client.ListAzureStorageAccounts(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Accounts for %s: %+v", id, err)
+			}
+
+			siteCredentialsFuture, err := client.ListPublishingCredentials(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("listing Site Publishing Credentials for %s: %+v", id, err)
+			}
+			if err := siteCredentialsFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for Site Publishing Credentials for %s: %+v", id, err)
+			}
+			siteCredentials, err := siteCredentialsFuture.Result(*client)
+			if err != nil {
+				return fmt.Errorf("reading Site Publishing Credentials for %s: %+v", id, err)
+			}
+
+			currentStack := ""
+			if v, ok := siteMetadata.Properties["CURRENT_STACK"]; ok {
+				currentStack = utils.NormalizeNilableString(v)
+			}
+
+			nodeVersion := ""
+			if v, ok := appSettings.Properties["WEBSITE_NODE_DEFAULT_VERSION"]; ok {
+				nodeVersion = utils.NormalizeNilableString(v)
+				delete(appSettings.Properties, "WEBSITE_NODE_DEFAULT_VERSION")
+			}
+
+			model := WindowsWebAppModel{
+				Name:              id.SiteName,
+				ResourceGroup:     id.ResourceGroup,
+				Location:          location.NormalizeNilable(webApp.Location),
+				AppSettings:       flattenAppSettings(appSettings),
+				AuthV2Settings:    flattenAuthV2Settings(authV2Settings.Model),
+				ConnectionStrings: flattenConnectionStrings(connectionStrings),
+				Kind:              utils.NormalizeNilableString(webApp.Kind),
+				SiteConfig:        flattenSiteConfigWindows(siteConfig.SiteConfig, currentStack, nodeVersion),
+				SiteCredentials:   flattenSiteCredentials(siteCredentials),
+				StorageAccounts:   flattenStorageAccounts(storageAccounts, state.StorageAccounts),
+				Tags:              tags.Flatten(webApp.Tags),
+			}
+
+			if props := webApp.SiteProperties; props != nil {
+				model.ServicePlanId = utils.NormalizeNilableString(props.ServerFarmID)
+				model.ClientAffinityEnabled = utils.NormaliseNilableBool(props.ClientAffinityEnabled)
+				model.ClientCertEnabled = utils.NormaliseNilableBool(props.ClientCertEnabled)
+				model.ClientCertMode = string(props.ClientCertMode)
+				model.CustomDomainVerificationId = utils.NormalizeNilableString(props.CustomDomainVerificationID)
+				model.DefaultHostname = utils.NormalizeNilableString(props.DefaultHostName)
+				model.Enabled = utils.NormaliseNilableBool(props.Enabled)
+				model.HttpsOnly = utils.NormaliseNilableBool(props.HTTPSOnly)
+				model.KeyVaultReferenceIdentityID = utils.NormalizeNilableString(props.KeyVaultReferenceIdentity)
+				model.OutboundIPAddresses = utils.NormalizeNilableString(props.OutboundIPAddresses)
+				model.OutboundIPAddressList = splitIpAddresses(model.OutboundIPAddresses)
+				model.PossibleOutboundIPAddresses = utils.NormalizeNilableString(props.PossibleOutboundIPAddresses)
+				model.PossibleOutboundIPAddressList = splitIpAddresses(model.PossibleOutboundIPAddresses)
+			}
+
+			// the API returns the System Assigned Identity as the Key Vault Reference Identity when none is configured
+			if strings.EqualFold(model.KeyVaultReferenceIdentityID, "SystemAssigned") {
+				model.KeyVaultReferenceIdentityID = ""
+			}
+
+			if err := metadata.ResourceData.Set("identity", flattenWebAppIdentity(webApp.Identity)); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			// the zip package isn't returned by the API, so these are retained from the state
+			model.ZipDeployFile = metadata.ResourceData.Get("zip_deploy_file").(string)
+			model.ZipDeployFileHash = metadata.ResourceData.Get("zip_deploy_file_hash").(string)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r WindowsWebAppResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s", id)
+
+			deleteMetrics := true
+			deleteEmptyServerFarm := false
+			if resp, err := client.Delete(ctx, id.ResourceGroup, id.SiteName, &deleteMetrics, &deleteEmptyServerFarm); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Web.AppServicesClient
+			id, err := parse.AppServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state WindowsWebAppModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+			if existing.SiteProperties == nil {
+				return fmt.Errorf("reading %s: `properties` was nil", id)
+			}
+
+			rd := metadata.ResourceData
+
+			if rd.HasChange("service_plan_id") {
+				existing.SiteProperties.ServerFarmID = utils.String(state.ServicePlanId)
+			}
+
+			if rd.HasChange("enabled") {
+				existing.SiteProperties.Enabled = utils.Bool(state.Enabled)
+			}
+
+			if rd.HasChange("https_only") {
+				existing.SiteProperties.HTTPSOnly = utils.Bool(state.HttpsOnly)
+			}
+
+			if rd.HasChange("client_affinity_enabled") {
+				existing.SiteProperties.ClientAffinityEnabled = utils.Bool(state.ClientAffinityEnabled)
+			}
+
+			if rd.HasChange("client_certificate_enabled") {
+				existing.SiteProperties.ClientCertEnabled = utils.Bool(state.ClientCertEnabled)
+			}
+
+			if rd.HasChange("client_certificate_mode") {
+				existing.SiteProperties.ClientCertMode = web.ClientCertMode(state.ClientCertMode)
+			}
+
+			if rd.HasChange("identity") {
+				siteIdentity, err := expandWebAppIdentity(rd.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				existing.Identity = siteIdentity
+			}
+
+			if rd.HasChange("key_vault_reference_identity_id") {
+				existing.SiteProperties.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
+			}
+
+			currentStack := ""
+			if rd.HasChange("site_config") {
+				siteConfig, stack, err := expandSiteConfigWindows(state.SiteConfig)
+				if err != nil {
+					return fmt.Errorf("expanding `site_config` for %s: %+v", id, err)
+				}
+				existing.SiteProperties.SiteConfig = siteConfig
+				currentStack = stack
+			}
+
+			if rd.HasChange("tags") {
+				existing.Tags = tags.Expand(state.Tags)
+			}
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, existing)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			if currentStack != "" {
+				siteMetadata := web.StringDictionary{
+					Properties: map[string]*string{
+						"CURRENT_STACK": utils.String(currentStack),
+					},
+				}
+				if _, err := client.UpdateMetadata(ctx, id.ResourceGroup, id.SiteName, siteMetadata); err != nil {
+					return fmt.Errorf("setting Site Metadata for Current Stack on %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChanges("app_settings", "site_config") {
+				appSettings := web.StringDictionary{
+					Properties: expandWindowsWebAppSettings(state.AppSettings, state.SiteConfig),
+				}
+				if _, err := client.UpdateApplicationSettings(ctx, id.ResourceGroup, id.SiteName, appSettings); err != nil {
+					return fmt.Errorf("updating App Settings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("auth_settings_v2") {
+				authId := webapps.NewSiteID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+				if _, err := metadata.Client.Web.WebAppsClient.UpdateAuthSettingsV2(ctx, authId, *expandAuthV2Settings(state.AuthV2Settings)); err != nil {
+					return fmt.Errorf("updating Auth V2 Settings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("connection_string") {
+				connectionStrings := web.ConnectionStringDictionary{
+					Properties: expandConnectionStrings(state.ConnectionStrings),
+				}
+				if _, err := client.UpdateConnectionStrings(ctx, id.ResourceGroup, id.SiteName, connectionStrings); err != nil {
+					return fmt.Errorf("updating Connection Strings for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChange("storage_account") {
+				storageAccounts := web.AzureStoragePropertyDictionaryResource{
+					Properties: expandStorageAccounts(state.StorageAccounts),
+				}
+				if _, err := client.UpdateAzureStorageAccounts(ctx, id.ResourceGroup, id.SiteName, storageAccounts); err != nil {
+					return fmt.Errorf("updating Storage Accounts for %s: %+v", id, err)
+				}
+			}
+
+			if rd.HasChanges("zip_deploy_file", "zip_deploy_file_hash") && state.ZipDeployFile != "" {
+				hash, err := deployZipFile(ctx, client, id.ResourceGroup, id.SiteName, "", state.ZipDeployFile)
+				if err != nil {
+					return fmt.Errorf("deploying zip package to %s: %+v", id, err)
+				}
+				if err := rd.Set("zip_deploy_file_hash", hash); err != nil {
+					return fmt.Errorf("setting `zip_deploy_file_hash`: %+v", err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// expandWindowsWebAppSettings returns the App Settings for a Windows Web App - since the Node version isn't part of the
+// Site Config on Windows this is set using the `WEBSITE_NODE_DEFAULT_VERSION` App Setting
+func expandWindowsWebAppSettings(input map[string]string, siteConfig []SiteConfigWindows) map[string]*string {
+	appSettings := expandAppSettings(input)
+	if len(siteConfig) == 1 && len(siteConfig[0].ApplicationStack) == 1 {
+		if nodeVersion := siteConfig[0].ApplicationStack[0].NodeVersion; nodeVersion != "" {
+			appSettings["WEBSITE_NODE_DEFAULT_VERSION"] = utils.String(nodeVersion)
+		}
+	}
+
+	return appSettings
+}
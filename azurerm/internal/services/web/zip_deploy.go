@@ -0,0 +1,271 @@
+package web
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-01-15/web"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// kuduDeploymentStatus is the `status` field of a Kudu Deployment
+// https://github.com/projectkudu/kudu/wiki/REST-API#deployment
+type kuduDeploymentStatus int
+
+const (
+	kuduDeploymentStatusPending   kuduDeploymentStatus = 0
+	kuduDeploymentStatusBuilding  kuduDeploymentStatus = 1
+	kuduDeploymentStatusDeploying kuduDeploymentStatus = 2
+	kuduDeploymentStatusFailed    kuduDeploymentStatus = 3
+	kuduDeploymentStatusSuccess   kuduDeploymentStatus = 4
+)
+
+type kuduDeployment struct {
+	Id         string               `json:"id"`
+	Status     kuduDeploymentStatus `json:"status"`
+	StatusText string               `json:"status_text"`
+	Complete   bool                 `json:"complete"`
+}
+
+// kuduClient is a minimal client for the Kudu (SCM) site of a Web or Function App
+// which authenticates using the Site's Publishing Credentials
+type kuduClient struct {
+	endpoint     string
+	client       autorest.Client
+	pollInterval time.Duration
+}
+
+// newKuduClient builds a Kudu client from the provider's client for the Web App, so that requests use the same
+// Sender (proxy configuration and request logging), User Agent and retry configuration as the rest of the provider
+func newKuduClient(client autorest.Client, scmHostName string, credentials web.User) (*kuduClient, error) {
+	props := credentials.UserProperties
+	if props == nil || props.PublishingUserName == nil || props.PublishingPassword == nil {
+		return nil, fmt.Errorf("the Publishing Credentials for the Site were nil")
+	}
+
+	client.Authorizer = autorest.NewBasicAuthorizer(*props.PublishingUserName, *props.PublishingPassword)
+
+	return &kuduClient{
+		endpoint:     fmt.Sprintf("https://%s", scmHostName),
+		client:       client,
+		pollInterval: 10 * time.Second,
+	}, nil
+}
+
+func (c kuduClient) send(req *http.Request) (*http.Response, error) {
+	return c.client.Send(req, autorest.DoRetryForStatusCodes(c.client.RetryAttempts, c.client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// zipDeploy pushes the zip package to the `zipdeploy` API and waits for the resulting Deployment to complete
+func (c kuduClient) zipDeploy(ctx context.Context, contents []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/zipdeploy?isAsync=true", c.endpoint), bytes.NewReader(contents))
+	if err != nil {
+		return fmt.Errorf("building zip deployment request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/zip")
+
+	resp, err := c.send(req)
+	if err != nil {
+		return fmt.Errorf("sending zip deployment request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status %d from the zip deployment API: %s", resp.StatusCode, string(body))
+	}
+
+	statusUri := resp.Header.Get("Location")
+	if statusUri == "" {
+		statusUri = fmt.Sprintf("%s/api/deployments/latest", c.endpoint)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			fmt.Sprintf("%d", kuduDeploymentStatusPending),
+			fmt.Sprintf("%d", kuduDeploymentStatusBuilding),
+			fmt.Sprintf("%d", kuduDeploymentStatusDeploying),
+		},
+		Target:       []string{fmt.Sprintf("%d", kuduDeploymentStatusSuccess)},
+		Refresh:      c.deploymentStatusRefreshFunc(ctx, statusUri),
+		PollInterval: c.pollInterval,
+		Timeout:      time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the zip deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func (c kuduClient) deploymentStatusRefreshFunc(ctx context.Context, statusUri string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, statusUri, nil)
+		if err != nil {
+			return nil, "", fmt.Errorf("building deployment status request: %+v", err)
+		}
+
+		resp, err := c.send(req)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving deployment status: %+v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, "", fmt.Errorf("reading deployment status: %+v", err)
+		}
+
+		// the deployment may not have been registered yet
+		if resp.StatusCode == http.StatusNotFound {
+			return resp, fmt.Sprintf("%d", kuduDeploymentStatusPending), nil
+		}
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+			return nil, "", fmt.Errorf("unexpected status %d retrieving deployment status: %s", resp.StatusCode, string(body))
+		}
+
+		var deployment kuduDeployment
+		if err := json.Unmarshal(body, &deployment); err != nil {
+			return nil, "", fmt.Errorf("parsing deployment status: %+v", err)
+		}
+
+		log.Printf("[DEBUG] Deployment %q has status %d (%s)", deployment.Id, deployment.Status, deployment.StatusText)
+		if deployment.Status == kuduDeploymentStatusFailed {
+			return nil, "", fmt.Errorf("deployment %q failed: %s", deployment.Id, deployment.StatusText)
+		}
+
+		return deployment, fmt.Sprintf("%d", deployment.Status), nil
+	}
+}
+
+// scmHostNameForSite returns the hostname of the Kudu (SCM) site for the Web App or Slot
+func scmHostNameForSite(site web.Site) (string, error) {
+	if props := site.SiteProperties; props != nil && props.HostNameSslStates != nil {
+		for _, v := range *props.HostNameSslStates {
+			if v.HostType == web.HostTypeRepository && v.Name != nil {
+				return *v.Name, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the SCM hostname was not found")
+}
+
+// deployZipFile pushes the zip package at `pathOnDisk` to the Site, or to the Slot when `slotName` is
+// specified, returning the SHA256 hash of the package which was deployed
+func deployZipFile(ctx context.Context, client *web.AppsClient, resourceGroup, siteName, slotName, pathOnDisk string) (string, error) {
+	contents, err := ioutil.ReadFile(pathOnDisk)
+	if err != nil {
+		return "", fmt.Errorf("reading zip package %q: %+v", pathOnDisk, err)
+	}
+
+	var site web.Site
+	var credentials web.User
+	if slotName == "" {
+		site, err = client.Get(ctx, resourceGroup, siteName)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Site %q (Resource Group %q): %+v", siteName, resourceGroup, err)
+		}
+
+		future, err := client.ListPublishingCredentials(ctx, resourceGroup, siteName)
+		if err != nil {
+			return "", fmt.Errorf("listing Publishing Credentials for Site %q (Resource Group %q): %+v", siteName, resourceGroup, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return "", fmt.Errorf("waiting for Publishing Credentials for Site %q (Resource Group %q): %+v", siteName, resourceGroup, err)
+		}
+		if credentials, err = future.Result(*client); err != nil {
+			return "", fmt.Errorf("reading Publishing Credentials for Site %q (Resource Group %q): %+v", siteName, resourceGroup, err)
+		}
+	} else {
+		site, err = client.GetSlot(ctx, resourceGroup, siteName, slotName)
+		if err != nil {
+			return "", fmt.Errorf("retrieving Slot %q (Site %q / Resource Group %q): %+v", slotName, siteName, resourceGroup, err)
+		}
+
+		future, err := client.ListPublishingCredentialsSlot(ctx, resourceGroup, siteName, slotName)
+		if err != nil {
+			return "", fmt.Errorf("listing Publishing Credentials for Slot %q (Site %q / Resource Group %q): %+v", slotName, siteName, resourceGroup, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return "", fmt.Errorf("waiting for Publishing Credentials for Slot %q (Site %q / Resource Group %q): %+v", slotName, siteName, resourceGroup, err)
+		}
+		if credentials, err = future.Result(*client); err != nil {
+			return "", fmt.Errorf("reading Publishing Credentials for Slot %q (Site %q / Resource Group %q): %+v", slotName, siteName, resourceGroup, err)
+		}
+	}
+
+	scmHostName, err := scmHostNameForSite(site)
+	if err != nil {
+		return "", err
+	}
+
+	kudu, err := newKuduClient(client.Client, scmHostName, credentials)
+	if err != nil {
+		return "", err
+	}
+
+	if err := kudu.zipDeploy(ctx, contents); err != nil {
+		return "", fmt.Errorf("deploying zip package %q: %+v", pathOnDisk, err)
+	}
+
+	return zipDeployFileHash(contents), nil
+}
+
+func zipDeployFileHash(contents []byte) string {
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
+
+// zipDeployFileCustomizeDiff marks `zip_deploy_file_hash` as changed when the contents of the file referenced
+// by `zip_deploy_file` have changed, so that a new deployment is triggered even when the path is unchanged
+func zipDeployFileCustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.NewValueKnown("zip_deploy_file") {
+				return rd.SetNewComputed("zip_deploy_file_hash")
+			}
+
+			pathOnDisk := rd.Get("zip_deploy_file").(string)
+			if strings.TrimSpace(pathOnDisk) == "" {
+				return nil
+			}
+
+			contents, err := ioutil.ReadFile(pathOnDisk)
+			if err != nil {
+				// the package may be built as a part of this apply
+				if os.IsNotExist(err) {
+					return rd.SetNewComputed("zip_deploy_file_hash")
+				}
+				return fmt.Errorf("reading zip package %q: %+v", pathOnDisk, err)
+			}
+
+			if hash := zipDeployFileHash(contents); hash != rd.Get("zip_deploy_file_hash").(string) {
+				return rd.SetNew("zip_deploy_file_hash", hash)
+			}
+
+			return nil
+		},
+	}
+}
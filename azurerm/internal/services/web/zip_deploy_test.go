@@ -0,0 +1,140 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type fakeKudu struct {
+	sync.Mutex
+
+	// statuses are returned in order by the deployment status API, the last one is repeated
+	statuses []kuduDeploymentStatus
+	polls    int
+	received []byte
+}
+
+func (f *fakeKudu) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/zipdeploy", func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "$user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if !strings.Contains(r.UserAgent(), "kudu-test") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Method != http.MethodPost || r.URL.Query().Get("isAsync") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		f.Lock()
+		f.received = body
+		f.Unlock()
+
+		w.Header().Set("Location", fmt.Sprintf("http://%s/api/deployments/latest", r.Host))
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("/api/deployments/latest", func(w http.ResponseWriter, r *http.Request) {
+		f.Lock()
+		defer f.Unlock()
+
+		status := f.statuses[len(f.statuses)-1]
+		if f.polls < len(f.statuses) {
+			status = f.statuses[f.polls]
+		}
+		f.polls++
+
+		statusCode := http.StatusAccepted
+		if status == kuduDeploymentStatusSuccess || status == kuduDeploymentStatusFailed {
+			statusCode = http.StatusOK
+		}
+		w.WriteHeader(statusCode)
+		fmt.Fprintf(w, `{"id": "abc123", "status": %d, "status_text": "some text", "complete": %t}`, status, statusCode == http.StatusOK)
+	})
+	return mux
+}
+
+func TestKuduClientZipDeploy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Statuses []kuduDeploymentStatus
+		Username string
+		Error    string
+	}{
+		{
+			Name:     "Success",
+			Statuses: []kuduDeploymentStatus{kuduDeploymentStatusPending, kuduDeploymentStatusBuilding, kuduDeploymentStatusDeploying, kuduDeploymentStatusSuccess},
+			Username: "$user",
+		},
+		{
+			Name:     "Failed",
+			Statuses: []kuduDeploymentStatus{kuduDeploymentStatusBuilding, kuduDeploymentStatusFailed},
+			Username: "$user",
+			Error:    "deployment \"abc123\" failed",
+		},
+		{
+			Name:     "Unauthorized",
+			Statuses: []kuduDeploymentStatus{kuduDeploymentStatusSuccess},
+			Username: "$other",
+			Error:    "unexpected status 401",
+		},
+		{
+			Name:     "Timeout",
+			Statuses: []kuduDeploymentStatus{kuduDeploymentStatusBuilding},
+			Username: "$user",
+			Error:    "timeout",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			kudu := &fakeKudu{statuses: v.Statuses}
+			server := httptest.NewServer(kudu.handler())
+			defer server.Close()
+
+			autorestClient := autorest.NewClientWithUserAgent("kudu-test")
+			autorestClient.Sender = server.Client()
+			autorestClient.Authorizer = autorest.NewBasicAuthorizer(v.Username, "pass")
+
+			client := kuduClient{
+				endpoint:     server.URL,
+				client:       autorestClient,
+				pollInterval: 10 * time.Millisecond,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			err := client.zipDeploy(ctx, []byte("some-zip-content"))
+			if v.Error == "" {
+				if err != nil {
+					t.Fatalf("expected no error but got: %+v", err)
+				}
+				if string(kudu.received) != "some-zip-content" {
+					t.Fatalf("expected the package to be uploaded but got %q", string(kudu.received))
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected an error containing %q but got none", v.Error)
+			}
+			if !strings.Contains(err.Error(), v.Error) {
+				t.Fatalf("expected an error containing %q but got: %+v", v.Error, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package utils
+
+// NormaliseNilableBool normalises a nilable bool into a bool
+// that is, if it's nil returns false else the value
+func NormaliseNilableBool(input *bool) bool {
+	if input == nil {
+		return false
+	}
+
+	return *input
+}